@@ -0,0 +1,75 @@
+package mtproto
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AccountGetPassword returns TL_account_password if two-step verification is enabled
+// or TL_account_noPassword otherwise
+func (m *MTProto) AccountGetPassword() (*TL, error) {
+	tl, err := m.InvokeSync(TL_account_getPassword{})
+	if err != nil {
+		return nil, err
+	}
+
+	switch (*tl).(type) {
+	case TL_account_password, TL_account_noPassword:
+	default:
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+
+	return tl, nil
+}
+
+// AccountUpdatePasswordSettings sets new two-step verification password, its hint and recovery email.
+// currentPassword is ignored if account has no password yet.
+func (m *MTProto) AccountUpdatePasswordSettings(currentPassword, newPassword, hint, email string) (bool, error) {
+	if newPassword == "" {
+		return false, errors.New("MTProto::AccountUpdatePasswordSettings new password is empty")
+	}
+
+	tl, err := m.AccountGetPassword()
+	if err != nil {
+		return false, err
+	}
+
+	var currentHash, serverSalt []byte
+	switch x := (*tl).(type) {
+	case TL_account_password:
+		currentHash = passwordHash(x.Current_salt, currentPassword)
+		serverSalt = x.New_salt
+	case TL_account_noPassword:
+		serverSalt = x.New_salt
+	}
+
+	// client appends its own random bytes to the salt proposed by server
+	newSalt := make([]byte, 0, len(serverSalt)+8)
+	newSalt = append(newSalt, serverSalt...)
+	newSalt = append(newSalt, GenerateNonce(8)...)
+
+	tl, err = m.InvokeSync(TL_account_updatePasswordSettings{
+		Current_password_hash: currentHash,
+		New_settings: TL_account_passwordInputSettings{
+			New_salt:          newSalt,
+			New_password_hash: passwordHash(newSalt, newPassword),
+			Hint:              hint,
+			Email:             email,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return ToBool(*tl)
+}
+
+// passwordHash is sha256(salt + password + salt)
+func passwordHash(salt []byte, password string) []byte {
+	data := make([]byte, 0, len(salt)*2+len(password))
+	data = append(data, salt...)
+	data = append(data, password...)
+	data = append(data, salt...)
+
+	return sha256(data)
+}
@@ -1,6 +1,20 @@
+// Code generated by tlgen from schemes/api-layer-65.tl. DO NOT EDIT.
+
 package mtproto
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
+
+// Current API Layer Version
+const layer = 65
+
+// Bool is implemented by constructors of Bool type
+type Bool interface {
+	TL
+	isBool()
+}
 
 // boolFalse#bc799737 = Bool;
 
@@ -9,6 +23,8 @@ const crc_boolFalse = 0xbc799737
 type TL_boolFalse struct {
 }
 
+func (e TL_boolFalse) isBool() {}
+
 // boolTrue#997275b5 = Bool;
 
 const crc_boolTrue = 0x997275b5
@@ -16,6 +32,14 @@ const crc_boolTrue = 0x997275b5
 type TL_boolTrue struct {
 }
 
+func (e TL_boolTrue) isBool() {}
+
+// True is implemented by constructors of True type
+type True interface {
+	TL
+	isTrue()
+}
+
 // true#3fedd339 = True;
 
 const crc_true = 0x3fedd339
@@ -23,6 +47,8 @@ const crc_true = 0x3fedd339
 type TL_true struct {
 }
 
+func (e TL_true) isTrue() {}
+
 // Encoding TL_true
 func (e TL_true) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -30,6 +56,12 @@ func (e TL_true) encode() []byte {
 	return x.buf
 }
 
+// Error is implemented by constructors of Error type
+type Error interface {
+	TL
+	isError()
+}
+
 // error#c4b9f9bb code:int text:string = Error;
 
 const crc_error = 0xc4b9f9bb
@@ -39,6 +71,8 @@ type TL_error struct {
 	Text string // text:string
 }
 
+func (e TL_error) isError() {}
+
 // Encoding TL_error
 func (e TL_error) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -48,6 +82,12 @@ func (e TL_error) encode() []byte {
 	return x.buf
 }
 
+// Null is implemented by constructors of Null type
+type Null interface {
+	TL
+	isNull()
+}
+
 // null#56730bcc = Null;
 
 const crc_null = 0x56730bcc
@@ -55,6 +95,14 @@ const crc_null = 0x56730bcc
 type TL_null struct {
 }
 
+func (e TL_null) isNull() {}
+
+// InputPeer is implemented by constructors of InputPeer type
+type InputPeer interface {
+	TL
+	isInputPeer()
+}
+
 // inputPeerEmpty#7f3b18ea = InputPeer;
 
 const crc_inputPeerEmpty = 0x7f3b18ea
@@ -62,6 +110,8 @@ const crc_inputPeerEmpty = 0x7f3b18ea
 type TL_inputPeerEmpty struct {
 }
 
+func (e TL_inputPeerEmpty) isInputPeer() {}
+
 // Encoding TL_inputPeerEmpty
 func (e TL_inputPeerEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -76,6 +126,8 @@ const crc_inputPeerSelf = 0x7da07ec9
 type TL_inputPeerSelf struct {
 }
 
+func (e TL_inputPeerSelf) isInputPeer() {}
+
 // Encoding TL_inputPeerSelf
 func (e TL_inputPeerSelf) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -91,6 +143,8 @@ type TL_inputPeerChat struct {
 	Chat_id int32 // chat_id:int
 }
 
+func (e TL_inputPeerChat) isInputPeer() {}
+
 // Encoding TL_inputPeerChat
 func (e TL_inputPeerChat) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -108,6 +162,8 @@ type TL_inputPeerUser struct {
 	Access_hash int64 // access_hash:long
 }
 
+func (e TL_inputPeerUser) isInputPeer() {}
+
 // Encoding TL_inputPeerUser
 func (e TL_inputPeerUser) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -126,6 +182,8 @@ type TL_inputPeerChannel struct {
 	Access_hash int64 // access_hash:long
 }
 
+func (e TL_inputPeerChannel) isInputPeer() {}
+
 // Encoding TL_inputPeerChannel
 func (e TL_inputPeerChannel) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -135,6 +193,12 @@ func (e TL_inputPeerChannel) encode() []byte {
 	return x.buf
 }
 
+// InputUser is implemented by constructors of InputUser type
+type InputUser interface {
+	TL
+	isInputUser()
+}
+
 // inputUserEmpty#b98886cf = InputUser;
 
 const crc_inputUserEmpty = 0xb98886cf
@@ -142,6 +206,8 @@ const crc_inputUserEmpty = 0xb98886cf
 type TL_inputUserEmpty struct {
 }
 
+func (e TL_inputUserEmpty) isInputUser() {}
+
 // Encoding TL_inputUserEmpty
 func (e TL_inputUserEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -156,6 +222,8 @@ const crc_inputUserSelf = 0xf7c1b13f
 type TL_inputUserSelf struct {
 }
 
+func (e TL_inputUserSelf) isInputUser() {}
+
 // Encoding TL_inputUserSelf
 func (e TL_inputUserSelf) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -172,6 +240,8 @@ type TL_inputUser struct {
 	Access_hash int64 // access_hash:long
 }
 
+func (e TL_inputUser) isInputUser() {}
+
 // Encoding TL_inputUser
 func (e TL_inputUser) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -181,6 +251,12 @@ func (e TL_inputUser) encode() []byte {
 	return x.buf
 }
 
+// InputContact is implemented by constructors of InputContact type
+type InputContact interface {
+	TL
+	isInputContact()
+}
+
 // inputPhoneContact#f392b7f4 client_id:long phone:string first_name:string last_name:string = InputContact;
 
 const crc_inputPhoneContact = 0xf392b7f4
@@ -192,6 +268,8 @@ type TL_inputPhoneContact struct {
 	Last_name  string // last_name:string
 }
 
+func (e TL_inputPhoneContact) isInputContact() {}
+
 // Encoding TL_inputPhoneContact
 func (e TL_inputPhoneContact) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -203,6 +281,12 @@ func (e TL_inputPhoneContact) encode() []byte {
 	return x.buf
 }
 
+// InputFile is implemented by constructors of InputFile type
+type InputFile interface {
+	TL
+	isInputFile()
+}
+
 // inputFile#f52ff27f Id:long parts:int name:string md5_checksum:string = InputFile;
 
 const crc_inputFile = 0xf52ff27f
@@ -214,6 +298,8 @@ type TL_inputFile struct {
 	Md5_checksum string // md5_checksum:string
 }
 
+func (e TL_inputFile) isInputFile() {}
+
 // Encoding TL_inputFile
 func (e TL_inputFile) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -235,6 +321,8 @@ type TL_inputFileBig struct {
 	Name  string // name:string
 }
 
+func (e TL_inputFileBig) isInputFile() {}
+
 // Encoding TL_inputFileBig
 func (e TL_inputFileBig) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -245,6 +333,12 @@ func (e TL_inputFileBig) encode() []byte {
 	return x.buf
 }
 
+// InputMedia is implemented by constructors of InputMedia type
+type InputMedia interface {
+	TL
+	isInputMedia()
+}
+
 // inputMediaEmpty#9664f57f = InputMedia;
 
 const crc_inputMediaEmpty = 0x9664f57f
@@ -252,6 +346,8 @@ const crc_inputMediaEmpty = 0x9664f57f
 type TL_inputMediaEmpty struct {
 }
 
+func (e TL_inputMediaEmpty) isInputMedia() {}
+
 // Encoding TL_inputMediaEmpty
 func (e TL_inputMediaEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -265,11 +361,13 @@ const crc_inputMediaUploadedPhoto = 0x630c9af1
 
 type TL_inputMediaUploadedPhoto struct {
 	Flags    int32
-	File     TL     // file:InputFile
-	Caption  string // caption:string
-	Stickers []TL   // stickers:flags.0?Vector<InputDocument>
+	File     InputFile       // file:InputFile
+	Caption  string          // caption:string
+	Stickers []InputDocument // stickers:flags.0?Vector<InputDocument>
 }
 
+func (e TL_inputMediaUploadedPhoto) isInputMedia() {}
+
 // Encoding TL_inputMediaUploadedPhoto
 func (e TL_inputMediaUploadedPhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -282,7 +380,11 @@ func (e TL_inputMediaUploadedPhoto) encode() []byte {
 	x.Bytes(e.File.encode())
 	x.String(e.Caption)
 	if flags&(1<<0) != 0 {
-		x.Vector(e.Stickers)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Stickers)))
+		for _, v := range e.Stickers {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
@@ -292,10 +394,12 @@ func (e TL_inputMediaUploadedPhoto) encode() []byte {
 const crc_inputMediaPhoto = 0xe9bfb4f3
 
 type TL_inputMediaPhoto struct {
-	Id      TL     // Id:InputPhoto
-	Caption string // caption:string
+	Id      InputPhoto // Id:InputPhoto
+	Caption string     // caption:string
 }
 
+func (e TL_inputMediaPhoto) isInputMedia() {}
+
 // Encoding TL_inputMediaPhoto
 func (e TL_inputMediaPhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -310,9 +414,11 @@ func (e TL_inputMediaPhoto) encode() []byte {
 const crc_inputMediaGeoPoint = 0xf9c44144
 
 type TL_inputMediaGeoPoint struct {
-	Geo_point TL // geo_point:InputGeoPoint
+	Geo_point InputGeoPoint // geo_point:InputGeoPoint
 }
 
+func (e TL_inputMediaGeoPoint) isInputMedia() {}
+
 // Encoding TL_inputMediaGeoPoint
 func (e TL_inputMediaGeoPoint) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -331,6 +437,8 @@ type TL_inputMediaContact struct {
 	Last_name    string // last_name:string
 }
 
+func (e TL_inputMediaContact) isInputMedia() {}
+
 // Encoding TL_inputMediaContact
 func (e TL_inputMediaContact) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -347,13 +455,15 @@ const crc_inputMediaUploadedDocument = 0xd070f1e9
 
 type TL_inputMediaUploadedDocument struct {
 	Flags      int32
-	File       TL     // file:InputFile
-	Mime_type  string // mime_type:string
-	Attributes []TL   // attributes:Vector<DocumentAttribute>
-	Caption    string // caption:string
-	Stickers   []TL   // stickers:flags.0?Vector<InputDocument>
+	File       InputFile           // file:InputFile
+	Mime_type  string              // mime_type:string
+	Attributes []DocumentAttribute // attributes:Vector<DocumentAttribute>
+	Caption    string              // caption:string
+	Stickers   []InputDocument     // stickers:flags.0?Vector<InputDocument>
 }
 
+func (e TL_inputMediaUploadedDocument) isInputMedia() {}
+
 // Encoding TL_inputMediaUploadedDocument
 func (e TL_inputMediaUploadedDocument) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -365,10 +475,18 @@ func (e TL_inputMediaUploadedDocument) encode() []byte {
 	x.Int(flags)
 	x.Bytes(e.File.encode())
 	x.String(e.Mime_type)
-	x.Vector(e.Attributes)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Attributes)))
+	for _, v := range e.Attributes {
+		x.Bytes(v.encode())
+	}
 	x.String(e.Caption)
 	if flags&(1<<0) != 0 {
-		x.Vector(e.Stickers)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Stickers)))
+		for _, v := range e.Stickers {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
@@ -379,14 +497,16 @@ const crc_inputMediaUploadedThumbDocument = 0x50d88cae
 
 type TL_inputMediaUploadedThumbDocument struct {
 	Flags      int32
-	File       TL     // file:InputFile
-	Thumb      TL     // thumb:InputFile
-	Mime_type  string // mime_type:string
-	Attributes []TL   // attributes:Vector<DocumentAttribute>
-	Caption    string // caption:string
-	Stickers   []TL   // stickers:flags.0?Vector<InputDocument>
+	File       InputFile           // file:InputFile
+	Thumb      InputFile           // thumb:InputFile
+	Mime_type  string              // mime_type:string
+	Attributes []DocumentAttribute // attributes:Vector<DocumentAttribute>
+	Caption    string              // caption:string
+	Stickers   []InputDocument     // stickers:flags.0?Vector<InputDocument>
 }
 
+func (e TL_inputMediaUploadedThumbDocument) isInputMedia() {}
+
 // Encoding TL_inputMediaUploadedThumbDocument
 func (e TL_inputMediaUploadedThumbDocument) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -399,10 +519,18 @@ func (e TL_inputMediaUploadedThumbDocument) encode() []byte {
 	x.Bytes(e.File.encode())
 	x.Bytes(e.Thumb.encode())
 	x.String(e.Mime_type)
-	x.Vector(e.Attributes)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Attributes)))
+	for _, v := range e.Attributes {
+		x.Bytes(v.encode())
+	}
 	x.String(e.Caption)
 	if flags&(1<<0) != 0 {
-		x.Vector(e.Stickers)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Stickers)))
+		for _, v := range e.Stickers {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
@@ -412,10 +540,12 @@ func (e TL_inputMediaUploadedThumbDocument) encode() []byte {
 const crc_inputMediaDocument = 0x1a77f29c
 
 type TL_inputMediaDocument struct {
-	Id      TL     // Id:InputDocument
-	Caption string // caption:string
+	Id      InputDocument // Id:InputDocument
+	Caption string        // caption:string
 }
 
+func (e TL_inputMediaDocument) isInputMedia() {}
+
 // Encoding TL_inputMediaDocument
 func (e TL_inputMediaDocument) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -430,13 +560,15 @@ func (e TL_inputMediaDocument) encode() []byte {
 const crc_inputMediaVenue = 0x2827a81a
 
 type TL_inputMediaVenue struct {
-	Geo_point TL     // geo_point:InputGeoPoint
-	Title     string // title:string
-	Address   string // address:string
-	Provider  string // provider:string
-	Venue_id  string // venue_id:string
+	Geo_point InputGeoPoint // geo_point:InputGeoPoint
+	Title     string        // title:string
+	Address   string        // address:string
+	Provider  string        // provider:string
+	Venue_id  string        // venue_id:string
 }
 
+func (e TL_inputMediaVenue) isInputMedia() {}
+
 // Encoding TL_inputMediaVenue
 func (e TL_inputMediaVenue) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -458,6 +590,8 @@ type TL_inputMediaGifExternal struct {
 	Q   string // q:string
 }
 
+func (e TL_inputMediaGifExternal) isInputMedia() {}
+
 // Encoding TL_inputMediaGifExternal
 func (e TL_inputMediaGifExternal) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -476,6 +610,8 @@ type TL_inputMediaPhotoExternal struct {
 	Caption string // caption:string
 }
 
+func (e TL_inputMediaPhotoExternal) isInputMedia() {}
+
 // Encoding TL_inputMediaPhotoExternal
 func (e TL_inputMediaPhotoExternal) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -494,6 +630,8 @@ type TL_inputMediaDocumentExternal struct {
 	Caption string // caption:string
 }
 
+func (e TL_inputMediaDocumentExternal) isInputMedia() {}
+
 // Encoding TL_inputMediaDocumentExternal
 func (e TL_inputMediaDocumentExternal) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -508,9 +646,11 @@ func (e TL_inputMediaDocumentExternal) encode() []byte {
 const crc_inputMediaGame = 0xd33f43f3
 
 type TL_inputMediaGame struct {
-	Id TL // Id:InputGame
+	Id InputGame // Id:InputGame
 }
 
+func (e TL_inputMediaGame) isInputMedia() {}
+
 // Encoding TL_inputMediaGame
 func (e TL_inputMediaGame) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -525,21 +665,23 @@ const crc_inputMediaInvoice = 0x92153685
 
 type TL_inputMediaInvoice struct {
 	Flags       int32
-	Title       string // title:string
-	Description string // description:string
-	Photo       TL     // photo:flags.0?InputWebDocument
-	Invoice     TL     // invoice:Invoice
-	Payload     []byte // payload:bytes
-	Provider    string // provider:string
-	Start_param string // start_param:string
+	Title       string           // title:string
+	Description string           // description:string
+	Photo       InputWebDocument // photo:flags.0?InputWebDocument
+	Invoice     Invoice          // invoice:Invoice
+	Payload     []byte           // payload:bytes
+	Provider    string           // provider:string
+	Start_param string           // start_param:string
 }
 
+func (e TL_inputMediaInvoice) isInputMedia() {}
+
 // Encoding TL_inputMediaInvoice
 func (e TL_inputMediaInvoice) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_inputMediaInvoice)
 	var flags int32
-	if _, ok := (e.Photo).(TL_null); !ok {
+	if e.Photo != nil {
 		flags |= (1 << 0)
 	}
 	x.Int(flags)
@@ -549,12 +691,18 @@ func (e TL_inputMediaInvoice) encode() []byte {
 		x.Bytes(e.Photo.encode())
 	}
 	x.Bytes(e.Invoice.encode())
-	x.Bytes(e.Payload)
+	x.StringBytes(e.Payload)
 	x.String(e.Provider)
 	x.String(e.Start_param)
 	return x.buf
 }
 
+// InputChatPhoto is implemented by constructors of InputChatPhoto type
+type InputChatPhoto interface {
+	TL
+	isInputChatPhoto()
+}
+
 // inputChatPhotoEmpty#1ca48f57 = InputChatPhoto;
 
 const crc_inputChatPhotoEmpty = 0x1ca48f57
@@ -562,6 +710,8 @@ const crc_inputChatPhotoEmpty = 0x1ca48f57
 type TL_inputChatPhotoEmpty struct {
 }
 
+func (e TL_inputChatPhotoEmpty) isInputChatPhoto() {}
+
 // Encoding TL_inputChatPhotoEmpty
 func (e TL_inputChatPhotoEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -574,9 +724,11 @@ func (e TL_inputChatPhotoEmpty) encode() []byte {
 const crc_inputChatUploadedPhoto = 0x927c55b4
 
 type TL_inputChatUploadedPhoto struct {
-	File TL // file:InputFile
+	File InputFile // file:InputFile
 }
 
+func (e TL_inputChatUploadedPhoto) isInputChatPhoto() {}
+
 // Encoding TL_inputChatUploadedPhoto
 func (e TL_inputChatUploadedPhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -590,9 +742,11 @@ func (e TL_inputChatUploadedPhoto) encode() []byte {
 const crc_inputChatPhoto = 0x8953ad37
 
 type TL_inputChatPhoto struct {
-	Id TL // Id:InputPhoto
+	Id InputPhoto // Id:InputPhoto
 }
 
+func (e TL_inputChatPhoto) isInputChatPhoto() {}
+
 // Encoding TL_inputChatPhoto
 func (e TL_inputChatPhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -601,6 +755,12 @@ func (e TL_inputChatPhoto) encode() []byte {
 	return x.buf
 }
 
+// InputGeoPoint is implemented by constructors of InputGeoPoint type
+type InputGeoPoint interface {
+	TL
+	isInputGeoPoint()
+}
+
 // inputGeoPointEmpty#e4c123d6 = InputGeoPoint;
 
 const crc_inputGeoPointEmpty = 0xe4c123d6
@@ -608,6 +768,8 @@ const crc_inputGeoPointEmpty = 0xe4c123d6
 type TL_inputGeoPointEmpty struct {
 }
 
+func (e TL_inputGeoPointEmpty) isInputGeoPoint() {}
+
 // Encoding TL_inputGeoPointEmpty
 func (e TL_inputGeoPointEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -624,6 +786,8 @@ type TL_inputGeoPoint struct {
 	Long float64 // long:double
 }
 
+func (e TL_inputGeoPoint) isInputGeoPoint() {}
+
 // Encoding TL_inputGeoPoint
 func (e TL_inputGeoPoint) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -633,6 +797,12 @@ func (e TL_inputGeoPoint) encode() []byte {
 	return x.buf
 }
 
+// InputPhoto is implemented by constructors of InputPhoto type
+type InputPhoto interface {
+	TL
+	isInputPhoto()
+}
+
 // inputPhotoEmpty#1cd7bf0d = InputPhoto;
 
 const crc_inputPhotoEmpty = 0x1cd7bf0d
@@ -640,6 +810,8 @@ const crc_inputPhotoEmpty = 0x1cd7bf0d
 type TL_inputPhotoEmpty struct {
 }
 
+func (e TL_inputPhotoEmpty) isInputPhoto() {}
+
 // Encoding TL_inputPhotoEmpty
 func (e TL_inputPhotoEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -656,6 +828,8 @@ type TL_inputPhoto struct {
 	Access_hash int64 // access_hash:long
 }
 
+func (e TL_inputPhoto) isInputPhoto() {}
+
 // Encoding TL_inputPhoto
 func (e TL_inputPhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -665,6 +839,12 @@ func (e TL_inputPhoto) encode() []byte {
 	return x.buf
 }
 
+// InputFileLocation is implemented by constructors of InputFileLocation type
+type InputFileLocation interface {
+	TL
+	isInputFileLocation()
+}
+
 // inputFileLocation#14637196 volume_id:long local_id:int secret:long = InputFileLocation;
 
 const crc_inputFileLocation = 0x14637196
@@ -675,6 +855,8 @@ type TL_inputFileLocation struct {
 	Secret    int64 // secret:long
 }
 
+func (e TL_inputFileLocation) isInputFileLocation() {}
+
 // Encoding TL_inputFileLocation
 func (e TL_inputFileLocation) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -694,6 +876,8 @@ type TL_inputEncryptedFileLocation struct {
 	Access_hash int64 // access_hash:long
 }
 
+func (e TL_inputEncryptedFileLocation) isInputFileLocation() {}
+
 // Encoding TL_inputEncryptedFileLocation
 func (e TL_inputEncryptedFileLocation) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -713,6 +897,8 @@ type TL_inputDocumentFileLocation struct {
 	Version     int32 // Version:int
 }
 
+func (e TL_inputDocumentFileLocation) isInputFileLocation() {}
+
 // Encoding TL_inputDocumentFileLocation
 func (e TL_inputDocumentFileLocation) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -723,6 +909,12 @@ func (e TL_inputDocumentFileLocation) encode() []byte {
 	return x.buf
 }
 
+// InputAppEvent is implemented by constructors of InputAppEvent type
+type InputAppEvent interface {
+	TL
+	isInputAppEvent()
+}
+
 // inputAppEvent#770656a8 time:double type:string peer:long data:string = InputAppEvent;
 
 const crc_inputAppEvent = 0x770656a8
@@ -734,6 +926,8 @@ type TL_inputAppEvent struct {
 	Data      string  // data:string
 }
 
+func (e TL_inputAppEvent) isInputAppEvent() {}
+
 // Encoding TL_inputAppEvent
 func (e TL_inputAppEvent) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -745,6 +939,12 @@ func (e TL_inputAppEvent) encode() []byte {
 	return x.buf
 }
 
+// Peer is implemented by constructors of Peer type
+type Peer interface {
+	TL
+	isPeer()
+}
+
 // peerUser#9db1bc6d user_id:int = Peer;
 
 const crc_peerUser = 0x9db1bc6d
@@ -753,6 +953,8 @@ type TL_peerUser struct {
 	User_id int32 // user_id:int
 }
 
+func (e TL_peerUser) isPeer() {}
+
 // Encoding TL_peerUser
 func (e TL_peerUser) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -769,6 +971,8 @@ type TL_peerChat struct {
 	Chat_id int32 // chat_id:int
 }
 
+func (e TL_peerChat) isPeer() {}
+
 // Encoding TL_peerChat
 func (e TL_peerChat) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -785,6 +989,8 @@ type TL_peerChannel struct {
 	Channel_id int32 // channel_id:int
 }
 
+func (e TL_peerChannel) isPeer() {}
+
 // Encoding TL_peerChannel
 func (e TL_peerChannel) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -793,6 +999,12 @@ func (e TL_peerChannel) encode() []byte {
 	return x.buf
 }
 
+// Storage_FileType is implemented by constructors of storage.FileType type
+type Storage_FileType interface {
+	TL
+	isStorage_FileType()
+}
+
 // storage.fileUnknown#aa963b05 = storage.FileType;
 
 const crc_storage_fileUnknown = 0xaa963b05
@@ -800,6 +1012,8 @@ const crc_storage_fileUnknown = 0xaa963b05
 type TL_storage_fileUnknown struct {
 }
 
+func (e TL_storage_fileUnknown) isStorage_FileType() {}
+
 // Encoding TL_storage_fileUnknown
 func (e TL_storage_fileUnknown) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -814,6 +1028,8 @@ const crc_storage_filePartial = 0x40bc6f52
 type TL_storage_filePartial struct {
 }
 
+func (e TL_storage_filePartial) isStorage_FileType() {}
+
 // Encoding TL_storage_filePartial
 func (e TL_storage_filePartial) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -828,6 +1044,8 @@ const crc_storage_fileJpeg = 0x7efe0e
 type TL_storage_fileJpeg struct {
 }
 
+func (e TL_storage_fileJpeg) isStorage_FileType() {}
+
 // Encoding TL_storage_fileJpeg
 func (e TL_storage_fileJpeg) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -842,6 +1060,8 @@ const crc_storage_fileGif = 0xcae1aadf
 type TL_storage_fileGif struct {
 }
 
+func (e TL_storage_fileGif) isStorage_FileType() {}
+
 // Encoding TL_storage_fileGif
 func (e TL_storage_fileGif) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -856,6 +1076,8 @@ const crc_storage_filePng = 0xa4f63c0
 type TL_storage_filePng struct {
 }
 
+func (e TL_storage_filePng) isStorage_FileType() {}
+
 // Encoding TL_storage_filePng
 func (e TL_storage_filePng) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -870,6 +1092,8 @@ const crc_storage_filePdf = 0xae1e508d
 type TL_storage_filePdf struct {
 }
 
+func (e TL_storage_filePdf) isStorage_FileType() {}
+
 // Encoding TL_storage_filePdf
 func (e TL_storage_filePdf) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -884,6 +1108,8 @@ const crc_storage_fileMp3 = 0x528a0677
 type TL_storage_fileMp3 struct {
 }
 
+func (e TL_storage_fileMp3) isStorage_FileType() {}
+
 // Encoding TL_storage_fileMp3
 func (e TL_storage_fileMp3) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -898,6 +1124,8 @@ const crc_storage_fileMov = 0x4b09ebbc
 type TL_storage_fileMov struct {
 }
 
+func (e TL_storage_fileMov) isStorage_FileType() {}
+
 // Encoding TL_storage_fileMov
 func (e TL_storage_fileMov) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -912,6 +1140,8 @@ const crc_storage_fileMp4 = 0xb3cea0e4
 type TL_storage_fileMp4 struct {
 }
 
+func (e TL_storage_fileMp4) isStorage_FileType() {}
+
 // Encoding TL_storage_fileMp4
 func (e TL_storage_fileMp4) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -926,6 +1156,8 @@ const crc_storage_fileWebp = 0x1081464c
 type TL_storage_fileWebp struct {
 }
 
+func (e TL_storage_fileWebp) isStorage_FileType() {}
+
 // Encoding TL_storage_fileWebp
 func (e TL_storage_fileWebp) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -933,6 +1165,12 @@ func (e TL_storage_fileWebp) encode() []byte {
 	return x.buf
 }
 
+// FileLocation is implemented by constructors of FileLocation type
+type FileLocation interface {
+	TL
+	isFileLocation()
+}
+
 // fileLocationUnavailable#7c596b46 volume_id:long local_id:int secret:long = FileLocation;
 
 const crc_fileLocationUnavailable = 0x7c596b46
@@ -943,6 +1181,8 @@ type TL_fileLocationUnavailable struct {
 	Secret    int64 // secret:long
 }
 
+func (e TL_fileLocationUnavailable) isFileLocation() {}
+
 // Encoding TL_fileLocationUnavailable
 func (e TL_fileLocationUnavailable) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -964,6 +1204,8 @@ type TL_fileLocation struct {
 	Secret    int64 // secret:long
 }
 
+func (e TL_fileLocation) isFileLocation() {}
+
 // Encoding TL_fileLocation
 func (e TL_fileLocation) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -975,6 +1217,12 @@ func (e TL_fileLocation) encode() []byte {
 	return x.buf
 }
 
+// User is implemented by constructors of User type
+type User interface {
+	TL
+	isUser()
+}
+
 // userEmpty#200250ba Id:int = User;
 
 const crc_userEmpty = 0x200250ba
@@ -983,6 +1231,8 @@ type TL_userEmpty struct {
 	Id int32 // Id:int
 }
 
+func (e TL_userEmpty) isUser() {}
+
 // Encoding TL_userEmpty
 func (e TL_userEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -997,29 +1247,31 @@ const crc_user = 0xd10d979a
 
 type TL_user struct {
 	Flags                  int32
-	Self                   bool   // self:flags.10?true
-	Contact                bool   // contact:flags.11?true
-	Mutual_contact         bool   // mutual_contact:flags.12?true
-	Deleted                bool   // deleted:flags.13?true
-	Bot                    bool   // bot:flags.14?true
-	Bot_chat_history       bool   // bot_chat_history:flags.15?true
-	Bot_nochats            bool   // bot_nochats:flags.16?true
-	Verified               bool   // verified:flags.17?true
-	Restricted             bool   // restricted:flags.18?true
-	Min                    bool   // min:flags.20?true
-	Bot_inline_geo         bool   // bot_inline_geo:flags.21?true
-	Id                     int32  // Id:int
-	Access_hash            int64  // access_hash:flags.0?long
-	First_name             string // first_name:flags.1?string
-	Last_name              string // last_name:flags.2?string
-	Username               string // username:flags.3?string
-	Phone                  string // phone:flags.4?string
-	Photo                  TL     // photo:flags.5?UserProfilePhoto
-	Status                 TL     // status:flags.6?UserStatus
-	Bot_info_version       int32  // bot_info_version:flags.14?int
-	Restriction_reason     string // restriction_reason:flags.18?string
-	Bot_inline_placeholder string // bot_inline_placeholder:flags.19?string
-}
+	Self                   bool             // self:flags.10?true
+	Contact                bool             // contact:flags.11?true
+	Mutual_contact         bool             // mutual_contact:flags.12?true
+	Deleted                bool             // deleted:flags.13?true
+	Bot                    bool             // bot:flags.14?true
+	Bot_chat_history       bool             // bot_chat_history:flags.15?true
+	Bot_nochats            bool             // bot_nochats:flags.16?true
+	Verified               bool             // verified:flags.17?true
+	Restricted             bool             // restricted:flags.18?true
+	Min                    bool             // min:flags.20?true
+	Bot_inline_geo         bool             // bot_inline_geo:flags.21?true
+	Id                     int32            // Id:int
+	Access_hash            int64            // access_hash:flags.0?long
+	First_name             string           // first_name:flags.1?string
+	Last_name              string           // last_name:flags.2?string
+	Username               string           // username:flags.3?string
+	Phone                  string           // phone:flags.4?string
+	Photo                  UserProfilePhoto // photo:flags.5?UserProfilePhoto
+	Status                 UserStatus       // status:flags.6?UserStatus
+	Bot_info_version       int32            // bot_info_version:flags.14?int
+	Restriction_reason     string           // restriction_reason:flags.18?string
+	Bot_inline_placeholder string           // bot_inline_placeholder:flags.19?string
+}
+
+func (e TL_user) isUser() {}
 
 // Encoding TL_user
 func (e TL_user) encode() []byte {
@@ -1074,10 +1326,10 @@ func (e TL_user) encode() []byte {
 	if e.Phone != "" {
 		flags |= (1 << 4)
 	}
-	if _, ok := (e.Photo).(TL_null); !ok {
+	if e.Photo != nil {
 		flags |= (1 << 5)
 	}
-	if _, ok := (e.Status).(TL_null); !ok {
+	if e.Status != nil {
 		flags |= (1 << 6)
 	}
 	if e.Bot_info_version > 0 {
@@ -1124,6 +1376,12 @@ func (e TL_user) encode() []byte {
 	return x.buf
 }
 
+// UserProfilePhoto is implemented by constructors of UserProfilePhoto type
+type UserProfilePhoto interface {
+	TL
+	isUserProfilePhoto()
+}
+
 // userProfilePhotoEmpty#4f11bae1 = UserProfilePhoto;
 
 const crc_userProfilePhotoEmpty = 0x4f11bae1
@@ -1131,6 +1389,8 @@ const crc_userProfilePhotoEmpty = 0x4f11bae1
 type TL_userProfilePhotoEmpty struct {
 }
 
+func (e TL_userProfilePhotoEmpty) isUserProfilePhoto() {}
+
 // Encoding TL_userProfilePhotoEmpty
 func (e TL_userProfilePhotoEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1143,11 +1403,13 @@ func (e TL_userProfilePhotoEmpty) encode() []byte {
 const crc_userProfilePhoto = 0xd559d8c8
 
 type TL_userProfilePhoto struct {
-	Photo_id    int64 // photo_id:long
-	Photo_small TL    // photo_small:FileLocation
-	Photo_big   TL    // photo_big:FileLocation
+	Photo_id    int64        // photo_id:long
+	Photo_small FileLocation // photo_small:FileLocation
+	Photo_big   FileLocation // photo_big:FileLocation
 }
 
+func (e TL_userProfilePhoto) isUserProfilePhoto() {}
+
 // Encoding TL_userProfilePhoto
 func (e TL_userProfilePhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1158,6 +1420,12 @@ func (e TL_userProfilePhoto) encode() []byte {
 	return x.buf
 }
 
+// UserStatus is implemented by constructors of UserStatus type
+type UserStatus interface {
+	TL
+	isUserStatus()
+}
+
 // userStatusEmpty#9d05049 = UserStatus;
 
 const crc_userStatusEmpty = 0x9d05049
@@ -1165,6 +1433,8 @@ const crc_userStatusEmpty = 0x9d05049
 type TL_userStatusEmpty struct {
 }
 
+func (e TL_userStatusEmpty) isUserStatus() {}
+
 // Encoding TL_userStatusEmpty
 func (e TL_userStatusEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1180,6 +1450,8 @@ type TL_userStatusOnline struct {
 	Expires int32 // expires:int
 }
 
+func (e TL_userStatusOnline) isUserStatus() {}
+
 // Encoding TL_userStatusOnline
 func (e TL_userStatusOnline) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1196,6 +1468,8 @@ type TL_userStatusOffline struct {
 	Was_online int32 // was_online:int
 }
 
+func (e TL_userStatusOffline) isUserStatus() {}
+
 // Encoding TL_userStatusOffline
 func (e TL_userStatusOffline) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1211,6 +1485,8 @@ const crc_userStatusRecently = 0xe26f42f1
 type TL_userStatusRecently struct {
 }
 
+func (e TL_userStatusRecently) isUserStatus() {}
+
 // Encoding TL_userStatusRecently
 func (e TL_userStatusRecently) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1225,6 +1501,8 @@ const crc_userStatusLastWeek = 0x7bf09fc
 type TL_userStatusLastWeek struct {
 }
 
+func (e TL_userStatusLastWeek) isUserStatus() {}
+
 // Encoding TL_userStatusLastWeek
 func (e TL_userStatusLastWeek) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1239,6 +1517,8 @@ const crc_userStatusLastMonth = 0x77ebc742
 type TL_userStatusLastMonth struct {
 }
 
+func (e TL_userStatusLastMonth) isUserStatus() {}
+
 // Encoding TL_userStatusLastMonth
 func (e TL_userStatusLastMonth) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1246,6 +1526,12 @@ func (e TL_userStatusLastMonth) encode() []byte {
 	return x.buf
 }
 
+// Chat is implemented by constructors of Chat type
+type Chat interface {
+	TL
+	isChat()
+}
+
 // chatEmpty#9ba2d800 Id:int = Chat;
 
 const crc_chatEmpty = 0x9ba2d800
@@ -1254,6 +1540,8 @@ type TL_chatEmpty struct {
 	Id int32 // Id:int
 }
 
+func (e TL_chatEmpty) isChat() {}
+
 // Encoding TL_chatEmpty
 func (e TL_chatEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1268,20 +1556,22 @@ const crc_chat = 0xd91cdd54
 
 type TL_chat struct {
 	Flags              int32
-	Creator            bool   // creator:flags.0?true
-	Kicked             bool   // kicked:flags.1?true
-	Left               bool   // left:flags.2?true
-	Admins_enabled     bool   // admins_enabled:flags.3?true
-	Admin              bool   // admin:flags.4?true
-	Deactivated        bool   // deactivated:flags.5?true
-	Id                 int32  // Id:int
-	Title              string // title:string
-	Photo              TL     // photo:ChatPhoto
-	Participants_count int32  // participants_count:int
-	Date               int32  // date:int
-	Version            int32  // Version:int
-	Migrated_to        TL     // migrated_to:flags.6?InputChannel
-}
+	Creator            bool         // creator:flags.0?true
+	Kicked             bool         // kicked:flags.1?true
+	Left               bool         // left:flags.2?true
+	Admins_enabled     bool         // admins_enabled:flags.3?true
+	Admin              bool         // admin:flags.4?true
+	Deactivated        bool         // deactivated:flags.5?true
+	Id                 int32        // Id:int
+	Title              string       // title:string
+	Photo              ChatPhoto    // photo:ChatPhoto
+	Participants_count int32        // participants_count:int
+	Date               int32        // date:int
+	Version            int32        // Version:int
+	Migrated_to        InputChannel // migrated_to:flags.6?InputChannel
+}
+
+func (e TL_chat) isChat() {}
 
 // Encoding TL_chat
 func (e TL_chat) encode() []byte {
@@ -1306,7 +1596,7 @@ func (e TL_chat) encode() []byte {
 	if e.Deactivated {
 		flags |= (1 << 5)
 	}
-	if _, ok := (e.Migrated_to).(TL_null); !ok {
+	if e.Migrated_to != nil {
 		flags |= (1 << 6)
 	}
 	x.Int(flags)
@@ -1331,6 +1621,8 @@ type TL_chatForbidden struct {
 	Title string // title:string
 }
 
+func (e TL_chatForbidden) isChat() {}
+
 // Encoding TL_chatForbidden
 func (e TL_chatForbidden) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1346,27 +1638,29 @@ const crc_channel = 0xa14dca52
 
 type TL_channel struct {
 	Flags              int32
-	Creator            bool   // creator:flags.0?true
-	Kicked             bool   // kicked:flags.1?true
-	Left               bool   // left:flags.2?true
-	Editor             bool   // editor:flags.3?true
-	Moderator          bool   // moderator:flags.4?true
-	Broadcast          bool   // broadcast:flags.5?true
-	Verified           bool   // verified:flags.7?true
-	Megagroup          bool   // megagroup:flags.8?true
-	Restricted         bool   // restricted:flags.9?true
-	Democracy          bool   // democracy:flags.10?true
-	Signatures         bool   // signatures:flags.11?true
-	Min                bool   // min:flags.12?true
-	Id                 int32  // Id:int
-	Access_hash        int64  // access_hash:flags.13?long
-	Title              string // title:string
-	Username           string // username:flags.6?string
-	Photo              TL     // photo:ChatPhoto
-	Date               int32  // date:int
-	Version            int32  // Version:int
-	Restriction_reason string // restriction_reason:flags.9?string
-}
+	Creator            bool      // creator:flags.0?true
+	Kicked             bool      // kicked:flags.1?true
+	Left               bool      // left:flags.2?true
+	Editor             bool      // editor:flags.3?true
+	Moderator          bool      // moderator:flags.4?true
+	Broadcast          bool      // broadcast:flags.5?true
+	Verified           bool      // verified:flags.7?true
+	Megagroup          bool      // megagroup:flags.8?true
+	Restricted         bool      // restricted:flags.9?true
+	Democracy          bool      // democracy:flags.10?true
+	Signatures         bool      // signatures:flags.11?true
+	Min                bool      // min:flags.12?true
+	Id                 int32     // Id:int
+	Access_hash        int64     // access_hash:flags.13?long
+	Title              string    // title:string
+	Username           string    // username:flags.6?string
+	Photo              ChatPhoto // photo:ChatPhoto
+	Date               int32     // date:int
+	Version            int32     // Version:int
+	Restriction_reason string    // restriction_reason:flags.9?string
+}
+
+func (e TL_channel) isChat() {}
 
 // Encoding TL_channel
 func (e TL_channel) encode() []byte {
@@ -1449,6 +1743,8 @@ type TL_channelForbidden struct {
 	Title       string // title:string
 }
 
+func (e TL_channelForbidden) isChat() {}
+
 // Encoding TL_channelForbidden
 func (e TL_channelForbidden) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1467,19 +1763,27 @@ func (e TL_channelForbidden) encode() []byte {
 	return x.buf
 }
 
+// ChatFull is implemented by constructors of ChatFull type
+type ChatFull interface {
+	TL
+	isChatFull()
+}
+
 // chatFull#2e02a614 Id:int participants:ChatParticipants chat_photo:Photo notify_settings:PeerNotifySettings exported_invite:ExportedChatInvite bot_info:Vector<BotInfo> = ChatFull;
 
 const crc_chatFull = 0x2e02a614
 
 type TL_chatFull struct {
-	Id              int32 // Id:int
-	Participants    TL    // participants:ChatParticipants
-	Chat_photo      TL    // chat_photo:Photo
-	Notify_settings TL    // notify_settings:PeerNotifySettings
-	Exported_invite TL    // exported_invite:ExportedChatInvite
-	Bot_info        []TL  // bot_info:Vector<BotInfo>
+	Id              int32              // Id:int
+	Participants    ChatParticipants   // participants:ChatParticipants
+	Chat_photo      Photo              // chat_photo:Photo
+	Notify_settings PeerNotifySettings // notify_settings:PeerNotifySettings
+	Exported_invite ExportedChatInvite // exported_invite:ExportedChatInvite
+	Bot_info        []BotInfo          // bot_info:Vector<BotInfo>
 }
 
+func (e TL_chatFull) isChatFull() {}
+
 // Encoding TL_chatFull
 func (e TL_chatFull) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1489,7 +1793,11 @@ func (e TL_chatFull) encode() []byte {
 	x.Bytes(e.Chat_photo.encode())
 	x.Bytes(e.Notify_settings.encode())
 	x.Bytes(e.Exported_invite.encode())
-	x.Vector(e.Bot_info)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Bot_info)))
+	for _, v := range e.Bot_info {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -1499,24 +1807,26 @@ const crc_channelFull = 0xc3d5512f
 
 type TL_channelFull struct {
 	Flags                 int32
-	Can_view_participants bool   // can_view_participants:flags.3?true
-	Can_set_username      bool   // can_set_username:flags.6?true
-	Id                    int32  // Id:int
-	About                 string // about:string
-	Participants_count    int32  // participants_count:flags.0?int
-	Admins_count          int32  // admins_count:flags.1?int
-	Kicked_count          int32  // kicked_count:flags.2?int
-	Read_inbox_max_id     int32  // read_inbox_max_id:int
-	Read_outbox_max_id    int32  // read_outbox_max_id:int
-	Unread_count          int32  // unread_count:int
-	Chat_photo            TL     // chat_photo:Photo
-	Notify_settings       TL     // notify_settings:PeerNotifySettings
-	Exported_invite       TL     // exported_invite:ExportedChatInvite
-	Bot_info              []TL   // bot_info:Vector<BotInfo>
-	Migrated_from_chat_id int32  // migrated_from_chat_id:flags.4?int
-	Migrated_from_max_id  int32  // migrated_from_max_id:flags.4?int
-	Pinned_msg_id         int32  // pinned_msg_id:flags.5?int
-}
+	Can_view_participants bool               // can_view_participants:flags.3?true
+	Can_set_username      bool               // can_set_username:flags.6?true
+	Id                    int32              // Id:int
+	About                 string             // about:string
+	Participants_count    int32              // participants_count:flags.0?int
+	Admins_count          int32              // admins_count:flags.1?int
+	Kicked_count          int32              // kicked_count:flags.2?int
+	Read_inbox_max_id     int32              // read_inbox_max_id:int
+	Read_outbox_max_id    int32              // read_outbox_max_id:int
+	Unread_count          int32              // unread_count:int
+	Chat_photo            Photo              // chat_photo:Photo
+	Notify_settings       PeerNotifySettings // notify_settings:PeerNotifySettings
+	Exported_invite       ExportedChatInvite // exported_invite:ExportedChatInvite
+	Bot_info              []BotInfo          // bot_info:Vector<BotInfo>
+	Migrated_from_chat_id int32              // migrated_from_chat_id:flags.4?int
+	Migrated_from_max_id  int32              // migrated_from_max_id:flags.4?int
+	Pinned_msg_id         int32              // pinned_msg_id:flags.5?int
+}
+
+func (e TL_channelFull) isChatFull() {}
 
 // Encoding TL_channelFull
 func (e TL_channelFull) encode() []byte {
@@ -1565,7 +1875,11 @@ func (e TL_channelFull) encode() []byte {
 	x.Bytes(e.Chat_photo.encode())
 	x.Bytes(e.Notify_settings.encode())
 	x.Bytes(e.Exported_invite.encode())
-	x.Vector(e.Bot_info)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Bot_info)))
+	for _, v := range e.Bot_info {
+		x.Bytes(v.encode())
+	}
 	if flags&(1<<4) != 0 {
 		x.Int(e.Migrated_from_chat_id)
 	}
@@ -1578,6 +1892,12 @@ func (e TL_channelFull) encode() []byte {
 	return x.buf
 }
 
+// ChatParticipant is implemented by constructors of ChatParticipant type
+type ChatParticipant interface {
+	TL
+	isChatParticipant()
+}
+
 // chatParticipant#c8d7493e user_id:int inviter_id:int date:int = ChatParticipant;
 
 const crc_chatParticipant = 0xc8d7493e
@@ -1588,6 +1908,8 @@ type TL_chatParticipant struct {
 	Date       int32 // date:int
 }
 
+func (e TL_chatParticipant) isChatParticipant() {}
+
 // Encoding TL_chatParticipant
 func (e TL_chatParticipant) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1606,6 +1928,8 @@ type TL_chatParticipantCreator struct {
 	User_id int32 // user_id:int
 }
 
+func (e TL_chatParticipantCreator) isChatParticipant() {}
+
 // Encoding TL_chatParticipantCreator
 func (e TL_chatParticipantCreator) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1624,6 +1948,8 @@ type TL_chatParticipantAdmin struct {
 	Date       int32 // date:int
 }
 
+func (e TL_chatParticipantAdmin) isChatParticipant() {}
+
 // Encoding TL_chatParticipantAdmin
 func (e TL_chatParticipantAdmin) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1634,22 +1960,30 @@ func (e TL_chatParticipantAdmin) encode() []byte {
 	return x.buf
 }
 
+// ChatParticipants is implemented by constructors of ChatParticipants type
+type ChatParticipants interface {
+	TL
+	isChatParticipants()
+}
+
 // chatParticipantsForbidden#fc900c2b flags:# chat_id:int self_participant:flags.0?ChatParticipant = ChatParticipants;
 
 const crc_chatParticipantsForbidden = 0xfc900c2b
 
 type TL_chatParticipantsForbidden struct {
 	Flags            int32
-	Chat_id          int32 // chat_id:int
-	Self_participant TL    // self_participant:flags.0?ChatParticipant
+	Chat_id          int32           // chat_id:int
+	Self_participant ChatParticipant // self_participant:flags.0?ChatParticipant
 }
 
+func (e TL_chatParticipantsForbidden) isChatParticipants() {}
+
 // Encoding TL_chatParticipantsForbidden
 func (e TL_chatParticipantsForbidden) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_chatParticipantsForbidden)
 	var flags int32
-	if _, ok := (e.Self_participant).(TL_null); !ok {
+	if e.Self_participant != nil {
 		flags |= (1 << 0)
 	}
 	x.Int(flags)
@@ -1665,21 +1999,33 @@ func (e TL_chatParticipantsForbidden) encode() []byte {
 const crc_chatParticipants = 0x3f460fed
 
 type TL_chatParticipants struct {
-	Chat_id      int32 // chat_id:int
-	Participants []TL  // participants:Vector<ChatParticipant>
-	Version      int32 // Version:int
+	Chat_id      int32             // chat_id:int
+	Participants []ChatParticipant // participants:Vector<ChatParticipant>
+	Version      int32             // Version:int
 }
 
+func (e TL_chatParticipants) isChatParticipants() {}
+
 // Encoding TL_chatParticipants
 func (e TL_chatParticipants) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_chatParticipants)
 	x.Int(e.Chat_id)
-	x.Vector(e.Participants)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Participants)))
+	for _, v := range e.Participants {
+		x.Bytes(v.encode())
+	}
 	x.Int(e.Version)
 	return x.buf
 }
 
+// ChatPhoto is implemented by constructors of ChatPhoto type
+type ChatPhoto interface {
+	TL
+	isChatPhoto()
+}
+
 // chatPhotoEmpty#37c1011c = ChatPhoto;
 
 const crc_chatPhotoEmpty = 0x37c1011c
@@ -1687,6 +2033,8 @@ const crc_chatPhotoEmpty = 0x37c1011c
 type TL_chatPhotoEmpty struct {
 }
 
+func (e TL_chatPhotoEmpty) isChatPhoto() {}
+
 // Encoding TL_chatPhotoEmpty
 func (e TL_chatPhotoEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1699,10 +2047,12 @@ func (e TL_chatPhotoEmpty) encode() []byte {
 const crc_chatPhoto = 0x6153276a
 
 type TL_chatPhoto struct {
-	Photo_small TL // photo_small:FileLocation
-	Photo_big   TL // photo_big:FileLocation
+	Photo_small FileLocation // photo_small:FileLocation
+	Photo_big   FileLocation // photo_big:FileLocation
 }
 
+func (e TL_chatPhoto) isChatPhoto() {}
+
 // Encoding TL_chatPhoto
 func (e TL_chatPhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1712,6 +2062,12 @@ func (e TL_chatPhoto) encode() []byte {
 	return x.buf
 }
 
+// Message is implemented by constructors of Message type
+type Message interface {
+	TL
+	isMessage()
+}
+
 // messageEmpty#83e5de54 Id:int = Message;
 
 const crc_messageEmpty = 0x83e5de54
@@ -1720,6 +2076,8 @@ type TL_messageEmpty struct {
 	Id int32 // Id:int
 }
 
+func (e TL_messageEmpty) isMessage() {}
+
 // Encoding TL_messageEmpty
 func (e TL_messageEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1734,25 +2092,27 @@ const crc_message = 0xc09be45f
 
 type TL_message struct {
 	Flags           int32
-	Out             bool   // out:flags.1?true
-	Mentioned       bool   // mentioned:flags.4?true
-	Media_unread    bool   // media_unread:flags.5?true
-	Silent          bool   // silent:flags.13?true
-	Post            bool   // post:flags.14?true
-	Id              int32  // Id:int
-	From_id         int32  // from_id:flags.8?int
-	To_id           TL     // to_id:Peer
-	Fwd_from        TL     // fwd_from:flags.2?MessageFwdHeader
-	Via_bot_id      int32  // via_bot_id:flags.11?int
-	Reply_to_msg_id int32  // reply_to_msg_id:flags.3?int
-	Date            int32  // date:int
-	Message         string // message:string
-	Media           TL     // media:flags.9?MessageMedia
-	Reply_markup    TL     // reply_markup:flags.6?ReplyMarkup
-	Entities        []TL   // entities:flags.7?Vector<MessageEntity>
-	Views           int32  // views:flags.10?int
-	Edit_date       int32  // edit_date:flags.15?int
-}
+	Out             bool             // out:flags.1?true
+	Mentioned       bool             // mentioned:flags.4?true
+	Media_unread    bool             // media_unread:flags.5?true
+	Silent          bool             // silent:flags.13?true
+	Post            bool             // post:flags.14?true
+	Id              int32            // Id:int
+	From_id         int32            // from_id:flags.8?int
+	To_id           Peer             // to_id:Peer
+	Fwd_from        MessageFwdHeader // fwd_from:flags.2?MessageFwdHeader
+	Via_bot_id      int32            // via_bot_id:flags.11?int
+	Reply_to_msg_id int32            // reply_to_msg_id:flags.3?int
+	Date            int32            // date:int
+	Message         string           // message:string
+	Media           MessageMedia     // media:flags.9?MessageMedia
+	Reply_markup    ReplyMarkup      // reply_markup:flags.6?ReplyMarkup
+	Entities        []MessageEntity  // entities:flags.7?Vector<MessageEntity>
+	Views           int32            // views:flags.10?int
+	Edit_date       int32            // edit_date:flags.15?int
+}
+
+func (e TL_message) isMessage() {}
 
 // Encoding TL_message
 func (e TL_message) encode() []byte {
@@ -1777,7 +2137,7 @@ func (e TL_message) encode() []byte {
 	if e.From_id > 0 {
 		flags |= (1 << 8)
 	}
-	if _, ok := (e.Fwd_from).(TL_null); !ok {
+	if e.Fwd_from != nil {
 		flags |= (1 << 2)
 	}
 	if e.Via_bot_id > 0 {
@@ -1786,10 +2146,10 @@ func (e TL_message) encode() []byte {
 	if e.Reply_to_msg_id > 0 {
 		flags |= (1 << 3)
 	}
-	if _, ok := (e.Media).(TL_null); !ok {
+	if e.Media != nil {
 		flags |= (1 << 9)
 	}
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 6)
 	}
 	if len(e.Entities) != 0 {
@@ -1825,7 +2185,11 @@ func (e TL_message) encode() []byte {
 		x.Bytes(e.Reply_markup.encode())
 	}
 	if flags&(1<<7) != 0 {
-		x.Vector(e.Entities)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Entities)))
+		for _, v := range e.Entities {
+			x.Bytes(v.encode())
+		}
 	}
 	if flags&(1<<10) != 0 {
 		x.Int(e.Views)
@@ -1842,19 +2206,21 @@ const crc_messageService = 0x9e19a1f6
 
 type TL_messageService struct {
 	Flags           int32
-	Out             bool  // out:flags.1?true
-	Mentioned       bool  // mentioned:flags.4?true
-	Media_unread    bool  // media_unread:flags.5?true
-	Silent          bool  // silent:flags.13?true
-	Post            bool  // post:flags.14?true
-	Id              int32 // Id:int
-	From_id         int32 // from_id:flags.8?int
-	To_id           TL    // to_id:Peer
-	Reply_to_msg_id int32 // reply_to_msg_id:flags.3?int
-	Date            int32 // date:int
-	Action          TL    // action:MessageAction
+	Out             bool          // out:flags.1?true
+	Mentioned       bool          // mentioned:flags.4?true
+	Media_unread    bool          // media_unread:flags.5?true
+	Silent          bool          // silent:flags.13?true
+	Post            bool          // post:flags.14?true
+	Id              int32         // Id:int
+	From_id         int32         // from_id:flags.8?int
+	To_id           Peer          // to_id:Peer
+	Reply_to_msg_id int32         // reply_to_msg_id:flags.3?int
+	Date            int32         // date:int
+	Action          MessageAction // action:MessageAction
 }
 
+func (e TL_messageService) isMessage() {}
+
 // Encoding TL_messageService
 func (e TL_messageService) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1895,6 +2261,12 @@ func (e TL_messageService) encode() []byte {
 	return x.buf
 }
 
+// MessageMedia is implemented by constructors of MessageMedia type
+type MessageMedia interface {
+	TL
+	isMessageMedia()
+}
+
 // messageMediaEmpty#3ded6320 = MessageMedia;
 
 const crc_messageMediaEmpty = 0x3ded6320
@@ -1902,6 +2274,8 @@ const crc_messageMediaEmpty = 0x3ded6320
 type TL_messageMediaEmpty struct {
 }
 
+func (e TL_messageMediaEmpty) isMessageMedia() {}
+
 // Encoding TL_messageMediaEmpty
 func (e TL_messageMediaEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1914,10 +2288,12 @@ func (e TL_messageMediaEmpty) encode() []byte {
 const crc_messageMediaPhoto = 0x3d8ce53d
 
 type TL_messageMediaPhoto struct {
-	Photo   TL     // photo:Photo
+	Photo   Photo  // photo:Photo
 	Caption string // caption:string
 }
 
+func (e TL_messageMediaPhoto) isMessageMedia() {}
+
 // Encoding TL_messageMediaPhoto
 func (e TL_messageMediaPhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1932,9 +2308,11 @@ func (e TL_messageMediaPhoto) encode() []byte {
 const crc_messageMediaGeo = 0x56e0d474
 
 type TL_messageMediaGeo struct {
-	Geo TL // geo:GeoPoint
+	Geo GeoPoint // geo:GeoPoint
 }
 
+func (e TL_messageMediaGeo) isMessageMedia() {}
+
 // Encoding TL_messageMediaGeo
 func (e TL_messageMediaGeo) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1954,6 +2332,8 @@ type TL_messageMediaContact struct {
 	User_id      int32  // user_id:int
 }
 
+func (e TL_messageMediaContact) isMessageMedia() {}
+
 // Encoding TL_messageMediaContact
 func (e TL_messageMediaContact) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1972,6 +2352,8 @@ const crc_messageMediaUnsupported = 0x9f84f49e
 type TL_messageMediaUnsupported struct {
 }
 
+func (e TL_messageMediaUnsupported) isMessageMedia() {}
+
 // Encoding TL_messageMediaUnsupported
 func (e TL_messageMediaUnsupported) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -1984,10 +2366,12 @@ func (e TL_messageMediaUnsupported) encode() []byte {
 const crc_messageMediaDocument = 0xf3e02ea8
 
 type TL_messageMediaDocument struct {
-	Document TL     // document:Document
-	Caption  string // caption:string
+	Document Document // document:Document
+	Caption  string   // caption:string
 }
 
+func (e TL_messageMediaDocument) isMessageMedia() {}
+
 // Encoding TL_messageMediaDocument
 func (e TL_messageMediaDocument) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2002,9 +2386,11 @@ func (e TL_messageMediaDocument) encode() []byte {
 const crc_messageMediaWebPage = 0xa32dd600
 
 type TL_messageMediaWebPage struct {
-	Webpage TL // webpage:WebPage
+	Webpage WebPage // webpage:WebPage
 }
 
+func (e TL_messageMediaWebPage) isMessageMedia() {}
+
 // Encoding TL_messageMediaWebPage
 func (e TL_messageMediaWebPage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2018,13 +2404,15 @@ func (e TL_messageMediaWebPage) encode() []byte {
 const crc_messageMediaVenue = 0x7912b71f
 
 type TL_messageMediaVenue struct {
-	Geo      TL     // geo:GeoPoint
-	Title    string // title:string
-	Address  string // address:string
-	Provider string // provider:string
-	Venue_id string // venue_id:string
+	Geo      GeoPoint // geo:GeoPoint
+	Title    string   // title:string
+	Address  string   // address:string
+	Provider string   // provider:string
+	Venue_id string   // venue_id:string
 }
 
+func (e TL_messageMediaVenue) isMessageMedia() {}
+
 // Encoding TL_messageMediaVenue
 func (e TL_messageMediaVenue) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2042,9 +2430,11 @@ func (e TL_messageMediaVenue) encode() []byte {
 const crc_messageMediaGame = 0xfdb19008
 
 type TL_messageMediaGame struct {
-	Game TL // game:Game
+	Game Game // game:Game
 }
 
+func (e TL_messageMediaGame) isMessageMedia() {}
+
 // Encoding TL_messageMediaGame
 func (e TL_messageMediaGame) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2059,17 +2449,19 @@ const crc_messageMediaInvoice = 0x84551347
 
 type TL_messageMediaInvoice struct {
 	Flags                      int32
-	Shipping_address_requested bool   // shipping_address_requested:flags.1?true
-	Test                       bool   // test:flags.3?true
-	Title                      string // title:string
-	Description                string // description:string
-	Photo                      TL     // photo:flags.0?WebDocument
-	Receipt_msg_id             int32  // receipt_msg_id:flags.2?int
-	Currency                   string // currency:string
-	Total_amount               int64  // total_amount:long
-	Start_param                string // start_param:string
+	Shipping_address_requested bool        // shipping_address_requested:flags.1?true
+	Test                       bool        // test:flags.3?true
+	Title                      string      // title:string
+	Description                string      // description:string
+	Photo                      WebDocument // photo:flags.0?WebDocument
+	Receipt_msg_id             int32       // receipt_msg_id:flags.2?int
+	Currency                   string      // currency:string
+	Total_amount               int64       // total_amount:long
+	Start_param                string      // start_param:string
 }
 
+func (e TL_messageMediaInvoice) isMessageMedia() {}
+
 // Encoding TL_messageMediaInvoice
 func (e TL_messageMediaInvoice) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2081,7 +2473,7 @@ func (e TL_messageMediaInvoice) encode() []byte {
 	if e.Test {
 		flags |= (1 << 3)
 	}
-	if _, ok := (e.Photo).(TL_null); !ok {
+	if e.Photo != nil {
 		flags |= (1 << 0)
 	}
 	if e.Receipt_msg_id > 0 {
@@ -2102,6 +2494,12 @@ func (e TL_messageMediaInvoice) encode() []byte {
 	return x.buf
 }
 
+// MessageAction is implemented by constructors of MessageAction type
+type MessageAction interface {
+	TL
+	isMessageAction()
+}
+
 // messageActionEmpty#b6aef7b0 = MessageAction;
 
 const crc_messageActionEmpty = 0xb6aef7b0
@@ -2109,6 +2507,8 @@ const crc_messageActionEmpty = 0xb6aef7b0
 type TL_messageActionEmpty struct {
 }
 
+func (e TL_messageActionEmpty) isMessageAction() {}
+
 // Encoding TL_messageActionEmpty
 func (e TL_messageActionEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2125,6 +2525,8 @@ type TL_messageActionChatCreate struct {
 	Users []int32 // users:Vector<int>
 }
 
+func (e TL_messageActionChatCreate) isMessageAction() {}
+
 // Encoding TL_messageActionChatCreate
 func (e TL_messageActionChatCreate) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2142,6 +2544,8 @@ type TL_messageActionChatEditTitle struct {
 	Title string // title:string
 }
 
+func (e TL_messageActionChatEditTitle) isMessageAction() {}
+
 // Encoding TL_messageActionChatEditTitle
 func (e TL_messageActionChatEditTitle) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2155,9 +2559,11 @@ func (e TL_messageActionChatEditTitle) encode() []byte {
 const crc_messageActionChatEditPhoto = 0x7fcb13a8
 
 type TL_messageActionChatEditPhoto struct {
-	Photo TL // photo:Photo
+	Photo Photo // photo:Photo
 }
 
+func (e TL_messageActionChatEditPhoto) isMessageAction() {}
+
 // Encoding TL_messageActionChatEditPhoto
 func (e TL_messageActionChatEditPhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2173,6 +2579,8 @@ const crc_messageActionChatDeletePhoto = 0x95e3fbef
 type TL_messageActionChatDeletePhoto struct {
 }
 
+func (e TL_messageActionChatDeletePhoto) isMessageAction() {}
+
 // Encoding TL_messageActionChatDeletePhoto
 func (e TL_messageActionChatDeletePhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2188,6 +2596,8 @@ type TL_messageActionChatAddUser struct {
 	Users []int32 // users:Vector<int>
 }
 
+func (e TL_messageActionChatAddUser) isMessageAction() {}
+
 // Encoding TL_messageActionChatAddUser
 func (e TL_messageActionChatAddUser) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2204,6 +2614,8 @@ type TL_messageActionChatDeleteUser struct {
 	User_id int32 // user_id:int
 }
 
+func (e TL_messageActionChatDeleteUser) isMessageAction() {}
+
 // Encoding TL_messageActionChatDeleteUser
 func (e TL_messageActionChatDeleteUser) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2220,6 +2632,8 @@ type TL_messageActionChatJoinedByLink struct {
 	Inviter_id int32 // inviter_id:int
 }
 
+func (e TL_messageActionChatJoinedByLink) isMessageAction() {}
+
 // Encoding TL_messageActionChatJoinedByLink
 func (e TL_messageActionChatJoinedByLink) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2236,6 +2650,8 @@ type TL_messageActionChannelCreate struct {
 	Title string // title:string
 }
 
+func (e TL_messageActionChannelCreate) isMessageAction() {}
+
 // Encoding TL_messageActionChannelCreate
 func (e TL_messageActionChannelCreate) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2252,6 +2668,8 @@ type TL_messageActionChatMigrateTo struct {
 	Channel_id int32 // channel_id:int
 }
 
+func (e TL_messageActionChatMigrateTo) isMessageAction() {}
+
 // Encoding TL_messageActionChatMigrateTo
 func (e TL_messageActionChatMigrateTo) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2269,6 +2687,8 @@ type TL_messageActionChannelMigrateFrom struct {
 	Chat_id int32  // chat_id:int
 }
 
+func (e TL_messageActionChannelMigrateFrom) isMessageAction() {}
+
 // Encoding TL_messageActionChannelMigrateFrom
 func (e TL_messageActionChannelMigrateFrom) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2285,6 +2705,8 @@ const crc_messageActionPinMessage = 0x94bd38ed
 type TL_messageActionPinMessage struct {
 }
 
+func (e TL_messageActionPinMessage) isMessageAction() {}
+
 // Encoding TL_messageActionPinMessage
 func (e TL_messageActionPinMessage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2299,6 +2721,8 @@ const crc_messageActionHistoryClear = 0x9fbab604
 type TL_messageActionHistoryClear struct {
 }
 
+func (e TL_messageActionHistoryClear) isMessageAction() {}
+
 // Encoding TL_messageActionHistoryClear
 func (e TL_messageActionHistoryClear) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2315,6 +2739,8 @@ type TL_messageActionGameScore struct {
 	Score   int32 // score:int
 }
 
+func (e TL_messageActionGameScore) isMessageAction() {}
+
 // Encoding TL_messageActionGameScore
 func (e TL_messageActionGameScore) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2330,20 +2756,22 @@ const crc_messageActionPaymentSentMe = 0x8f31b327
 
 type TL_messageActionPaymentSentMe struct {
 	Flags              int32
-	Currency           string // currency:string
-	Total_amount       int64  // total_amount:long
-	Payload            []byte // payload:bytes
-	Info               TL     // info:flags.0?PaymentRequestedInfo
-	Shipping_option_id string // shipping_option_id:flags.1?string
-	Charge             TL     // charge:PaymentCharge
+	Currency           string               // currency:string
+	Total_amount       int64                // total_amount:long
+	Payload            []byte               // payload:bytes
+	Info               PaymentRequestedInfo // info:flags.0?PaymentRequestedInfo
+	Shipping_option_id string               // shipping_option_id:flags.1?string
+	Charge             PaymentCharge        // charge:PaymentCharge
 }
 
+func (e TL_messageActionPaymentSentMe) isMessageAction() {}
+
 // Encoding TL_messageActionPaymentSentMe
 func (e TL_messageActionPaymentSentMe) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messageActionPaymentSentMe)
 	var flags int32
-	if _, ok := (e.Info).(TL_null); !ok {
+	if e.Info != nil {
 		flags |= (1 << 0)
 	}
 	if e.Shipping_option_id != "" {
@@ -2352,7 +2780,7 @@ func (e TL_messageActionPaymentSentMe) encode() []byte {
 	x.Int(flags)
 	x.String(e.Currency)
 	x.Long(e.Total_amount)
-	x.Bytes(e.Payload)
+	x.StringBytes(e.Payload)
 	if flags&(1<<0) != 0 {
 		x.Bytes(e.Info.encode())
 	}
@@ -2372,6 +2800,8 @@ type TL_messageActionPaymentSent struct {
 	Total_amount int64  // total_amount:long
 }
 
+func (e TL_messageActionPaymentSent) isMessageAction() {}
+
 // Encoding TL_messageActionPaymentSent
 func (e TL_messageActionPaymentSent) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2387,17 +2817,19 @@ const crc_messageActionPhoneCall = 0x80e11a7f
 
 type TL_messageActionPhoneCall struct {
 	Flags    int32
-	Call_id  int64 // call_id:long
-	Reason   TL    // reason:flags.0?PhoneCallDiscardReason
-	Duration int32 // duration:flags.1?int
+	Call_id  int64                  // call_id:long
+	Reason   PhoneCallDiscardReason // reason:flags.0?PhoneCallDiscardReason
+	Duration int32                  // duration:flags.1?int
 }
 
+func (e TL_messageActionPhoneCall) isMessageAction() {}
+
 // Encoding TL_messageActionPhoneCall
 func (e TL_messageActionPhoneCall) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messageActionPhoneCall)
 	var flags int32
-	if _, ok := (e.Reason).(TL_null); !ok {
+	if e.Reason != nil {
 		flags |= (1 << 0)
 	}
 	if e.Duration > 0 {
@@ -2414,23 +2846,31 @@ func (e TL_messageActionPhoneCall) encode() []byte {
 	return x.buf
 }
 
+// Dialog is implemented by constructors of Dialog type
+type Dialog interface {
+	TL
+	isDialog()
+}
+
 // dialog#66ffba14 flags:# pinned:flags.2?true peer:Peer top_message:int read_inbox_max_id:int read_outbox_max_id:int unread_count:int notify_settings:PeerNotifySettings pts:flags.0?int draft:flags.1?DraftMessage = Dialog;
 
 const crc_dialog = 0x66ffba14
 
 type TL_dialog struct {
 	Flags              int32
-	Pinned             bool  // pinned:flags.2?true
-	Peer               TL    // peer:Peer
-	Top_message        int32 // top_message:int
-	Read_inbox_max_id  int32 // read_inbox_max_id:int
-	Read_outbox_max_id int32 // read_outbox_max_id:int
-	Unread_count       int32 // unread_count:int
-	Notify_settings    TL    // notify_settings:PeerNotifySettings
-	Pts                int32 // pts:flags.0?int
-	Draft              TL    // draft:flags.1?DraftMessage
+	Pinned             bool               // pinned:flags.2?true
+	Peer               Peer               // peer:Peer
+	Top_message        int32              // top_message:int
+	Read_inbox_max_id  int32              // read_inbox_max_id:int
+	Read_outbox_max_id int32              // read_outbox_max_id:int
+	Unread_count       int32              // unread_count:int
+	Notify_settings    PeerNotifySettings // notify_settings:PeerNotifySettings
+	Pts                int32              // pts:flags.0?int
+	Draft              DraftMessage       // draft:flags.1?DraftMessage
 }
 
+func (e TL_dialog) isDialog() {}
+
 // Encoding TL_dialog
 func (e TL_dialog) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2442,7 +2882,7 @@ func (e TL_dialog) encode() []byte {
 	if e.Pts > 0 {
 		flags |= (1 << 0)
 	}
-	if _, ok := (e.Draft).(TL_null); !ok {
+	if e.Draft != nil {
 		flags |= (1 << 1)
 	}
 	x.Int(flags)
@@ -2461,6 +2901,12 @@ func (e TL_dialog) encode() []byte {
 	return x.buf
 }
 
+// Photo is implemented by constructors of Photo type
+type Photo interface {
+	TL
+	isPhoto()
+}
+
 // photoEmpty#2331b22d Id:long = Photo;
 
 const crc_photoEmpty = 0x2331b22d
@@ -2469,6 +2915,8 @@ type TL_photoEmpty struct {
 	Id int64 // Id:long
 }
 
+func (e TL_photoEmpty) isPhoto() {}
+
 // Encoding TL_photoEmpty
 func (e TL_photoEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2483,13 +2931,15 @@ const crc_photo = 0x9288dd29
 
 type TL_photo struct {
 	Flags        int32
-	Has_stickers bool  // has_stickers:flags.0?true
-	Id           int64 // Id:long
-	Access_hash  int64 // access_hash:long
-	Date         int32 // date:int
-	Sizes        []TL  // sizes:Vector<PhotoSize>
+	Has_stickers bool        // has_stickers:flags.0?true
+	Id           int64       // Id:long
+	Access_hash  int64       // access_hash:long
+	Date         int32       // date:int
+	Sizes        []PhotoSize // sizes:Vector<PhotoSize>
 }
 
+func (e TL_photo) isPhoto() {}
+
 // Encoding TL_photo
 func (e TL_photo) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2502,10 +2952,20 @@ func (e TL_photo) encode() []byte {
 	x.Long(e.Id)
 	x.Long(e.Access_hash)
 	x.Int(e.Date)
-	x.Vector(e.Sizes)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Sizes)))
+	for _, v := range e.Sizes {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// PhotoSize is implemented by constructors of PhotoSize type
+type PhotoSize interface {
+	TL
+	isPhotoSize()
+}
+
 // photoSizeEmpty#e17e23c type:string = PhotoSize;
 
 const crc_photoSizeEmpty = 0xe17e23c
@@ -2514,6 +2974,8 @@ type TL_photoSizeEmpty struct {
 	Code_type string // type:string
 }
 
+func (e TL_photoSizeEmpty) isPhotoSize() {}
+
 // Encoding TL_photoSizeEmpty
 func (e TL_photoSizeEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2527,13 +2989,15 @@ func (e TL_photoSizeEmpty) encode() []byte {
 const crc_photoSize = 0x77bfb61b
 
 type TL_photoSize struct {
-	Code_type string // type:string
-	Location  TL     // location:FileLocation
-	W         int32  // w:int
-	H         int32  // h:int
-	Size      int32  // size:int
+	Code_type string       // type:string
+	Location  FileLocation // location:FileLocation
+	W         int32        // w:int
+	H         int32        // h:int
+	Size      int32        // size:int
 }
 
+func (e TL_photoSize) isPhotoSize() {}
+
 // Encoding TL_photoSize
 func (e TL_photoSize) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2551,13 +3015,15 @@ func (e TL_photoSize) encode() []byte {
 const crc_photoCachedSize = 0xe9a734fa
 
 type TL_photoCachedSize struct {
-	Code_type string // type:string
-	Location  TL     // location:FileLocation
-	W         int32  // w:int
-	H         int32  // h:int
-	Bytes     []byte // bytes:bytes
+	Code_type string       // type:string
+	Location  FileLocation // location:FileLocation
+	W         int32        // w:int
+	H         int32        // h:int
+	Bytes     []byte       // bytes:bytes
 }
 
+func (e TL_photoCachedSize) isPhotoSize() {}
+
 // Encoding TL_photoCachedSize
 func (e TL_photoCachedSize) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2566,10 +3032,16 @@ func (e TL_photoCachedSize) encode() []byte {
 	x.Bytes(e.Location.encode())
 	x.Int(e.W)
 	x.Int(e.H)
-	x.Bytes(e.Bytes)
+	x.StringBytes(e.Bytes)
 	return x.buf
 }
 
+// GeoPoint is implemented by constructors of GeoPoint type
+type GeoPoint interface {
+	TL
+	isGeoPoint()
+}
+
 // geoPointEmpty#1117dd5f = GeoPoint;
 
 const crc_geoPointEmpty = 0x1117dd5f
@@ -2577,6 +3049,8 @@ const crc_geoPointEmpty = 0x1117dd5f
 type TL_geoPointEmpty struct {
 }
 
+func (e TL_geoPointEmpty) isGeoPoint() {}
+
 // Encoding TL_geoPointEmpty
 func (e TL_geoPointEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2593,6 +3067,8 @@ type TL_geoPoint struct {
 	Lat  float64 // lat:double
 }
 
+func (e TL_geoPoint) isGeoPoint() {}
+
 // Encoding TL_geoPoint
 func (e TL_geoPoint) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2602,14 +3078,22 @@ func (e TL_geoPoint) encode() []byte {
 	return x.buf
 }
 
+// Auth_CheckedPhone is implemented by constructors of auth.CheckedPhone type
+type Auth_CheckedPhone interface {
+	TL
+	isAuth_CheckedPhone()
+}
+
 // auth.checkedPhone#811ea28e phone_registered:Bool = auth.CheckedPhone;
 
 const crc_auth_checkedPhone = 0x811ea28e
 
 type TL_auth_checkedPhone struct {
-	Phone_registered TL // phone_registered:Bool
+	Phone_registered Bool // phone_registered:Bool
 }
 
+func (e TL_auth_checkedPhone) isAuth_CheckedPhone() {}
+
 // Encoding TL_auth_checkedPhone
 func (e TL_auth_checkedPhone) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2618,19 +3102,27 @@ func (e TL_auth_checkedPhone) encode() []byte {
 	return x.buf
 }
 
+// Auth_SentCode is implemented by constructors of auth.SentCode type
+type Auth_SentCode interface {
+	TL
+	isAuth_SentCode()
+}
+
 // auth.sentCode#5e002502 flags:# phone_registered:flags.0?true type:auth.SentCodeType phone_code_hash:string next_type:flags.1?auth.CodeType timeout:flags.2?int = auth.SentCode;
 
 const crc_auth_sentCode = 0x5e002502
 
 type TL_auth_sentCode struct {
 	Flags            int32
-	Phone_registered bool   // phone_registered:flags.0?true
-	Code_type        TL     // type:auth.SentCodeType
-	Phone_code_hash  string // phone_code_hash:string
-	Next_type        TL     // next_type:flags.1?auth.CodeType
-	Timeout          int32  // timeout:flags.2?int
+	Phone_registered bool              // phone_registered:flags.0?true
+	Code_type        Auth_SentCodeType // type:auth.SentCodeType
+	Phone_code_hash  string            // phone_code_hash:string
+	Next_type        Auth_CodeType     // next_type:flags.1?auth.CodeType
+	Timeout          int32             // timeout:flags.2?int
 }
 
+func (e TL_auth_sentCode) isAuth_SentCode() {}
+
 // Encoding TL_auth_sentCode
 func (e TL_auth_sentCode) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2639,7 +3131,7 @@ func (e TL_auth_sentCode) encode() []byte {
 	if e.Phone_registered {
 		flags |= (1 << 0)
 	}
-	if _, ok := (e.Next_type).(TL_null); !ok {
+	if e.Next_type != nil {
 		flags |= (1 << 1)
 	}
 	if e.Timeout > 0 {
@@ -2657,6 +3149,12 @@ func (e TL_auth_sentCode) encode() []byte {
 	return x.buf
 }
 
+// Auth_Authorization is implemented by constructors of auth.Authorization type
+type Auth_Authorization interface {
+	TL
+	isAuth_Authorization()
+}
+
 // auth.authorization#cd050916 flags:# tmp_sessions:flags.0?int user:User = auth.Authorization;
 
 const crc_auth_authorization = 0xcd050916
@@ -2664,9 +3162,11 @@ const crc_auth_authorization = 0xcd050916
 type TL_auth_authorization struct {
 	Flags        int32
 	Tmp_sessions int32 // tmp_sessions:flags.0?int
-	User         TL    // user:User
+	User         User  // user:User
 }
 
+func (e TL_auth_authorization) isAuth_Authorization() {}
+
 // Encoding TL_auth_authorization
 func (e TL_auth_authorization) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2683,6 +3183,12 @@ func (e TL_auth_authorization) encode() []byte {
 	return x.buf
 }
 
+// Auth_ExportedAuthorization is implemented by constructors of auth.ExportedAuthorization type
+type Auth_ExportedAuthorization interface {
+	TL
+	isAuth_ExportedAuthorization()
+}
+
 // auth.exportedAuthorization#df969c2d Id:int bytes:bytes = auth.ExportedAuthorization;
 
 const crc_auth_exportedAuthorization = 0xdf969c2d
@@ -2692,23 +3198,33 @@ type TL_auth_exportedAuthorization struct {
 	Bytes []byte // bytes:bytes
 }
 
+func (e TL_auth_exportedAuthorization) isAuth_ExportedAuthorization() {}
+
 // Encoding TL_auth_exportedAuthorization
 func (e TL_auth_exportedAuthorization) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_auth_exportedAuthorization)
 	x.Int(e.Id)
-	x.Bytes(e.Bytes)
+	x.StringBytes(e.Bytes)
 	return x.buf
 }
 
+// InputNotifyPeer is implemented by constructors of InputNotifyPeer type
+type InputNotifyPeer interface {
+	TL
+	isInputNotifyPeer()
+}
+
 // inputNotifyPeer#b8bc5b0c peer:InputPeer = InputNotifyPeer;
 
 const crc_inputNotifyPeer = 0xb8bc5b0c
 
 type TL_inputNotifyPeer struct {
-	Peer TL // peer:InputPeer
+	Peer InputPeer // peer:InputPeer
 }
 
+func (e TL_inputNotifyPeer) isInputNotifyPeer() {}
+
 // Encoding TL_inputNotifyPeer
 func (e TL_inputNotifyPeer) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2724,6 +3240,8 @@ const crc_inputNotifyUsers = 0x193b4417
 type TL_inputNotifyUsers struct {
 }
 
+func (e TL_inputNotifyUsers) isInputNotifyPeer() {}
+
 // Encoding TL_inputNotifyUsers
 func (e TL_inputNotifyUsers) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2738,6 +3256,8 @@ const crc_inputNotifyChats = 0x4a95e84e
 type TL_inputNotifyChats struct {
 }
 
+func (e TL_inputNotifyChats) isInputNotifyPeer() {}
+
 // Encoding TL_inputNotifyChats
 func (e TL_inputNotifyChats) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2752,6 +3272,8 @@ const crc_inputNotifyAll = 0xa429b886
 type TL_inputNotifyAll struct {
 }
 
+func (e TL_inputNotifyAll) isInputNotifyPeer() {}
+
 // Encoding TL_inputNotifyAll
 func (e TL_inputNotifyAll) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2759,6 +3281,12 @@ func (e TL_inputNotifyAll) encode() []byte {
 	return x.buf
 }
 
+// InputPeerNotifyEvents is implemented by constructors of InputPeerNotifyEvents type
+type InputPeerNotifyEvents interface {
+	TL
+	isInputPeerNotifyEvents()
+}
+
 // inputPeerNotifyEventsEmpty#f03064d8 = InputPeerNotifyEvents;
 
 const crc_inputPeerNotifyEventsEmpty = 0xf03064d8
@@ -2766,6 +3294,8 @@ const crc_inputPeerNotifyEventsEmpty = 0xf03064d8
 type TL_inputPeerNotifyEventsEmpty struct {
 }
 
+func (e TL_inputPeerNotifyEventsEmpty) isInputPeerNotifyEvents() {}
+
 // Encoding TL_inputPeerNotifyEventsEmpty
 func (e TL_inputPeerNotifyEventsEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2780,6 +3310,8 @@ const crc_inputPeerNotifyEventsAll = 0xe86a2c74
 type TL_inputPeerNotifyEventsAll struct {
 }
 
+func (e TL_inputPeerNotifyEventsAll) isInputPeerNotifyEvents() {}
+
 // Encoding TL_inputPeerNotifyEventsAll
 func (e TL_inputPeerNotifyEventsAll) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2787,6 +3319,12 @@ func (e TL_inputPeerNotifyEventsAll) encode() []byte {
 	return x.buf
 }
 
+// InputPeerNotifySettings is implemented by constructors of InputPeerNotifySettings type
+type InputPeerNotifySettings interface {
+	TL
+	isInputPeerNotifySettings()
+}
+
 // inputPeerNotifySettings#38935eb2 flags:# show_previews:flags.0?true silent:flags.1?true mute_until:int sound:string = InputPeerNotifySettings;
 
 const crc_inputPeerNotifySettings = 0x38935eb2
@@ -2799,6 +3337,8 @@ type TL_inputPeerNotifySettings struct {
 	Sound         string // sound:string
 }
 
+func (e TL_inputPeerNotifySettings) isInputPeerNotifySettings() {}
+
 // Encoding TL_inputPeerNotifySettings
 func (e TL_inputPeerNotifySettings) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2816,6 +3356,12 @@ func (e TL_inputPeerNotifySettings) encode() []byte {
 	return x.buf
 }
 
+// PeerNotifyEvents is implemented by constructors of PeerNotifyEvents type
+type PeerNotifyEvents interface {
+	TL
+	isPeerNotifyEvents()
+}
+
 // peerNotifyEventsEmpty#add53cb3 = PeerNotifyEvents;
 
 const crc_peerNotifyEventsEmpty = 0xadd53cb3
@@ -2823,6 +3369,8 @@ const crc_peerNotifyEventsEmpty = 0xadd53cb3
 type TL_peerNotifyEventsEmpty struct {
 }
 
+func (e TL_peerNotifyEventsEmpty) isPeerNotifyEvents() {}
+
 // Encoding TL_peerNotifyEventsEmpty
 func (e TL_peerNotifyEventsEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2837,6 +3385,8 @@ const crc_peerNotifyEventsAll = 0x6d1ded88
 type TL_peerNotifyEventsAll struct {
 }
 
+func (e TL_peerNotifyEventsAll) isPeerNotifyEvents() {}
+
 // Encoding TL_peerNotifyEventsAll
 func (e TL_peerNotifyEventsAll) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2844,6 +3394,12 @@ func (e TL_peerNotifyEventsAll) encode() []byte {
 	return x.buf
 }
 
+// PeerNotifySettings is implemented by constructors of PeerNotifySettings type
+type PeerNotifySettings interface {
+	TL
+	isPeerNotifySettings()
+}
+
 // peerNotifySettingsEmpty#70a68512 = PeerNotifySettings;
 
 const crc_peerNotifySettingsEmpty = 0x70a68512
@@ -2851,6 +3407,8 @@ const crc_peerNotifySettingsEmpty = 0x70a68512
 type TL_peerNotifySettingsEmpty struct {
 }
 
+func (e TL_peerNotifySettingsEmpty) isPeerNotifySettings() {}
+
 // Encoding TL_peerNotifySettingsEmpty
 func (e TL_peerNotifySettingsEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2870,6 +3428,8 @@ type TL_peerNotifySettings struct {
 	Sound         string // sound:string
 }
 
+func (e TL_peerNotifySettings) isPeerNotifySettings() {}
+
 // Encoding TL_peerNotifySettings
 func (e TL_peerNotifySettings) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2887,6 +3447,12 @@ func (e TL_peerNotifySettings) encode() []byte {
 	return x.buf
 }
 
+// PeerSettings is implemented by constructors of PeerSettings type
+type PeerSettings interface {
+	TL
+	isPeerSettings()
+}
+
 // peerSettings#818426cd flags:# report_spam:flags.0?true = PeerSettings;
 
 const crc_peerSettings = 0x818426cd
@@ -2896,6 +3462,8 @@ type TL_peerSettings struct {
 	Report_spam bool // report_spam:flags.0?true
 }
 
+func (e TL_peerSettings) isPeerSettings() {}
+
 // Encoding TL_peerSettings
 func (e TL_peerSettings) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2908,24 +3476,36 @@ func (e TL_peerSettings) encode() []byte {
 	return x.buf
 }
 
+// WallPaper is implemented by constructors of WallPaper type
+type WallPaper interface {
+	TL
+	isWallPaper()
+}
+
 // wallPaper#ccb03657 Id:int title:string sizes:Vector<PhotoSize> color:int = WallPaper;
 
 const crc_wallPaper = 0xccb03657
 
 type TL_wallPaper struct {
-	Id    int32  // Id:int
-	Title string // title:string
-	Sizes []TL   // sizes:Vector<PhotoSize>
-	Color int32  // color:int
+	Id    int32       // Id:int
+	Title string      // title:string
+	Sizes []PhotoSize // sizes:Vector<PhotoSize>
+	Color int32       // color:int
 }
 
+func (e TL_wallPaper) isWallPaper() {}
+
 // Encoding TL_wallPaper
 func (e TL_wallPaper) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_wallPaper)
 	x.Int(e.Id)
 	x.String(e.Title)
-	x.Vector(e.Sizes)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Sizes)))
+	for _, v := range e.Sizes {
+		x.Bytes(v.encode())
+	}
 	x.Int(e.Color)
 	return x.buf
 }
@@ -2941,6 +3521,8 @@ type TL_wallPaperSolid struct {
 	Color    int32  // color:int
 }
 
+func (e TL_wallPaperSolid) isWallPaper() {}
+
 // Encoding TL_wallPaperSolid
 func (e TL_wallPaperSolid) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2952,6 +3534,12 @@ func (e TL_wallPaperSolid) encode() []byte {
 	return x.buf
 }
 
+// ReportReason is implemented by constructors of ReportReason type
+type ReportReason interface {
+	TL
+	isReportReason()
+}
+
 // inputReportReasonSpam#58dbcab8 = ReportReason;
 
 const crc_inputReportReasonSpam = 0x58dbcab8
@@ -2959,6 +3547,8 @@ const crc_inputReportReasonSpam = 0x58dbcab8
 type TL_inputReportReasonSpam struct {
 }
 
+func (e TL_inputReportReasonSpam) isReportReason() {}
+
 // Encoding TL_inputReportReasonSpam
 func (e TL_inputReportReasonSpam) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2973,6 +3563,8 @@ const crc_inputReportReasonViolence = 0x1e22c78d
 type TL_inputReportReasonViolence struct {
 }
 
+func (e TL_inputReportReasonViolence) isReportReason() {}
+
 // Encoding TL_inputReportReasonViolence
 func (e TL_inputReportReasonViolence) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -2987,6 +3579,8 @@ const crc_inputReportReasonPornography = 0x2e59d922
 type TL_inputReportReasonPornography struct {
 }
 
+func (e TL_inputReportReasonPornography) isReportReason() {}
+
 // Encoding TL_inputReportReasonPornography
 func (e TL_inputReportReasonPornography) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3002,6 +3596,8 @@ type TL_inputReportReasonOther struct {
 	Text string // text:string
 }
 
+func (e TL_inputReportReasonOther) isReportReason() {}
+
 // Encoding TL_inputReportReasonOther
 func (e TL_inputReportReasonOther) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3010,24 +3606,32 @@ func (e TL_inputReportReasonOther) encode() []byte {
 	return x.buf
 }
 
+// UserFull is implemented by constructors of UserFull type
+type UserFull interface {
+	TL
+	isUserFull()
+}
+
 // userFull#f220f3f flags:# blocked:flags.0?true phone_calls_available:flags.4?true phone_calls_private:flags.5?true user:User about:flags.1?string link:contacts.Link profile_photo:flags.2?Photo notify_settings:PeerNotifySettings bot_info:flags.3?BotInfo common_chats_count:int = UserFull;
 
 const crc_userFull = 0xf220f3f
 
 type TL_userFull struct {
 	Flags                 int32
-	Blocked               bool   // blocked:flags.0?true
-	Phone_calls_available bool   // phone_calls_available:flags.4?true
-	Phone_calls_private   bool   // phone_calls_private:flags.5?true
-	User                  TL     // user:User
-	About                 string // about:flags.1?string
-	Link                  TL     // link:contacts.Link
-	Profile_photo         TL     // profile_photo:flags.2?Photo
-	Notify_settings       TL     // notify_settings:PeerNotifySettings
-	Bot_info              TL     // bot_info:flags.3?BotInfo
-	Common_chats_count    int32  // common_chats_count:int
+	Blocked               bool               // blocked:flags.0?true
+	Phone_calls_available bool               // phone_calls_available:flags.4?true
+	Phone_calls_private   bool               // phone_calls_private:flags.5?true
+	User                  User               // user:User
+	About                 string             // about:flags.1?string
+	Link                  Contacts_Link      // link:contacts.Link
+	Profile_photo         Photo              // profile_photo:flags.2?Photo
+	Notify_settings       PeerNotifySettings // notify_settings:PeerNotifySettings
+	Bot_info              BotInfo            // bot_info:flags.3?BotInfo
+	Common_chats_count    int32              // common_chats_count:int
 }
 
+func (e TL_userFull) isUserFull() {}
+
 // Encoding TL_userFull
 func (e TL_userFull) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3045,10 +3649,10 @@ func (e TL_userFull) encode() []byte {
 	if e.About != "" {
 		flags |= (1 << 1)
 	}
-	if _, ok := (e.Profile_photo).(TL_null); !ok {
+	if e.Profile_photo != nil {
 		flags |= (1 << 2)
 	}
-	if _, ok := (e.Bot_info).(TL_null); !ok {
+	if e.Bot_info != nil {
 		flags |= (1 << 3)
 	}
 	x.Int(flags)
@@ -3068,15 +3672,23 @@ func (e TL_userFull) encode() []byte {
 	return x.buf
 }
 
+// Contact is implemented by constructors of Contact type
+type Contact interface {
+	TL
+	isContact()
+}
+
 // contact#f911c994 user_id:int mutual:Bool = Contact;
 
 const crc_contact = 0xf911c994
 
 type TL_contact struct {
 	User_id int32 // user_id:int
-	Mutual  TL    // mutual:Bool
+	Mutual  Bool  // mutual:Bool
 }
 
+func (e TL_contact) isContact() {}
+
 // Encoding TL_contact
 func (e TL_contact) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3086,6 +3698,12 @@ func (e TL_contact) encode() []byte {
 	return x.buf
 }
 
+// ImportedContact is implemented by constructors of ImportedContact type
+type ImportedContact interface {
+	TL
+	isImportedContact()
+}
+
 // importedContact#d0028438 user_id:int client_id:long = ImportedContact;
 
 const crc_importedContact = 0xd0028438
@@ -3095,6 +3713,8 @@ type TL_importedContact struct {
 	Client_id int64 // client_id:long
 }
 
+func (e TL_importedContact) isImportedContact() {}
+
 // Encoding TL_importedContact
 func (e TL_importedContact) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3104,6 +3724,12 @@ func (e TL_importedContact) encode() []byte {
 	return x.buf
 }
 
+// ContactBlocked is implemented by constructors of ContactBlocked type
+type ContactBlocked interface {
+	TL
+	isContactBlocked()
+}
+
 // contactBlocked#561bc879 user_id:int date:int = ContactBlocked;
 
 const crc_contactBlocked = 0x561bc879
@@ -3113,6 +3739,8 @@ type TL_contactBlocked struct {
 	Date    int32 // date:int
 }
 
+func (e TL_contactBlocked) isContactBlocked() {}
+
 // Encoding TL_contactBlocked
 func (e TL_contactBlocked) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3122,15 +3750,23 @@ func (e TL_contactBlocked) encode() []byte {
 	return x.buf
 }
 
+// ContactStatus is implemented by constructors of ContactStatus type
+type ContactStatus interface {
+	TL
+	isContactStatus()
+}
+
 // contactStatus#d3680c61 user_id:int status:UserStatus = ContactStatus;
 
 const crc_contactStatus = 0xd3680c61
 
 type TL_contactStatus struct {
-	User_id int32 // user_id:int
-	Status  TL    // status:UserStatus
+	User_id int32      // user_id:int
+	Status  UserStatus // status:UserStatus
 }
 
+func (e TL_contactStatus) isContactStatus() {}
+
 // Encoding TL_contactStatus
 func (e TL_contactStatus) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3140,16 +3776,24 @@ func (e TL_contactStatus) encode() []byte {
 	return x.buf
 }
 
+// Contacts_Link is implemented by constructors of contacts.Link type
+type Contacts_Link interface {
+	TL
+	isContacts_Link()
+}
+
 // contacts.link#3ace484c my_link:ContactLink foreign_link:ContactLink user:User = contacts.Link;
 
 const crc_contacts_link = 0x3ace484c
 
 type TL_contacts_link struct {
-	My_link      TL // my_link:ContactLink
-	Foreign_link TL // foreign_link:ContactLink
-	User         TL // user:User
+	My_link      ContactLink // my_link:ContactLink
+	Foreign_link ContactLink // foreign_link:ContactLink
+	User         User        // user:User
 }
 
+func (e TL_contacts_link) isContacts_Link() {}
+
 // Encoding TL_contacts_link
 func (e TL_contacts_link) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3160,6 +3804,12 @@ func (e TL_contacts_link) encode() []byte {
 	return x.buf
 }
 
+// Contacts_Contacts is implemented by constructors of contacts.Contacts type
+type Contacts_Contacts interface {
+	TL
+	isContacts_Contacts()
+}
+
 // contacts.contactsNotModified#b74ba9d2 = contacts.Contacts;
 
 const crc_contacts_contactsNotModified = 0xb74ba9d2
@@ -3167,6 +3817,8 @@ const crc_contacts_contactsNotModified = 0xb74ba9d2
 type TL_contacts_contactsNotModified struct {
 }
 
+func (e TL_contacts_contactsNotModified) isContacts_Contacts() {}
+
 // Encoding TL_contacts_contactsNotModified
 func (e TL_contacts_contactsNotModified) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3179,54 +3831,96 @@ func (e TL_contacts_contactsNotModified) encode() []byte {
 const crc_contacts_contacts = 0x6f8b8cb2
 
 type TL_contacts_contacts struct {
-	Contacts []TL // contacts:Vector<Contact>
-	Users    []TL // users:Vector<User>
+	Contacts []Contact // contacts:Vector<Contact>
+	Users    []User    // users:Vector<User>
 }
 
+func (e TL_contacts_contacts) isContacts_Contacts() {}
+
 // Encoding TL_contacts_contacts
 func (e TL_contacts_contacts) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_contacts_contacts)
-	x.Vector(e.Contacts)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Contacts)))
+	for _, v := range e.Contacts {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Contacts_ImportedContacts is implemented by constructors of contacts.ImportedContacts type
+type Contacts_ImportedContacts interface {
+	TL
+	isContacts_ImportedContacts()
+}
+
 // contacts.importedContacts#ad524315 imported:Vector<ImportedContact> retry_contacts:Vector<long> users:Vector<User> = contacts.ImportedContacts;
 
 const crc_contacts_importedContacts = 0xad524315
 
 type TL_contacts_importedContacts struct {
-	Imported       []TL    // imported:Vector<ImportedContact>
-	Retry_contacts []int64 // retry_contacts:Vector<long>
-	Users          []TL    // users:Vector<User>
+	Imported       []ImportedContact // imported:Vector<ImportedContact>
+	Retry_contacts []int64           // retry_contacts:Vector<long>
+	Users          []User            // users:Vector<User>
 }
 
+func (e TL_contacts_importedContacts) isContacts_ImportedContacts() {}
+
 // Encoding TL_contacts_importedContacts
 func (e TL_contacts_importedContacts) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_contacts_importedContacts)
-	x.Vector(e.Imported)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Imported)))
+	for _, v := range e.Imported {
+		x.Bytes(v.encode())
+	}
 	x.VectorLong(e.Retry_contacts)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Contacts_Blocked is implemented by constructors of contacts.Blocked type
+type Contacts_Blocked interface {
+	TL
+	isContacts_Blocked()
+}
+
 // contacts.blocked#1c138d15 blocked:Vector<ContactBlocked> users:Vector<User> = contacts.Blocked;
 
 const crc_contacts_blocked = 0x1c138d15
 
 type TL_contacts_blocked struct {
-	Blocked []TL // blocked:Vector<ContactBlocked>
-	Users   []TL // users:Vector<User>
+	Blocked []ContactBlocked // blocked:Vector<ContactBlocked>
+	Users   []User           // users:Vector<User>
 }
 
+func (e TL_contacts_blocked) isContacts_Blocked() {}
+
 // Encoding TL_contacts_blocked
 func (e TL_contacts_blocked) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_contacts_blocked)
-	x.Vector(e.Blocked)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Blocked)))
+	for _, v := range e.Blocked {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -3235,40 +3929,74 @@ func (e TL_contacts_blocked) encode() []byte {
 const crc_contacts_blockedSlice = 0x900802a1
 
 type TL_contacts_blockedSlice struct {
-	Count   int32 // count:int
-	Blocked []TL  // blocked:Vector<ContactBlocked>
-	Users   []TL  // users:Vector<User>
+	Count   int32            // count:int
+	Blocked []ContactBlocked // blocked:Vector<ContactBlocked>
+	Users   []User           // users:Vector<User>
 }
 
+func (e TL_contacts_blockedSlice) isContacts_Blocked() {}
+
 // Encoding TL_contacts_blockedSlice
 func (e TL_contacts_blockedSlice) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_contacts_blockedSlice)
 	x.Int(e.Count)
-	x.Vector(e.Blocked)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Blocked)))
+	for _, v := range e.Blocked {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Messages_Dialogs is implemented by constructors of messages.Dialogs type
+type Messages_Dialogs interface {
+	TL
+	isMessages_Dialogs()
+}
+
 // messages.dialogs#15ba6c40 dialogs:Vector<Dialog> messages:Vector<Message> chats:Vector<Chat> users:Vector<User> = messages.Dialogs;
 
 const crc_messages_dialogs = 0x15ba6c40
 
 type TL_messages_dialogs struct {
-	Dialogs  []TL // dialogs:Vector<Dialog>
-	Messages []TL // messages:Vector<Message>
-	Chats    []TL // chats:Vector<Chat>
-	Users    []TL // users:Vector<User>
+	Dialogs  []Dialog  // dialogs:Vector<Dialog>
+	Messages []Message // messages:Vector<Message>
+	Chats    []Chat    // chats:Vector<Chat>
+	Users    []User    // users:Vector<User>
 }
 
+func (e TL_messages_dialogs) isMessages_Dialogs() {}
+
 // Encoding TL_messages_dialogs
 func (e TL_messages_dialogs) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_dialogs)
-	x.Vector(e.Dialogs)
-	x.Vector(e.Messages)
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Dialogs)))
+	for _, v := range e.Dialogs {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Messages)))
+	for _, v := range e.Messages {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -3277,42 +4005,80 @@ func (e TL_messages_dialogs) encode() []byte {
 const crc_messages_dialogsSlice = 0x71e094f3
 
 type TL_messages_dialogsSlice struct {
-	Count    int32 // count:int
-	Dialogs  []TL  // dialogs:Vector<Dialog>
-	Messages []TL  // messages:Vector<Message>
-	Chats    []TL  // chats:Vector<Chat>
-	Users    []TL  // users:Vector<User>
+	Count    int32     // count:int
+	Dialogs  []Dialog  // dialogs:Vector<Dialog>
+	Messages []Message // messages:Vector<Message>
+	Chats    []Chat    // chats:Vector<Chat>
+	Users    []User    // users:Vector<User>
 }
 
+func (e TL_messages_dialogsSlice) isMessages_Dialogs() {}
+
 // Encoding TL_messages_dialogsSlice
 func (e TL_messages_dialogsSlice) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_dialogsSlice)
 	x.Int(e.Count)
-	x.Vector(e.Dialogs)
-	x.Vector(e.Messages)
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Dialogs)))
+	for _, v := range e.Dialogs {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Messages)))
+	for _, v := range e.Messages {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Messages_Messages is implemented by constructors of messages.Messages type
+type Messages_Messages interface {
+	TL
+	isMessages_Messages()
+}
+
 // messages.messages#8c718e87 messages:Vector<Message> chats:Vector<Chat> users:Vector<User> = messages.Messages;
 
 const crc_messages_messages = 0x8c718e87
 
 type TL_messages_messages struct {
-	Messages []TL // messages:Vector<Message>
-	Chats    []TL // chats:Vector<Chat>
-	Users    []TL // users:Vector<User>
+	Messages []Message // messages:Vector<Message>
+	Chats    []Chat    // chats:Vector<Chat>
+	Users    []User    // users:Vector<User>
 }
 
+func (e TL_messages_messages) isMessages_Messages() {}
+
 // Encoding TL_messages_messages
 func (e TL_messages_messages) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_messages)
-	x.Vector(e.Messages)
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Messages)))
+	for _, v := range e.Messages {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -3321,20 +4087,34 @@ func (e TL_messages_messages) encode() []byte {
 const crc_messages_messagesSlice = 0xb446ae3
 
 type TL_messages_messagesSlice struct {
-	Count    int32 // count:int
-	Messages []TL  // messages:Vector<Message>
-	Chats    []TL  // chats:Vector<Chat>
-	Users    []TL  // users:Vector<User>
+	Count    int32     // count:int
+	Messages []Message // messages:Vector<Message>
+	Chats    []Chat    // chats:Vector<Chat>
+	Users    []User    // users:Vector<User>
 }
 
+func (e TL_messages_messagesSlice) isMessages_Messages() {}
+
 // Encoding TL_messages_messagesSlice
 func (e TL_messages_messagesSlice) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_messagesSlice)
 	x.Int(e.Count)
-	x.Vector(e.Messages)
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Messages)))
+	for _, v := range e.Messages {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -3344,13 +4124,15 @@ const crc_messages_channelMessages = 0x99262e37
 
 type TL_messages_channelMessages struct {
 	Flags    int32
-	Pts      int32 // pts:int
-	Count    int32 // count:int
-	Messages []TL  // messages:Vector<Message>
-	Chats    []TL  // chats:Vector<Chat>
-	Users    []TL  // users:Vector<User>
+	Pts      int32     // pts:int
+	Count    int32     // count:int
+	Messages []Message // messages:Vector<Message>
+	Chats    []Chat    // chats:Vector<Chat>
+	Users    []User    // users:Vector<User>
 }
 
+func (e TL_messages_channelMessages) isMessages_Messages() {}
+
 // Encoding TL_messages_channelMessages
 func (e TL_messages_channelMessages) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3359,25 +4141,49 @@ func (e TL_messages_channelMessages) encode() []byte {
 	x.Int(flags)
 	x.Int(e.Pts)
 	x.Int(e.Count)
-	x.Vector(e.Messages)
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Messages)))
+	for _, v := range e.Messages {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Messages_Chats is implemented by constructors of messages.Chats type
+type Messages_Chats interface {
+	TL
+	isMessages_Chats()
+}
+
 // messages.chats#64ff9fd5 chats:Vector<Chat> = messages.Chats;
 
 const crc_messages_chats = 0x64ff9fd5
 
 type TL_messages_chats struct {
-	Chats []TL // chats:Vector<Chat>
+	Chats []Chat // chats:Vector<Chat>
 }
 
+func (e TL_messages_chats) isMessages_Chats() {}
+
 // Encoding TL_messages_chats
 func (e TL_messages_chats) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_chats)
-	x.Vector(e.Chats)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -3386,39 +4192,67 @@ func (e TL_messages_chats) encode() []byte {
 const crc_messages_chatsSlice = 0x9cd81144
 
 type TL_messages_chatsSlice struct {
-	Count int32 // count:int
-	Chats []TL  // chats:Vector<Chat>
+	Count int32  // count:int
+	Chats []Chat // chats:Vector<Chat>
 }
 
+func (e TL_messages_chatsSlice) isMessages_Chats() {}
+
 // Encoding TL_messages_chatsSlice
 func (e TL_messages_chatsSlice) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_chatsSlice)
 	x.Int(e.Count)
-	x.Vector(e.Chats)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Messages_ChatFull is implemented by constructors of messages.ChatFull type
+type Messages_ChatFull interface {
+	TL
+	isMessages_ChatFull()
+}
+
 // messages.chatFull#e5d7d19c full_chat:ChatFull chats:Vector<Chat> users:Vector<User> = messages.ChatFull;
 
 const crc_messages_chatFull = 0xe5d7d19c
 
 type TL_messages_chatFull struct {
-	Full_chat TL   // full_chat:ChatFull
-	Chats     []TL // chats:Vector<Chat>
-	Users     []TL // users:Vector<User>
+	Full_chat ChatFull // full_chat:ChatFull
+	Chats     []Chat   // chats:Vector<Chat>
+	Users     []User   // users:Vector<User>
 }
 
+func (e TL_messages_chatFull) isMessages_ChatFull() {}
+
 // Encoding TL_messages_chatFull
 func (e TL_messages_chatFull) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_chatFull)
 	x.Bytes(e.Full_chat.encode())
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Messages_AffectedHistory is implemented by constructors of messages.AffectedHistory type
+type Messages_AffectedHistory interface {
+	TL
+	isMessages_AffectedHistory()
+}
+
 // messages.affectedHistory#b45c69d1 pts:int pts_count:int offset:int = messages.AffectedHistory;
 
 const crc_messages_affectedHistory = 0xb45c69d1
@@ -3429,6 +4263,8 @@ type TL_messages_affectedHistory struct {
 	Offset    int32 // offset:int
 }
 
+func (e TL_messages_affectedHistory) isMessages_AffectedHistory() {}
+
 // Encoding TL_messages_affectedHistory
 func (e TL_messages_affectedHistory) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3439,6 +4275,12 @@ func (e TL_messages_affectedHistory) encode() []byte {
 	return x.buf
 }
 
+// MessagesFilter is implemented by constructors of MessagesFilter type
+type MessagesFilter interface {
+	TL
+	isMessagesFilter()
+}
+
 // inputMessagesFilterEmpty#57e2f66c = MessagesFilter;
 
 const crc_inputMessagesFilterEmpty = 0x57e2f66c
@@ -3446,6 +4288,8 @@ const crc_inputMessagesFilterEmpty = 0x57e2f66c
 type TL_inputMessagesFilterEmpty struct {
 }
 
+func (e TL_inputMessagesFilterEmpty) isMessagesFilter() {}
+
 // Encoding TL_inputMessagesFilterEmpty
 func (e TL_inputMessagesFilterEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3460,6 +4304,8 @@ const crc_inputMessagesFilterPhotos = 0x9609a51c
 type TL_inputMessagesFilterPhotos struct {
 }
 
+func (e TL_inputMessagesFilterPhotos) isMessagesFilter() {}
+
 // Encoding TL_inputMessagesFilterPhotos
 func (e TL_inputMessagesFilterPhotos) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3474,6 +4320,8 @@ const crc_inputMessagesFilterVideo = 0x9fc00e65
 type TL_inputMessagesFilterVideo struct {
 }
 
+func (e TL_inputMessagesFilterVideo) isMessagesFilter() {}
+
 // Encoding TL_inputMessagesFilterVideo
 func (e TL_inputMessagesFilterVideo) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3488,6 +4336,8 @@ const crc_inputMessagesFilterPhotoVideo = 0x56e9f0e4
 type TL_inputMessagesFilterPhotoVideo struct {
 }
 
+func (e TL_inputMessagesFilterPhotoVideo) isMessagesFilter() {}
+
 // Encoding TL_inputMessagesFilterPhotoVideo
 func (e TL_inputMessagesFilterPhotoVideo) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3502,6 +4352,8 @@ const crc_inputMessagesFilterPhotoVideoDocuments = 0xd95e73bb
 type TL_inputMessagesFilterPhotoVideoDocuments struct {
 }
 
+func (e TL_inputMessagesFilterPhotoVideoDocuments) isMessagesFilter() {}
+
 // Encoding TL_inputMessagesFilterPhotoVideoDocuments
 func (e TL_inputMessagesFilterPhotoVideoDocuments) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3516,6 +4368,8 @@ const crc_inputMessagesFilterDocument = 0x9eddf188
 type TL_inputMessagesFilterDocument struct {
 }
 
+func (e TL_inputMessagesFilterDocument) isMessagesFilter() {}
+
 // Encoding TL_inputMessagesFilterDocument
 func (e TL_inputMessagesFilterDocument) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3530,6 +4384,8 @@ const crc_inputMessagesFilterUrl = 0x7ef0dd87
 type TL_inputMessagesFilterUrl struct {
 }
 
+func (e TL_inputMessagesFilterUrl) isMessagesFilter() {}
+
 // Encoding TL_inputMessagesFilterUrl
 func (e TL_inputMessagesFilterUrl) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3544,6 +4400,8 @@ const crc_inputMessagesFilterGif = 0xffc86587
 type TL_inputMessagesFilterGif struct {
 }
 
+func (e TL_inputMessagesFilterGif) isMessagesFilter() {}
+
 // Encoding TL_inputMessagesFilterGif
 func (e TL_inputMessagesFilterGif) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3558,6 +4416,8 @@ const crc_inputMessagesFilterVoice = 0x50f5c392
 type TL_inputMessagesFilterVoice struct {
 }
 
+func (e TL_inputMessagesFilterVoice) isMessagesFilter() {}
+
 // Encoding TL_inputMessagesFilterVoice
 func (e TL_inputMessagesFilterVoice) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3572,6 +4432,8 @@ const crc_inputMessagesFilterMusic = 0x3751b49e
 type TL_inputMessagesFilterMusic struct {
 }
 
+func (e TL_inputMessagesFilterMusic) isMessagesFilter() {}
+
 // Encoding TL_inputMessagesFilterMusic
 func (e TL_inputMessagesFilterMusic) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3586,6 +4448,8 @@ const crc_inputMessagesFilterChatPhotos = 0x3a20ecb8
 type TL_inputMessagesFilterChatPhotos struct {
 }
 
+func (e TL_inputMessagesFilterChatPhotos) isMessagesFilter() {}
+
 // Encoding TL_inputMessagesFilterChatPhotos
 func (e TL_inputMessagesFilterChatPhotos) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3602,6 +4466,8 @@ type TL_inputMessagesFilterPhoneCalls struct {
 	Missed bool // missed:flags.0?true
 }
 
+func (e TL_inputMessagesFilterPhoneCalls) isMessagesFilter() {}
+
 // Encoding TL_inputMessagesFilterPhoneCalls
 func (e TL_inputMessagesFilterPhoneCalls) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3614,16 +4480,24 @@ func (e TL_inputMessagesFilterPhoneCalls) encode() []byte {
 	return x.buf
 }
 
+// Update is implemented by constructors of Update type
+type Update interface {
+	TL
+	isUpdate()
+}
+
 // updateNewMessage#1f2b0afd message:Message pts:int pts_count:int = Update;
 
 const crc_updateNewMessage = 0x1f2b0afd
 
 type TL_updateNewMessage struct {
-	Message   TL    // message:Message
-	Pts       int32 // pts:int
-	Pts_count int32 // pts_count:int
+	Message   Message // message:Message
+	Pts       int32   // pts:int
+	Pts_count int32   // pts_count:int
 }
 
+func (e TL_updateNewMessage) isUpdate() {}
+
 // Encoding TL_updateNewMessage
 func (e TL_updateNewMessage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3643,6 +4517,8 @@ type TL_updateMessageID struct {
 	Random_id int64 // random_id:long
 }
 
+func (e TL_updateMessageID) isUpdate() {}
+
 // Encoding TL_updateMessageID
 func (e TL_updateMessageID) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3662,6 +4538,8 @@ type TL_updateDeleteMessages struct {
 	Pts_count int32   // pts_count:int
 }
 
+func (e TL_updateDeleteMessages) isUpdate() {}
+
 // Encoding TL_updateDeleteMessages
 func (e TL_updateDeleteMessages) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3677,10 +4555,12 @@ func (e TL_updateDeleteMessages) encode() []byte {
 const crc_updateUserTyping = 0x5c486927
 
 type TL_updateUserTyping struct {
-	User_id int32 // user_id:int
-	Action  TL    // action:SendMessageAction
+	User_id int32             // user_id:int
+	Action  SendMessageAction // action:SendMessageAction
 }
 
+func (e TL_updateUserTyping) isUpdate() {}
+
 // Encoding TL_updateUserTyping
 func (e TL_updateUserTyping) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3695,11 +4575,13 @@ func (e TL_updateUserTyping) encode() []byte {
 const crc_updateChatUserTyping = 0x9a65ea1f
 
 type TL_updateChatUserTyping struct {
-	Chat_id int32 // chat_id:int
-	User_id int32 // user_id:int
-	Action  TL    // action:SendMessageAction
+	Chat_id int32             // chat_id:int
+	User_id int32             // user_id:int
+	Action  SendMessageAction // action:SendMessageAction
 }
 
+func (e TL_updateChatUserTyping) isUpdate() {}
+
 // Encoding TL_updateChatUserTyping
 func (e TL_updateChatUserTyping) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3715,9 +4597,11 @@ func (e TL_updateChatUserTyping) encode() []byte {
 const crc_updateChatParticipants = 0x7761198
 
 type TL_updateChatParticipants struct {
-	Participants TL // participants:ChatParticipants
+	Participants ChatParticipants // participants:ChatParticipants
 }
 
+func (e TL_updateChatParticipants) isUpdate() {}
+
 // Encoding TL_updateChatParticipants
 func (e TL_updateChatParticipants) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3731,10 +4615,12 @@ func (e TL_updateChatParticipants) encode() []byte {
 const crc_updateUserStatus = 0x1bfbd823
 
 type TL_updateUserStatus struct {
-	User_id int32 // user_id:int
-	Status  TL    // status:UserStatus
+	User_id int32      // user_id:int
+	Status  UserStatus // status:UserStatus
 }
 
+func (e TL_updateUserStatus) isUpdate() {}
+
 // Encoding TL_updateUserStatus
 func (e TL_updateUserStatus) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3755,6 +4641,8 @@ type TL_updateUserName struct {
 	Username   string // username:string
 }
 
+func (e TL_updateUserName) isUpdate() {}
+
 // Encoding TL_updateUserName
 func (e TL_updateUserName) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3771,12 +4659,14 @@ func (e TL_updateUserName) encode() []byte {
 const crc_updateUserPhoto = 0x95313b0c
 
 type TL_updateUserPhoto struct {
-	User_id  int32 // user_id:int
-	Date     int32 // date:int
-	Photo    TL    // photo:UserProfilePhoto
-	Previous TL    // previous:Bool
+	User_id  int32            // user_id:int
+	Date     int32            // date:int
+	Photo    UserProfilePhoto // photo:UserProfilePhoto
+	Previous Bool             // previous:Bool
 }
 
+func (e TL_updateUserPhoto) isUpdate() {}
+
 // Encoding TL_updateUserPhoto
 func (e TL_updateUserPhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3797,6 +4687,8 @@ type TL_updateContactRegistered struct {
 	Date    int32 // date:int
 }
 
+func (e TL_updateContactRegistered) isUpdate() {}
+
 // Encoding TL_updateContactRegistered
 func (e TL_updateContactRegistered) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3811,11 +4703,13 @@ func (e TL_updateContactRegistered) encode() []byte {
 const crc_updateContactLink = 0x9d2e67c5
 
 type TL_updateContactLink struct {
-	User_id      int32 // user_id:int
-	My_link      TL    // my_link:ContactLink
-	Foreign_link TL    // foreign_link:ContactLink
+	User_id      int32       // user_id:int
+	My_link      ContactLink // my_link:ContactLink
+	Foreign_link ContactLink // foreign_link:ContactLink
 }
 
+func (e TL_updateContactLink) isUpdate() {}
+
 // Encoding TL_updateContactLink
 func (e TL_updateContactLink) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3831,10 +4725,12 @@ func (e TL_updateContactLink) encode() []byte {
 const crc_updateNewEncryptedMessage = 0x12bcbd9a
 
 type TL_updateNewEncryptedMessage struct {
-	Message TL    // message:EncryptedMessage
-	Qts     int32 // qts:int
+	Message EncryptedMessage // message:EncryptedMessage
+	Qts     int32            // qts:int
 }
 
+func (e TL_updateNewEncryptedMessage) isUpdate() {}
+
 // Encoding TL_updateNewEncryptedMessage
 func (e TL_updateNewEncryptedMessage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3852,6 +4748,8 @@ type TL_updateEncryptedChatTyping struct {
 	Chat_id int32 // chat_id:int
 }
 
+func (e TL_updateEncryptedChatTyping) isUpdate() {}
+
 // Encoding TL_updateEncryptedChatTyping
 func (e TL_updateEncryptedChatTyping) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3865,10 +4763,12 @@ func (e TL_updateEncryptedChatTyping) encode() []byte {
 const crc_updateEncryption = 0xb4a2e88d
 
 type TL_updateEncryption struct {
-	Chat TL    // chat:EncryptedChat
-	Date int32 // date:int
+	Chat EncryptedChat // chat:EncryptedChat
+	Date int32         // date:int
 }
 
+func (e TL_updateEncryption) isUpdate() {}
+
 // Encoding TL_updateEncryption
 func (e TL_updateEncryption) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3888,6 +4788,8 @@ type TL_updateEncryptedMessagesRead struct {
 	Date     int32 // date:int
 }
 
+func (e TL_updateEncryptedMessagesRead) isUpdate() {}
+
 // Encoding TL_updateEncryptedMessagesRead
 func (e TL_updateEncryptedMessagesRead) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3910,6 +4812,8 @@ type TL_updateChatParticipantAdd struct {
 	Version    int32 // Version:int
 }
 
+func (e TL_updateChatParticipantAdd) isUpdate() {}
+
 // Encoding TL_updateChatParticipantAdd
 func (e TL_updateChatParticipantAdd) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3932,6 +4836,8 @@ type TL_updateChatParticipantDelete struct {
 	Version int32 // Version:int
 }
 
+func (e TL_updateChatParticipantDelete) isUpdate() {}
+
 // Encoding TL_updateChatParticipantDelete
 func (e TL_updateChatParticipantDelete) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3947,14 +4853,20 @@ func (e TL_updateChatParticipantDelete) encode() []byte {
 const crc_updateDcOptions = 0x8e5e9873
 
 type TL_updateDcOptions struct {
-	Dc_options []TL // dc_options:Vector<DcOption>
+	Dc_options []DcOption // dc_options:Vector<DcOption>
 }
 
+func (e TL_updateDcOptions) isUpdate() {}
+
 // Encoding TL_updateDcOptions
 func (e TL_updateDcOptions) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_updateDcOptions)
-	x.Vector(e.Dc_options)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Dc_options)))
+	for _, v := range e.Dc_options {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -3964,9 +4876,11 @@ const crc_updateUserBlocked = 0x80ece81a
 
 type TL_updateUserBlocked struct {
 	User_id int32 // user_id:int
-	Blocked TL    // blocked:Bool
+	Blocked Bool  // blocked:Bool
 }
 
+func (e TL_updateUserBlocked) isUpdate() {}
+
 // Encoding TL_updateUserBlocked
 func (e TL_updateUserBlocked) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -3981,10 +4895,12 @@ func (e TL_updateUserBlocked) encode() []byte {
 const crc_updateNotifySettings = 0xbec268ef
 
 type TL_updateNotifySettings struct {
-	Peer            TL // peer:NotifyPeer
-	Notify_settings TL // notify_settings:PeerNotifySettings
+	Peer            NotifyPeer         // peer:NotifyPeer
+	Notify_settings PeerNotifySettings // notify_settings:PeerNotifySettings
 }
 
+func (e TL_updateNotifySettings) isUpdate() {}
+
 // Encoding TL_updateNotifySettings
 func (e TL_updateNotifySettings) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4000,14 +4916,16 @@ const crc_updateServiceNotification = 0xebe46819
 
 type TL_updateServiceNotification struct {
 	Flags      int32
-	Popup      bool   // popup:flags.0?true
-	Inbox_date int32  // inbox_date:flags.1?int
-	Code_type  string // type:string
-	Message    string // message:string
-	Media      TL     // media:MessageMedia
-	Entities   []TL   // entities:Vector<MessageEntity>
+	Popup      bool            // popup:flags.0?true
+	Inbox_date int32           // inbox_date:flags.1?int
+	Code_type  string          // type:string
+	Message    string          // message:string
+	Media      MessageMedia    // media:MessageMedia
+	Entities   []MessageEntity // entities:Vector<MessageEntity>
 }
 
+func (e TL_updateServiceNotification) isUpdate() {}
+
 // Encoding TL_updateServiceNotification
 func (e TL_updateServiceNotification) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4026,7 +4944,11 @@ func (e TL_updateServiceNotification) encode() []byte {
 	x.String(e.Code_type)
 	x.String(e.Message)
 	x.Bytes(e.Media.encode())
-	x.Vector(e.Entities)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Entities)))
+	for _, v := range e.Entities {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -4035,16 +4957,22 @@ func (e TL_updateServiceNotification) encode() []byte {
 const crc_updatePrivacy = 0xee3b272a
 
 type TL_updatePrivacy struct {
-	Key   TL   // key:PrivacyKey
-	Rules []TL // rules:Vector<PrivacyRule>
+	Key   PrivacyKey    // key:PrivacyKey
+	Rules []PrivacyRule // rules:Vector<PrivacyRule>
 }
 
+func (e TL_updatePrivacy) isUpdate() {}
+
 // Encoding TL_updatePrivacy
 func (e TL_updatePrivacy) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_updatePrivacy)
 	x.Bytes(e.Key.encode())
-	x.Vector(e.Rules)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Rules)))
+	for _, v := range e.Rules {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -4057,6 +4985,8 @@ type TL_updateUserPhone struct {
 	Phone   string // phone:string
 }
 
+func (e TL_updateUserPhone) isUpdate() {}
+
 // Encoding TL_updateUserPhone
 func (e TL_updateUserPhone) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4071,12 +5001,14 @@ func (e TL_updateUserPhone) encode() []byte {
 const crc_updateReadHistoryInbox = 0x9961fd5c
 
 type TL_updateReadHistoryInbox struct {
-	Peer      TL    // peer:Peer
+	Peer      Peer  // peer:Peer
 	Max_id    int32 // max_id:int
 	Pts       int32 // pts:int
 	Pts_count int32 // pts_count:int
 }
 
+func (e TL_updateReadHistoryInbox) isUpdate() {}
+
 // Encoding TL_updateReadHistoryInbox
 func (e TL_updateReadHistoryInbox) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4093,12 +5025,14 @@ func (e TL_updateReadHistoryInbox) encode() []byte {
 const crc_updateReadHistoryOutbox = 0x2f2f21bf
 
 type TL_updateReadHistoryOutbox struct {
-	Peer      TL    // peer:Peer
+	Peer      Peer  // peer:Peer
 	Max_id    int32 // max_id:int
 	Pts       int32 // pts:int
 	Pts_count int32 // pts_count:int
 }
 
+func (e TL_updateReadHistoryOutbox) isUpdate() {}
+
 // Encoding TL_updateReadHistoryOutbox
 func (e TL_updateReadHistoryOutbox) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4115,11 +5049,13 @@ func (e TL_updateReadHistoryOutbox) encode() []byte {
 const crc_updateWebPage = 0x7f891213
 
 type TL_updateWebPage struct {
-	Webpage   TL    // webpage:WebPage
-	Pts       int32 // pts:int
-	Pts_count int32 // pts_count:int
+	Webpage   WebPage // webpage:WebPage
+	Pts       int32   // pts:int
+	Pts_count int32   // pts_count:int
 }
 
+func (e TL_updateWebPage) isUpdate() {}
+
 // Encoding TL_updateWebPage
 func (e TL_updateWebPage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4140,6 +5076,8 @@ type TL_updateReadMessagesContents struct {
 	Pts_count int32   // pts_count:int
 }
 
+func (e TL_updateReadMessagesContents) isUpdate() {}
+
 // Encoding TL_updateReadMessagesContents
 func (e TL_updateReadMessagesContents) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4160,6 +5098,8 @@ type TL_updateChannelTooLong struct {
 	Pts        int32 // pts:flags.0?int
 }
 
+func (e TL_updateChannelTooLong) isUpdate() {}
+
 // Encoding TL_updateChannelTooLong
 func (e TL_updateChannelTooLong) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4184,6 +5124,8 @@ type TL_updateChannel struct {
 	Channel_id int32 // channel_id:int
 }
 
+func (e TL_updateChannel) isUpdate() {}
+
 // Encoding TL_updateChannel
 func (e TL_updateChannel) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4197,11 +5139,13 @@ func (e TL_updateChannel) encode() []byte {
 const crc_updateNewChannelMessage = 0x62ba04d9
 
 type TL_updateNewChannelMessage struct {
-	Message   TL    // message:Message
-	Pts       int32 // pts:int
-	Pts_count int32 // pts_count:int
+	Message   Message // message:Message
+	Pts       int32   // pts:int
+	Pts_count int32   // pts_count:int
 }
 
+func (e TL_updateNewChannelMessage) isUpdate() {}
+
 // Encoding TL_updateNewChannelMessage
 func (e TL_updateNewChannelMessage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4221,6 +5165,8 @@ type TL_updateReadChannelInbox struct {
 	Max_id     int32 // max_id:int
 }
 
+func (e TL_updateReadChannelInbox) isUpdate() {}
+
 // Encoding TL_updateReadChannelInbox
 func (e TL_updateReadChannelInbox) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4241,6 +5187,8 @@ type TL_updateDeleteChannelMessages struct {
 	Pts_count  int32   // pts_count:int
 }
 
+func (e TL_updateDeleteChannelMessages) isUpdate() {}
+
 // Encoding TL_updateDeleteChannelMessages
 func (e TL_updateDeleteChannelMessages) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4262,6 +5210,8 @@ type TL_updateChannelMessageViews struct {
 	Views      int32 // views:int
 }
 
+func (e TL_updateChannelMessageViews) isUpdate() {}
+
 // Encoding TL_updateChannelMessageViews
 func (e TL_updateChannelMessageViews) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4278,10 +5228,12 @@ const crc_updateChatAdmins = 0x6e947941
 
 type TL_updateChatAdmins struct {
 	Chat_id int32 // chat_id:int
-	Enabled TL    // enabled:Bool
+	Enabled Bool  // enabled:Bool
 	Version int32 // Version:int
 }
 
+func (e TL_updateChatAdmins) isUpdate() {}
+
 // Encoding TL_updateChatAdmins
 func (e TL_updateChatAdmins) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4299,10 +5251,12 @@ const crc_updateChatParticipantAdmin = 0xb6901959
 type TL_updateChatParticipantAdmin struct {
 	Chat_id  int32 // chat_id:int
 	User_id  int32 // user_id:int
-	Is_admin TL    // is_admin:Bool
+	Is_admin Bool  // is_admin:Bool
 	Version  int32 // Version:int
 }
 
+func (e TL_updateChatParticipantAdmin) isUpdate() {}
+
 // Encoding TL_updateChatParticipantAdmin
 func (e TL_updateChatParticipantAdmin) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4319,9 +5273,11 @@ func (e TL_updateChatParticipantAdmin) encode() []byte {
 const crc_updateNewStickerSet = 0x688a30aa
 
 type TL_updateNewStickerSet struct {
-	Stickerset TL // stickerset:messages.StickerSet
+	Stickerset Messages_StickerSet // stickerset:messages.StickerSet
 }
 
+func (e TL_updateNewStickerSet) isUpdate() {}
+
 // Encoding TL_updateNewStickerSet
 func (e TL_updateNewStickerSet) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4340,6 +5296,8 @@ type TL_updateStickerSetsOrder struct {
 	Order []int64 // order:Vector<long>
 }
 
+func (e TL_updateStickerSetsOrder) isUpdate() {}
+
 // Encoding TL_updateStickerSetsOrder
 func (e TL_updateStickerSetsOrder) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4360,6 +5318,8 @@ const crc_updateStickerSets = 0x43ae3dec
 type TL_updateStickerSets struct {
 }
 
+func (e TL_updateStickerSets) isUpdate() {}
+
 // Encoding TL_updateStickerSets
 func (e TL_updateStickerSets) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4374,6 +5334,8 @@ const crc_updateSavedGifs = 0x9375341e
 type TL_updateSavedGifs struct {
 }
 
+func (e TL_updateSavedGifs) isUpdate() {}
+
 // Encoding TL_updateSavedGifs
 func (e TL_updateSavedGifs) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4387,19 +5349,21 @@ const crc_updateBotInlineQuery = 0x54826690
 
 type TL_updateBotInlineQuery struct {
 	Flags    int32
-	Query_id int64  // query_id:long
-	User_id  int32  // user_id:int
-	Query    string // query:string
-	Geo      TL     // geo:flags.0?GeoPoint
-	Offset   string // offset:string
+	Query_id int64    // query_id:long
+	User_id  int32    // user_id:int
+	Query    string   // query:string
+	Geo      GeoPoint // geo:flags.0?GeoPoint
+	Offset   string   // offset:string
 }
 
+func (e TL_updateBotInlineQuery) isUpdate() {}
+
 // Encoding TL_updateBotInlineQuery
 func (e TL_updateBotInlineQuery) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_updateBotInlineQuery)
 	var flags int32
-	if _, ok := (e.Geo).(TL_null); !ok {
+	if e.Geo != nil {
 		flags |= (1 << 0)
 	}
 	x.Int(flags)
@@ -4419,22 +5383,24 @@ const crc_updateBotInlineSend = 0xe48f964
 
 type TL_updateBotInlineSend struct {
 	Flags   int32
-	User_id int32  // user_id:int
-	Query   string // query:string
-	Geo     TL     // geo:flags.0?GeoPoint
-	Id      string // Id:string
-	Msg_id  TL     // msg_id:flags.1?InputBotInlineMessageID
+	User_id int32                   // user_id:int
+	Query   string                  // query:string
+	Geo     GeoPoint                // geo:flags.0?GeoPoint
+	Id      string                  // Id:string
+	Msg_id  InputBotInlineMessageID // msg_id:flags.1?InputBotInlineMessageID
 }
 
+func (e TL_updateBotInlineSend) isUpdate() {}
+
 // Encoding TL_updateBotInlineSend
 func (e TL_updateBotInlineSend) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_updateBotInlineSend)
 	var flags int32
-	if _, ok := (e.Geo).(TL_null); !ok {
+	if e.Geo != nil {
 		flags |= (1 << 0)
 	}
-	if _, ok := (e.Msg_id).(TL_null); !ok {
+	if e.Msg_id != nil {
 		flags |= (1 << 1)
 	}
 	x.Int(flags)
@@ -4455,11 +5421,13 @@ func (e TL_updateBotInlineSend) encode() []byte {
 const crc_updateEditChannelMessage = 0x1b3f4df7
 
 type TL_updateEditChannelMessage struct {
-	Message   TL    // message:Message
-	Pts       int32 // pts:int
-	Pts_count int32 // pts_count:int
+	Message   Message // message:Message
+	Pts       int32   // pts:int
+	Pts_count int32   // pts_count:int
 }
 
+func (e TL_updateEditChannelMessage) isUpdate() {}
+
 // Encoding TL_updateEditChannelMessage
 func (e TL_updateEditChannelMessage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4479,6 +5447,8 @@ type TL_updateChannelPinnedMessage struct {
 	Id         int32 // Id:int
 }
 
+func (e TL_updateChannelPinnedMessage) isUpdate() {}
+
 // Encoding TL_updateChannelPinnedMessage
 func (e TL_updateChannelPinnedMessage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4496,13 +5466,15 @@ type TL_updateBotCallbackQuery struct {
 	Flags           int32
 	Query_id        int64  // query_id:long
 	User_id         int32  // user_id:int
-	Peer            TL     // peer:Peer
+	Peer            Peer   // peer:Peer
 	Msg_id          int32  // msg_id:int
 	Chat_instance   int64  // chat_instance:long
 	Data            []byte // data:flags.0?bytes
 	Game_short_name string // game_short_name:flags.1?string
 }
 
+func (e TL_updateBotCallbackQuery) isUpdate() {}
+
 // Encoding TL_updateBotCallbackQuery
 func (e TL_updateBotCallbackQuery) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4521,7 +5493,7 @@ func (e TL_updateBotCallbackQuery) encode() []byte {
 	x.Int(e.Msg_id)
 	x.Long(e.Chat_instance)
 	if flags&(1<<0) != 0 {
-		x.Bytes(e.Data)
+		x.StringBytes(e.Data)
 	}
 	if flags&(1<<1) != 0 {
 		x.String(e.Game_short_name)
@@ -4534,11 +5506,13 @@ func (e TL_updateBotCallbackQuery) encode() []byte {
 const crc_updateEditMessage = 0xe40370a3
 
 type TL_updateEditMessage struct {
-	Message   TL    // message:Message
-	Pts       int32 // pts:int
-	Pts_count int32 // pts_count:int
+	Message   Message // message:Message
+	Pts       int32   // pts:int
+	Pts_count int32   // pts_count:int
 }
 
+func (e TL_updateEditMessage) isUpdate() {}
+
 // Encoding TL_updateEditMessage
 func (e TL_updateEditMessage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4555,14 +5529,16 @@ const crc_updateInlineBotCallbackQuery = 0xf9d27a5a
 
 type TL_updateInlineBotCallbackQuery struct {
 	Flags           int32
-	Query_id        int64  // query_id:long
-	User_id         int32  // user_id:int
-	Msg_id          TL     // msg_id:InputBotInlineMessageID
-	Chat_instance   int64  // chat_instance:long
-	Data            []byte // data:flags.0?bytes
-	Game_short_name string // game_short_name:flags.1?string
+	Query_id        int64                   // query_id:long
+	User_id         int32                   // user_id:int
+	Msg_id          InputBotInlineMessageID // msg_id:InputBotInlineMessageID
+	Chat_instance   int64                   // chat_instance:long
+	Data            []byte                  // data:flags.0?bytes
+	Game_short_name string                  // game_short_name:flags.1?string
 }
 
+func (e TL_updateInlineBotCallbackQuery) isUpdate() {}
+
 // Encoding TL_updateInlineBotCallbackQuery
 func (e TL_updateInlineBotCallbackQuery) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4580,7 +5556,7 @@ func (e TL_updateInlineBotCallbackQuery) encode() []byte {
 	x.Bytes(e.Msg_id.encode())
 	x.Long(e.Chat_instance)
 	if flags&(1<<0) != 0 {
-		x.Bytes(e.Data)
+		x.StringBytes(e.Data)
 	}
 	if flags&(1<<1) != 0 {
 		x.String(e.Game_short_name)
@@ -4597,6 +5573,8 @@ type TL_updateReadChannelOutbox struct {
 	Max_id     int32 // max_id:int
 }
 
+func (e TL_updateReadChannelOutbox) isUpdate() {}
+
 // Encoding TL_updateReadChannelOutbox
 func (e TL_updateReadChannelOutbox) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4611,10 +5589,12 @@ func (e TL_updateReadChannelOutbox) encode() []byte {
 const crc_updateDraftMessage = 0xee2bb969
 
 type TL_updateDraftMessage struct {
-	Peer  TL // peer:Peer
-	Draft TL // draft:DraftMessage
+	Peer  Peer         // peer:Peer
+	Draft DraftMessage // draft:DraftMessage
 }
 
+func (e TL_updateDraftMessage) isUpdate() {}
+
 // Encoding TL_updateDraftMessage
 func (e TL_updateDraftMessage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4631,6 +5611,8 @@ const crc_updateReadFeaturedStickers = 0x571d2742
 type TL_updateReadFeaturedStickers struct {
 }
 
+func (e TL_updateReadFeaturedStickers) isUpdate() {}
+
 // Encoding TL_updateReadFeaturedStickers
 func (e TL_updateReadFeaturedStickers) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4645,6 +5627,8 @@ const crc_updateRecentStickers = 0x9a422c20
 type TL_updateRecentStickers struct {
 }
 
+func (e TL_updateRecentStickers) isUpdate() {}
+
 // Encoding TL_updateRecentStickers
 func (e TL_updateRecentStickers) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4659,6 +5643,8 @@ const crc_updateConfig = 0xa229dd06
 type TL_updateConfig struct {
 }
 
+func (e TL_updateConfig) isUpdate() {}
+
 // Encoding TL_updateConfig
 func (e TL_updateConfig) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4673,6 +5659,8 @@ const crc_updatePtsChanged = 0x3354678f
 type TL_updatePtsChanged struct {
 }
 
+func (e TL_updatePtsChanged) isUpdate() {}
+
 // Encoding TL_updatePtsChanged
 func (e TL_updatePtsChanged) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4685,12 +5673,14 @@ func (e TL_updatePtsChanged) encode() []byte {
 const crc_updateChannelWebPage = 0x40771900
 
 type TL_updateChannelWebPage struct {
-	Channel_id int32 // channel_id:int
-	Webpage    TL    // webpage:WebPage
-	Pts        int32 // pts:int
-	Pts_count  int32 // pts_count:int
+	Channel_id int32   // channel_id:int
+	Webpage    WebPage // webpage:WebPage
+	Pts        int32   // pts:int
+	Pts_count  int32   // pts_count:int
 }
 
+func (e TL_updateChannelWebPage) isUpdate() {}
+
 // Encoding TL_updateChannelWebPage
 func (e TL_updateChannelWebPage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4709,9 +5699,11 @@ const crc_updateDialogPinned = 0xd711a2cc
 type TL_updateDialogPinned struct {
 	Flags  int32
 	Pinned bool // pinned:flags.0?true
-	Peer   TL   // peer:Peer
+	Peer   Peer // peer:Peer
 }
 
+func (e TL_updateDialogPinned) isUpdate() {}
+
 // Encoding TL_updateDialogPinned
 func (e TL_updateDialogPinned) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4731,9 +5723,11 @@ const crc_updatePinnedDialogs = 0xd8caf68d
 
 type TL_updatePinnedDialogs struct {
 	Flags int32
-	Order []TL // order:flags.0?Vector<Peer>
+	Order []Peer // order:flags.0?Vector<Peer>
 }
 
+func (e TL_updatePinnedDialogs) isUpdate() {}
+
 // Encoding TL_updatePinnedDialogs
 func (e TL_updatePinnedDialogs) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4744,7 +5738,11 @@ func (e TL_updatePinnedDialogs) encode() []byte {
 	}
 	x.Int(flags)
 	if flags&(1<<0) != 0 {
-		x.Vector(e.Order)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Order)))
+		for _, v := range e.Order {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
@@ -4754,9 +5752,11 @@ func (e TL_updatePinnedDialogs) encode() []byte {
 const crc_updateBotWebhookJSON = 0x8317c0c3
 
 type TL_updateBotWebhookJSON struct {
-	Data TL // data:DataJSON
+	Data DataJSON // data:DataJSON
 }
 
+func (e TL_updateBotWebhookJSON) isUpdate() {}
+
 // Encoding TL_updateBotWebhookJSON
 func (e TL_updateBotWebhookJSON) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4770,11 +5770,13 @@ func (e TL_updateBotWebhookJSON) encode() []byte {
 const crc_updateBotWebhookJSONQuery = 0x9b9240a6
 
 type TL_updateBotWebhookJSONQuery struct {
-	Query_id int64 // query_id:long
-	Data     TL    // data:DataJSON
-	Timeout  int32 // timeout:int
+	Query_id int64    // query_id:long
+	Data     DataJSON // data:DataJSON
+	Timeout  int32    // timeout:int
 }
 
+func (e TL_updateBotWebhookJSONQuery) isUpdate() {}
+
 // Encoding TL_updateBotWebhookJSONQuery
 func (e TL_updateBotWebhookJSONQuery) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4790,19 +5792,21 @@ func (e TL_updateBotWebhookJSONQuery) encode() []byte {
 const crc_updateBotShippingQuery = 0xe0cdc940
 
 type TL_updateBotShippingQuery struct {
-	Query_id         int64  // query_id:long
-	User_id          int32  // user_id:int
-	Payload          []byte // payload:bytes
-	Shipping_address TL     // shipping_address:PostAddress
+	Query_id         int64       // query_id:long
+	User_id          int32       // user_id:int
+	Payload          []byte      // payload:bytes
+	Shipping_address PostAddress // shipping_address:PostAddress
 }
 
+func (e TL_updateBotShippingQuery) isUpdate() {}
+
 // Encoding TL_updateBotShippingQuery
 func (e TL_updateBotShippingQuery) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_updateBotShippingQuery)
 	x.Long(e.Query_id)
 	x.Int(e.User_id)
-	x.Bytes(e.Payload)
+	x.StringBytes(e.Payload)
 	x.Bytes(e.Shipping_address.encode())
 	return x.buf
 }
@@ -4813,21 +5817,23 @@ const crc_updateBotPrecheckoutQuery = 0x5d2f3aa9
 
 type TL_updateBotPrecheckoutQuery struct {
 	Flags              int32
-	Query_id           int64  // query_id:long
-	User_id            int32  // user_id:int
-	Payload            []byte // payload:bytes
-	Info               TL     // info:flags.0?PaymentRequestedInfo
-	Shipping_option_id string // shipping_option_id:flags.1?string
-	Currency           string // currency:string
-	Total_amount       int64  // total_amount:long
+	Query_id           int64                // query_id:long
+	User_id            int32                // user_id:int
+	Payload            []byte               // payload:bytes
+	Info               PaymentRequestedInfo // info:flags.0?PaymentRequestedInfo
+	Shipping_option_id string               // shipping_option_id:flags.1?string
+	Currency           string               // currency:string
+	Total_amount       int64                // total_amount:long
 }
 
+func (e TL_updateBotPrecheckoutQuery) isUpdate() {}
+
 // Encoding TL_updateBotPrecheckoutQuery
 func (e TL_updateBotPrecheckoutQuery) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_updateBotPrecheckoutQuery)
 	var flags int32
-	if _, ok := (e.Info).(TL_null); !ok {
+	if e.Info != nil {
 		flags |= (1 << 0)
 	}
 	if e.Shipping_option_id != "" {
@@ -4836,7 +5842,7 @@ func (e TL_updateBotPrecheckoutQuery) encode() []byte {
 	x.Int(flags)
 	x.Long(e.Query_id)
 	x.Int(e.User_id)
-	x.Bytes(e.Payload)
+	x.StringBytes(e.Payload)
 	if flags&(1<<0) != 0 {
 		x.Bytes(e.Info.encode())
 	}
@@ -4853,9 +5859,11 @@ func (e TL_updateBotPrecheckoutQuery) encode() []byte {
 const crc_updatePhoneCall = 0xab0f6b1e
 
 type TL_updatePhoneCall struct {
-	Phone_call TL // phone_call:PhoneCall
+	Phone_call PhoneCall // phone_call:PhoneCall
 }
 
+func (e TL_updatePhoneCall) isUpdate() {}
+
 // Encoding TL_updatePhoneCall
 func (e TL_updatePhoneCall) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4864,6 +5872,12 @@ func (e TL_updatePhoneCall) encode() []byte {
 	return x.buf
 }
 
+// Updates_State is implemented by constructors of updates.State type
+type Updates_State interface {
+	TL
+	isUpdates_State()
+}
+
 // updates.state#a56c2a3e pts:int qts:int date:int seq:int unread_count:int = updates.State;
 
 const crc_updates_state = 0xa56c2a3e
@@ -4876,6 +5890,8 @@ type TL_updates_state struct {
 	Unread_count int32 // unread_count:int
 }
 
+func (e TL_updates_state) isUpdates_State() {}
+
 // Encoding TL_updates_state
 func (e TL_updates_state) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4888,6 +5904,12 @@ func (e TL_updates_state) encode() []byte {
 	return x.buf
 }
 
+// Updates_Difference is implemented by constructors of updates.Difference type
+type Updates_Difference interface {
+	TL
+	isUpdates_Difference()
+}
+
 // updates.differenceEmpty#5d75a138 date:int seq:int = updates.Difference;
 
 const crc_updates_differenceEmpty = 0x5d75a138
@@ -4897,6 +5919,8 @@ type TL_updates_differenceEmpty struct {
 	Seq  int32 // seq:int
 }
 
+func (e TL_updates_differenceEmpty) isUpdates_Difference() {}
+
 // Encoding TL_updates_differenceEmpty
 func (e TL_updates_differenceEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4911,23 +5935,45 @@ func (e TL_updates_differenceEmpty) encode() []byte {
 const crc_updates_difference = 0xf49ca0
 
 type TL_updates_difference struct {
-	New_messages           []TL // new_messages:Vector<Message>
-	New_encrypted_messages []TL // new_encrypted_messages:Vector<EncryptedMessage>
-	Other_updates          []TL // other_updates:Vector<Update>
-	Chats                  []TL // chats:Vector<Chat>
-	Users                  []TL // users:Vector<User>
-	State                  TL   // state:updates.State
+	New_messages           []Message          // new_messages:Vector<Message>
+	New_encrypted_messages []EncryptedMessage // new_encrypted_messages:Vector<EncryptedMessage>
+	Other_updates          []Update           // other_updates:Vector<Update>
+	Chats                  []Chat             // chats:Vector<Chat>
+	Users                  []User             // users:Vector<User>
+	State                  Updates_State      // state:updates.State
 }
 
+func (e TL_updates_difference) isUpdates_Difference() {}
+
 // Encoding TL_updates_difference
 func (e TL_updates_difference) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_updates_difference)
-	x.Vector(e.New_messages)
-	x.Vector(e.New_encrypted_messages)
-	x.Vector(e.Other_updates)
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.New_messages)))
+	for _, v := range e.New_messages {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.New_encrypted_messages)))
+	for _, v := range e.New_encrypted_messages {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Other_updates)))
+	for _, v := range e.Other_updates {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	x.Bytes(e.State.encode())
 	return x.buf
 }
@@ -4937,23 +5983,45 @@ func (e TL_updates_difference) encode() []byte {
 const crc_updates_differenceSlice = 0xa8fb1981
 
 type TL_updates_differenceSlice struct {
-	New_messages           []TL // new_messages:Vector<Message>
-	New_encrypted_messages []TL // new_encrypted_messages:Vector<EncryptedMessage>
-	Other_updates          []TL // other_updates:Vector<Update>
-	Chats                  []TL // chats:Vector<Chat>
-	Users                  []TL // users:Vector<User>
-	Intermediate_state     TL   // intermediate_state:updates.State
+	New_messages           []Message          // new_messages:Vector<Message>
+	New_encrypted_messages []EncryptedMessage // new_encrypted_messages:Vector<EncryptedMessage>
+	Other_updates          []Update           // other_updates:Vector<Update>
+	Chats                  []Chat             // chats:Vector<Chat>
+	Users                  []User             // users:Vector<User>
+	Intermediate_state     Updates_State      // intermediate_state:updates.State
 }
 
+func (e TL_updates_differenceSlice) isUpdates_Difference() {}
+
 // Encoding TL_updates_differenceSlice
 func (e TL_updates_differenceSlice) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_updates_differenceSlice)
-	x.Vector(e.New_messages)
-	x.Vector(e.New_encrypted_messages)
-	x.Vector(e.Other_updates)
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.New_messages)))
+	for _, v := range e.New_messages {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.New_encrypted_messages)))
+	for _, v := range e.New_encrypted_messages {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Other_updates)))
+	for _, v := range e.Other_updates {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	x.Bytes(e.Intermediate_state.encode())
 	return x.buf
 }
@@ -4966,6 +6034,8 @@ type TL_updates_differenceTooLong struct {
 	Pts int32 // pts:int
 }
 
+func (e TL_updates_differenceTooLong) isUpdates_Difference() {}
+
 // Encoding TL_updates_differenceTooLong
 func (e TL_updates_differenceTooLong) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4974,6 +6044,12 @@ func (e TL_updates_differenceTooLong) encode() []byte {
 	return x.buf
 }
 
+// Updates is implemented by constructors of Updates type
+type Updates interface {
+	TL
+	isUpdates()
+}
+
 // updatesTooLong#e317af7e = Updates;
 
 const crc_updatesTooLong = 0xe317af7e
@@ -4981,6 +6057,8 @@ const crc_updatesTooLong = 0xe317af7e
 type TL_updatesTooLong struct {
 }
 
+func (e TL_updatesTooLong) isUpdates() {}
+
 // Encoding TL_updatesTooLong
 func (e TL_updatesTooLong) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -4994,21 +6072,23 @@ const crc_updateShortMessage = 0x914fbf11
 
 type TL_updateShortMessage struct {
 	Flags           int32
-	Out             bool   // out:flags.1?true
-	Mentioned       bool   // mentioned:flags.4?true
-	Media_unread    bool   // media_unread:flags.5?true
-	Silent          bool   // silent:flags.13?true
-	Id              int32  // Id:int
-	User_id         int32  // user_id:int
-	Message         string // message:string
-	Pts             int32  // pts:int
-	Pts_count       int32  // pts_count:int
-	Date            int32  // date:int
-	Fwd_from        TL     // fwd_from:flags.2?MessageFwdHeader
-	Via_bot_id      int32  // via_bot_id:flags.11?int
-	Reply_to_msg_id int32  // reply_to_msg_id:flags.3?int
-	Entities        []TL   // entities:flags.7?Vector<MessageEntity>
-}
+	Out             bool             // out:flags.1?true
+	Mentioned       bool             // mentioned:flags.4?true
+	Media_unread    bool             // media_unread:flags.5?true
+	Silent          bool             // silent:flags.13?true
+	Id              int32            // Id:int
+	User_id         int32            // user_id:int
+	Message         string           // message:string
+	Pts             int32            // pts:int
+	Pts_count       int32            // pts_count:int
+	Date            int32            // date:int
+	Fwd_from        MessageFwdHeader // fwd_from:flags.2?MessageFwdHeader
+	Via_bot_id      int32            // via_bot_id:flags.11?int
+	Reply_to_msg_id int32            // reply_to_msg_id:flags.3?int
+	Entities        []MessageEntity  // entities:flags.7?Vector<MessageEntity>
+}
+
+func (e TL_updateShortMessage) isUpdates() {}
 
 // Encoding TL_updateShortMessage
 func (e TL_updateShortMessage) encode() []byte {
@@ -5027,7 +6107,7 @@ func (e TL_updateShortMessage) encode() []byte {
 	if e.Silent {
 		flags |= (1 << 13)
 	}
-	if _, ok := (e.Fwd_from).(TL_null); !ok {
+	if e.Fwd_from != nil {
 		flags |= (1 << 2)
 	}
 	if e.Via_bot_id > 0 {
@@ -5056,7 +6136,11 @@ func (e TL_updateShortMessage) encode() []byte {
 		x.Int(e.Reply_to_msg_id)
 	}
 	if flags&(1<<7) != 0 {
-		x.Vector(e.Entities)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Entities)))
+		for _, v := range e.Entities {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
@@ -5067,22 +6151,24 @@ const crc_updateShortChatMessage = 0x16812688
 
 type TL_updateShortChatMessage struct {
 	Flags           int32
-	Out             bool   // out:flags.1?true
-	Mentioned       bool   // mentioned:flags.4?true
-	Media_unread    bool   // media_unread:flags.5?true
-	Silent          bool   // silent:flags.13?true
-	Id              int32  // Id:int
-	From_id         int32  // from_id:int
-	Chat_id         int32  // chat_id:int
-	Message         string // message:string
-	Pts             int32  // pts:int
-	Pts_count       int32  // pts_count:int
-	Date            int32  // date:int
-	Fwd_from        TL     // fwd_from:flags.2?MessageFwdHeader
-	Via_bot_id      int32  // via_bot_id:flags.11?int
-	Reply_to_msg_id int32  // reply_to_msg_id:flags.3?int
-	Entities        []TL   // entities:flags.7?Vector<MessageEntity>
-}
+	Out             bool             // out:flags.1?true
+	Mentioned       bool             // mentioned:flags.4?true
+	Media_unread    bool             // media_unread:flags.5?true
+	Silent          bool             // silent:flags.13?true
+	Id              int32            // Id:int
+	From_id         int32            // from_id:int
+	Chat_id         int32            // chat_id:int
+	Message         string           // message:string
+	Pts             int32            // pts:int
+	Pts_count       int32            // pts_count:int
+	Date            int32            // date:int
+	Fwd_from        MessageFwdHeader // fwd_from:flags.2?MessageFwdHeader
+	Via_bot_id      int32            // via_bot_id:flags.11?int
+	Reply_to_msg_id int32            // reply_to_msg_id:flags.3?int
+	Entities        []MessageEntity  // entities:flags.7?Vector<MessageEntity>
+}
+
+func (e TL_updateShortChatMessage) isUpdates() {}
 
 // Encoding TL_updateShortChatMessage
 func (e TL_updateShortChatMessage) encode() []byte {
@@ -5101,7 +6187,7 @@ func (e TL_updateShortChatMessage) encode() []byte {
 	if e.Silent {
 		flags |= (1 << 13)
 	}
-	if _, ok := (e.Fwd_from).(TL_null); !ok {
+	if e.Fwd_from != nil {
 		flags |= (1 << 2)
 	}
 	if e.Via_bot_id > 0 {
@@ -5131,7 +6217,11 @@ func (e TL_updateShortChatMessage) encode() []byte {
 		x.Int(e.Reply_to_msg_id)
 	}
 	if flags&(1<<7) != 0 {
-		x.Vector(e.Entities)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Entities)))
+		for _, v := range e.Entities {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
@@ -5141,10 +6231,12 @@ func (e TL_updateShortChatMessage) encode() []byte {
 const crc_updateShort = 0x78d4dec1
 
 type TL_updateShort struct {
-	Update TL    // update:Update
-	Date   int32 // date:int
+	Update Update // update:Update
+	Date   int32  // date:int
 }
 
+func (e TL_updateShort) isUpdates() {}
+
 // Encoding TL_updateShort
 func (e TL_updateShort) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5159,21 +6251,35 @@ func (e TL_updateShort) encode() []byte {
 const crc_updatesCombined = 0x725b04c3
 
 type TL_updatesCombined struct {
-	Updates   []TL  // updates:Vector<Update>
-	Users     []TL  // users:Vector<User>
-	Chats     []TL  // chats:Vector<Chat>
-	Date      int32 // date:int
-	Seq_start int32 // seq_start:int
-	Seq       int32 // seq:int
+	Updates   []Update // updates:Vector<Update>
+	Users     []User   // users:Vector<User>
+	Chats     []Chat   // chats:Vector<Chat>
+	Date      int32    // date:int
+	Seq_start int32    // seq_start:int
+	Seq       int32    // seq:int
 }
 
+func (e TL_updatesCombined) isUpdates() {}
+
 // Encoding TL_updatesCombined
 func (e TL_updatesCombined) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_updatesCombined)
-	x.Vector(e.Updates)
-	x.Vector(e.Users)
-	x.Vector(e.Chats)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Updates)))
+	for _, v := range e.Updates {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
 	x.Int(e.Date)
 	x.Int(e.Seq_start)
 	x.Int(e.Seq)
@@ -5185,20 +6291,34 @@ func (e TL_updatesCombined) encode() []byte {
 const crc_updates = 0x74ae4240
 
 type TL_updates struct {
-	Updates []TL  // updates:Vector<Update>
-	Users   []TL  // users:Vector<User>
-	Chats   []TL  // chats:Vector<Chat>
-	Date    int32 // date:int
-	Seq     int32 // seq:int
+	Updates []Update // updates:Vector<Update>
+	Users   []User   // users:Vector<User>
+	Chats   []Chat   // chats:Vector<Chat>
+	Date    int32    // date:int
+	Seq     int32    // seq:int
 }
 
+func (e TL_updates) isUpdates() {}
+
 // Encoding TL_updates
 func (e TL_updates) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_updates)
-	x.Vector(e.Updates)
-	x.Vector(e.Users)
-	x.Vector(e.Chats)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Updates)))
+	for _, v := range e.Updates {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
 	x.Int(e.Date)
 	x.Int(e.Seq)
 	return x.buf
@@ -5210,15 +6330,17 @@ const crc_updateShortSentMessage = 0x11f1331c
 
 type TL_updateShortSentMessage struct {
 	Flags     int32
-	Out       bool  // out:flags.1?true
-	Id        int32 // Id:int
-	Pts       int32 // pts:int
-	Pts_count int32 // pts_count:int
-	Date      int32 // date:int
-	Media     TL    // media:flags.9?MessageMedia
-	Entities  []TL  // entities:flags.7?Vector<MessageEntity>
+	Out       bool            // out:flags.1?true
+	Id        int32           // Id:int
+	Pts       int32           // pts:int
+	Pts_count int32           // pts_count:int
+	Date      int32           // date:int
+	Media     MessageMedia    // media:flags.9?MessageMedia
+	Entities  []MessageEntity // entities:flags.7?Vector<MessageEntity>
 }
 
+func (e TL_updateShortSentMessage) isUpdates() {}
+
 // Encoding TL_updateShortSentMessage
 func (e TL_updateShortSentMessage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5227,7 +6349,7 @@ func (e TL_updateShortSentMessage) encode() []byte {
 	if e.Out {
 		flags |= (1 << 1)
 	}
-	if _, ok := (e.Media).(TL_null); !ok {
+	if e.Media != nil {
 		flags |= (1 << 9)
 	}
 	if len(e.Entities) != 0 {
@@ -5242,26 +6364,46 @@ func (e TL_updateShortSentMessage) encode() []byte {
 		x.Bytes(e.Media.encode())
 	}
 	if flags&(1<<7) != 0 {
-		x.Vector(e.Entities)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Entities)))
+		for _, v := range e.Entities {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
 
+// Photos_Photos is implemented by constructors of photos.Photos type
+type Photos_Photos interface {
+	TL
+	isPhotos_Photos()
+}
+
 // photos.photos#8dca6aa5 photos:Vector<Photo> users:Vector<User> = photos.Photos;
 
 const crc_photos_photos = 0x8dca6aa5
 
 type TL_photos_photos struct {
-	Photos []TL // photos:Vector<Photo>
-	Users  []TL // users:Vector<User>
+	Photos []Photo // photos:Vector<Photo>
+	Users  []User  // users:Vector<User>
 }
 
+func (e TL_photos_photos) isPhotos_Photos() {}
+
 // Encoding TL_photos_photos
 func (e TL_photos_photos) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_photos_photos)
-	x.Vector(e.Photos)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Photos)))
+	for _, v := range e.Photos {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -5270,59 +6412,95 @@ func (e TL_photos_photos) encode() []byte {
 const crc_photos_photosSlice = 0x15051f54
 
 type TL_photos_photosSlice struct {
-	Count  int32 // count:int
-	Photos []TL  // photos:Vector<Photo>
-	Users  []TL  // users:Vector<User>
+	Count  int32   // count:int
+	Photos []Photo // photos:Vector<Photo>
+	Users  []User  // users:Vector<User>
 }
 
+func (e TL_photos_photosSlice) isPhotos_Photos() {}
+
 // Encoding TL_photos_photosSlice
 func (e TL_photos_photosSlice) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_photos_photosSlice)
 	x.Int(e.Count)
-	x.Vector(e.Photos)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Photos)))
+	for _, v := range e.Photos {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Photos_Photo is implemented by constructors of photos.Photo type
+type Photos_Photo interface {
+	TL
+	isPhotos_Photo()
+}
+
 // photos.photo#20212ca8 photo:Photo users:Vector<User> = photos.Photo;
 
 const crc_photos_photo = 0x20212ca8
 
 type TL_photos_photo struct {
-	Photo TL   // photo:Photo
-	Users []TL // users:Vector<User>
+	Photo Photo  // photo:Photo
+	Users []User // users:Vector<User>
 }
 
+func (e TL_photos_photo) isPhotos_Photo() {}
+
 // Encoding TL_photos_photo
 func (e TL_photos_photo) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_photos_photo)
 	x.Bytes(e.Photo.encode())
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Upload_File is implemented by constructors of upload.File type
+type Upload_File interface {
+	TL
+	isUpload_File()
+}
+
 // upload.file#96a18d5 type:storage.FileType mtime:int bytes:bytes = upload.File;
 
 const crc_upload_file = 0x96a18d5
 
 type TL_upload_file struct {
-	Code_type TL     // type:storage.FileType
-	Mtime     int32  // mtime:int
-	Bytes     []byte // bytes:bytes
+	Code_type Storage_FileType // type:storage.FileType
+	Mtime     int32            // mtime:int
+	Bytes     []byte           // bytes:bytes
 }
 
+func (e TL_upload_file) isUpload_File() {}
+
 // Encoding TL_upload_file
 func (e TL_upload_file) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_upload_file)
 	x.Bytes(e.Code_type.encode())
 	x.Int(e.Mtime)
-	x.Bytes(e.Bytes)
+	x.StringBytes(e.Bytes)
 	return x.buf
 }
 
+// DcOption is implemented by constructors of DcOption type
+type DcOption interface {
+	TL
+	isDcOption()
+}
+
 // dcOption#5d8c6cc flags:# ipv6:flags.0?true media_only:flags.1?true tcpo_only:flags.2?true Id:int ip_address:string port:int = DcOption;
 
 const crc_dcOption = 0x5d8c6cc
@@ -5337,6 +6515,8 @@ type TL_dcOption struct {
 	Port       int32  // port:int
 }
 
+func (e TL_dcOption) isDcOption() {}
+
 // Encoding TL_dcOption
 func (e TL_dcOption) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5358,43 +6538,51 @@ func (e TL_dcOption) encode() []byte {
 	return x.buf
 }
 
+// Config is implemented by constructors of Config type
+type Config interface {
+	TL
+	isConfig()
+}
+
 // config#cb601684 flags:# phonecalls_enabled:flags.1?true date:int expires:int test_mode:Bool this_dc:int dc_options:Vector<DcOption> chat_size_max:int megagroup_size_max:int forwarded_count_max:int online_update_period_ms:int offline_blur_timeout_ms:int offline_idle_timeout_ms:int online_cloud_timeout_ms:int notify_cloud_delay_ms:int notify_default_delay_ms:int chat_big_size:int push_chat_period_ms:int push_chat_limit:int saved_gifs_limit:int edit_time_limit:int rating_e_decay:int stickers_recent_limit:int tmp_sessions:flags.0?int pinned_dialogs_count_max:int call_receive_timeout_ms:int call_ring_timeout_ms:int call_connect_timeout_ms:int call_packet_timeout_ms:int me_url_prefix:string disabled_features:Vector<DisabledFeature> = Config;
 
 const crc_config = 0xcb601684
 
 type TL_config struct {
 	Flags                    int32
-	Phonecalls_enabled       bool   // phonecalls_enabled:flags.1?true
-	Date                     int32  // date:int
-	Expires                  int32  // expires:int
-	Test_mode                TL     // test_mode:Bool
-	This_dc                  int32  // this_dc:int
-	Dc_options               []TL   // dc_options:Vector<DcOption>
-	Chat_size_max            int32  // chat_size_max:int
-	Megagroup_size_max       int32  // megagroup_size_max:int
-	Forwarded_count_max      int32  // forwarded_count_max:int
-	Online_update_period_ms  int32  // online_update_period_ms:int
-	Offline_blur_timeout_ms  int32  // offline_blur_timeout_ms:int
-	Offline_idle_timeout_ms  int32  // offline_idle_timeout_ms:int
-	Online_cloud_timeout_ms  int32  // online_cloud_timeout_ms:int
-	Notify_cloud_delay_ms    int32  // notify_cloud_delay_ms:int
-	Notify_default_delay_ms  int32  // notify_default_delay_ms:int
-	Chat_big_size            int32  // chat_big_size:int
-	Push_chat_period_ms      int32  // push_chat_period_ms:int
-	Push_chat_limit          int32  // push_chat_limit:int
-	Saved_gifs_limit         int32  // saved_gifs_limit:int
-	Edit_time_limit          int32  // edit_time_limit:int
-	Rating_e_decay           int32  // rating_e_decay:int
-	Stickers_recent_limit    int32  // stickers_recent_limit:int
-	Tmp_sessions             int32  // tmp_sessions:flags.0?int
-	Pinned_dialogs_count_max int32  // pinned_dialogs_count_max:int
-	Call_receive_timeout_ms  int32  // call_receive_timeout_ms:int
-	Call_ring_timeout_ms     int32  // call_ring_timeout_ms:int
-	Call_connect_timeout_ms  int32  // call_connect_timeout_ms:int
-	Call_packet_timeout_ms   int32  // call_packet_timeout_ms:int
-	Me_url_prefix            string // me_url_prefix:string
-	Disabled_features        []TL   // disabled_features:Vector<DisabledFeature>
-}
+	Phonecalls_enabled       bool              // phonecalls_enabled:flags.1?true
+	Date                     int32             // date:int
+	Expires                  int32             // expires:int
+	Test_mode                Bool              // test_mode:Bool
+	This_dc                  int32             // this_dc:int
+	Dc_options               []DcOption        // dc_options:Vector<DcOption>
+	Chat_size_max            int32             // chat_size_max:int
+	Megagroup_size_max       int32             // megagroup_size_max:int
+	Forwarded_count_max      int32             // forwarded_count_max:int
+	Online_update_period_ms  int32             // online_update_period_ms:int
+	Offline_blur_timeout_ms  int32             // offline_blur_timeout_ms:int
+	Offline_idle_timeout_ms  int32             // offline_idle_timeout_ms:int
+	Online_cloud_timeout_ms  int32             // online_cloud_timeout_ms:int
+	Notify_cloud_delay_ms    int32             // notify_cloud_delay_ms:int
+	Notify_default_delay_ms  int32             // notify_default_delay_ms:int
+	Chat_big_size            int32             // chat_big_size:int
+	Push_chat_period_ms      int32             // push_chat_period_ms:int
+	Push_chat_limit          int32             // push_chat_limit:int
+	Saved_gifs_limit         int32             // saved_gifs_limit:int
+	Edit_time_limit          int32             // edit_time_limit:int
+	Rating_e_decay           int32             // rating_e_decay:int
+	Stickers_recent_limit    int32             // stickers_recent_limit:int
+	Tmp_sessions             int32             // tmp_sessions:flags.0?int
+	Pinned_dialogs_count_max int32             // pinned_dialogs_count_max:int
+	Call_receive_timeout_ms  int32             // call_receive_timeout_ms:int
+	Call_ring_timeout_ms     int32             // call_ring_timeout_ms:int
+	Call_connect_timeout_ms  int32             // call_connect_timeout_ms:int
+	Call_packet_timeout_ms   int32             // call_packet_timeout_ms:int
+	Me_url_prefix            string            // me_url_prefix:string
+	Disabled_features        []DisabledFeature // disabled_features:Vector<DisabledFeature>
+}
+
+func (e TL_config) isConfig() {}
 
 // Encoding TL_config
 func (e TL_config) encode() []byte {
@@ -5412,7 +6600,11 @@ func (e TL_config) encode() []byte {
 	x.Int(e.Expires)
 	x.Bytes(e.Test_mode.encode())
 	x.Int(e.This_dc)
-	x.Vector(e.Dc_options)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Dc_options)))
+	for _, v := range e.Dc_options {
+		x.Bytes(v.encode())
+	}
 	x.Int(e.Chat_size_max)
 	x.Int(e.Megagroup_size_max)
 	x.Int(e.Forwarded_count_max)
@@ -5438,10 +6630,20 @@ func (e TL_config) encode() []byte {
 	x.Int(e.Call_connect_timeout_ms)
 	x.Int(e.Call_packet_timeout_ms)
 	x.String(e.Me_url_prefix)
-	x.Vector(e.Disabled_features)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Disabled_features)))
+	for _, v := range e.Disabled_features {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// NearestDc is implemented by constructors of NearestDc type
+type NearestDc interface {
+	TL
+	isNearestDc()
+}
+
 // nearestDc#8e1a1775 country:string this_dc:int nearest_dc:int = NearestDc;
 
 const crc_nearestDc = 0x8e1a1775
@@ -5452,6 +6654,8 @@ type TL_nearestDc struct {
 	Nearest_dc int32  // nearest_dc:int
 }
 
+func (e TL_nearestDc) isNearestDc() {}
+
 // Encoding TL_nearestDc
 func (e TL_nearestDc) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5462,17 +6666,25 @@ func (e TL_nearestDc) encode() []byte {
 	return x.buf
 }
 
+// Help_AppUpdate is implemented by constructors of help.AppUpdate type
+type Help_AppUpdate interface {
+	TL
+	isHelp_AppUpdate()
+}
+
 // help.appUpdate#8987f311 Id:int critical:Bool url:string text:string = help.AppUpdate;
 
 const crc_help_appUpdate = 0x8987f311
 
 type TL_help_appUpdate struct {
 	Id       int32  // Id:int
-	Critical TL     // critical:Bool
+	Critical Bool   // critical:Bool
 	Url      string // url:string
 	Text     string // text:string
 }
 
+func (e TL_help_appUpdate) isHelp_AppUpdate() {}
+
 // Encoding TL_help_appUpdate
 func (e TL_help_appUpdate) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5491,6 +6703,8 @@ const crc_help_noAppUpdate = 0xc45a6536
 type TL_help_noAppUpdate struct {
 }
 
+func (e TL_help_noAppUpdate) isHelp_AppUpdate() {}
+
 // Encoding TL_help_noAppUpdate
 func (e TL_help_noAppUpdate) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5498,6 +6712,12 @@ func (e TL_help_noAppUpdate) encode() []byte {
 	return x.buf
 }
 
+// Help_InviteText is implemented by constructors of help.InviteText type
+type Help_InviteText interface {
+	TL
+	isHelp_InviteText()
+}
+
 // help.inviteText#18cb9f78 message:string = help.InviteText;
 
 const crc_help_inviteText = 0x18cb9f78
@@ -5506,6 +6726,8 @@ type TL_help_inviteText struct {
 	Message string // message:string
 }
 
+func (e TL_help_inviteText) isHelp_InviteText() {}
+
 // Encoding TL_help_inviteText
 func (e TL_help_inviteText) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5514,6 +6736,12 @@ func (e TL_help_inviteText) encode() []byte {
 	return x.buf
 }
 
+// EncryptedChat is implemented by constructors of EncryptedChat type
+type EncryptedChat interface {
+	TL
+	isEncryptedChat()
+}
+
 // encryptedChatEmpty#ab7ec0a0 Id:int = EncryptedChat;
 
 const crc_encryptedChatEmpty = 0xab7ec0a0
@@ -5522,6 +6750,8 @@ type TL_encryptedChatEmpty struct {
 	Id int32 // Id:int
 }
 
+func (e TL_encryptedChatEmpty) isEncryptedChat() {}
+
 // Encoding TL_encryptedChatEmpty
 func (e TL_encryptedChatEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5542,6 +6772,8 @@ type TL_encryptedChatWaiting struct {
 	Participant_id int32 // participant_id:int
 }
 
+func (e TL_encryptedChatWaiting) isEncryptedChat() {}
+
 // Encoding TL_encryptedChatWaiting
 func (e TL_encryptedChatWaiting) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5567,6 +6799,8 @@ type TL_encryptedChatRequested struct {
 	G_a            []byte // g_a:bytes
 }
 
+func (e TL_encryptedChatRequested) isEncryptedChat() {}
+
 // Encoding TL_encryptedChatRequested
 func (e TL_encryptedChatRequested) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5576,7 +6810,7 @@ func (e TL_encryptedChatRequested) encode() []byte {
 	x.Int(e.Date)
 	x.Int(e.Admin_id)
 	x.Int(e.Participant_id)
-	x.Bytes(e.G_a)
+	x.StringBytes(e.G_a)
 	return x.buf
 }
 
@@ -5594,6 +6828,8 @@ type TL_encryptedChat struct {
 	Key_fingerprint int64  // key_fingerprint:long
 }
 
+func (e TL_encryptedChat) isEncryptedChat() {}
+
 // Encoding TL_encryptedChat
 func (e TL_encryptedChat) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5603,7 +6839,7 @@ func (e TL_encryptedChat) encode() []byte {
 	x.Int(e.Date)
 	x.Int(e.Admin_id)
 	x.Int(e.Participant_id)
-	x.Bytes(e.G_a_or_b)
+	x.StringBytes(e.G_a_or_b)
 	x.Long(e.Key_fingerprint)
 	return x.buf
 }
@@ -5616,6 +6852,8 @@ type TL_encryptedChatDiscarded struct {
 	Id int32 // Id:int
 }
 
+func (e TL_encryptedChatDiscarded) isEncryptedChat() {}
+
 // Encoding TL_encryptedChatDiscarded
 func (e TL_encryptedChatDiscarded) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5624,6 +6862,12 @@ func (e TL_encryptedChatDiscarded) encode() []byte {
 	return x.buf
 }
 
+// InputEncryptedChat is implemented by constructors of InputEncryptedChat type
+type InputEncryptedChat interface {
+	TL
+	isInputEncryptedChat()
+}
+
 // inputEncryptedChat#f141b5e1 chat_id:int access_hash:long = InputEncryptedChat;
 
 const crc_inputEncryptedChat = 0xf141b5e1
@@ -5633,6 +6877,8 @@ type TL_inputEncryptedChat struct {
 	Access_hash int64 // access_hash:long
 }
 
+func (e TL_inputEncryptedChat) isInputEncryptedChat() {}
+
 // Encoding TL_inputEncryptedChat
 func (e TL_inputEncryptedChat) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5642,6 +6888,12 @@ func (e TL_inputEncryptedChat) encode() []byte {
 	return x.buf
 }
 
+// EncryptedFile is implemented by constructors of EncryptedFile type
+type EncryptedFile interface {
+	TL
+	isEncryptedFile()
+}
+
 // encryptedFileEmpty#c21f497e = EncryptedFile;
 
 const crc_encryptedFileEmpty = 0xc21f497e
@@ -5649,6 +6901,8 @@ const crc_encryptedFileEmpty = 0xc21f497e
 type TL_encryptedFileEmpty struct {
 }
 
+func (e TL_encryptedFileEmpty) isEncryptedFile() {}
+
 // Encoding TL_encryptedFileEmpty
 func (e TL_encryptedFileEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5668,6 +6922,8 @@ type TL_encryptedFile struct {
 	Key_fingerprint int32 // key_fingerprint:int
 }
 
+func (e TL_encryptedFile) isEncryptedFile() {}
+
 // Encoding TL_encryptedFile
 func (e TL_encryptedFile) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5680,6 +6936,12 @@ func (e TL_encryptedFile) encode() []byte {
 	return x.buf
 }
 
+// InputEncryptedFile is implemented by constructors of InputEncryptedFile type
+type InputEncryptedFile interface {
+	TL
+	isInputEncryptedFile()
+}
+
 // inputEncryptedFileEmpty#1837c364 = InputEncryptedFile;
 
 const crc_inputEncryptedFileEmpty = 0x1837c364
@@ -5687,6 +6949,8 @@ const crc_inputEncryptedFileEmpty = 0x1837c364
 type TL_inputEncryptedFileEmpty struct {
 }
 
+func (e TL_inputEncryptedFileEmpty) isInputEncryptedFile() {}
+
 // Encoding TL_inputEncryptedFileEmpty
 func (e TL_inputEncryptedFileEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5705,6 +6969,8 @@ type TL_inputEncryptedFileUploaded struct {
 	Key_fingerprint int32  // key_fingerprint:int
 }
 
+func (e TL_inputEncryptedFileUploaded) isInputEncryptedFile() {}
+
 // Encoding TL_inputEncryptedFileUploaded
 func (e TL_inputEncryptedFileUploaded) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5725,6 +6991,8 @@ type TL_inputEncryptedFile struct {
 	Access_hash int64 // access_hash:long
 }
 
+func (e TL_inputEncryptedFile) isInputEncryptedFile() {}
+
 // Encoding TL_inputEncryptedFile
 func (e TL_inputEncryptedFile) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5744,6 +7012,8 @@ type TL_inputEncryptedFileBigUploaded struct {
 	Key_fingerprint int32 // key_fingerprint:int
 }
 
+func (e TL_inputEncryptedFileBigUploaded) isInputEncryptedFile() {}
+
 // Encoding TL_inputEncryptedFileBigUploaded
 func (e TL_inputEncryptedFileBigUploaded) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5754,18 +7024,26 @@ func (e TL_inputEncryptedFileBigUploaded) encode() []byte {
 	return x.buf
 }
 
+// EncryptedMessage is implemented by constructors of EncryptedMessage type
+type EncryptedMessage interface {
+	TL
+	isEncryptedMessage()
+}
+
 // encryptedMessage#ed18c118 random_id:long chat_id:int date:int bytes:bytes file:EncryptedFile = EncryptedMessage;
 
 const crc_encryptedMessage = 0xed18c118
 
 type TL_encryptedMessage struct {
-	Random_id int64  // random_id:long
-	Chat_id   int32  // chat_id:int
-	Date      int32  // date:int
-	Bytes     []byte // bytes:bytes
-	File      TL     // file:EncryptedFile
+	Random_id int64         // random_id:long
+	Chat_id   int32         // chat_id:int
+	Date      int32         // date:int
+	Bytes     []byte        // bytes:bytes
+	File      EncryptedFile // file:EncryptedFile
 }
 
+func (e TL_encryptedMessage) isEncryptedMessage() {}
+
 // Encoding TL_encryptedMessage
 func (e TL_encryptedMessage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5773,7 +7051,7 @@ func (e TL_encryptedMessage) encode() []byte {
 	x.Long(e.Random_id)
 	x.Int(e.Chat_id)
 	x.Int(e.Date)
-	x.Bytes(e.Bytes)
+	x.StringBytes(e.Bytes)
 	x.Bytes(e.File.encode())
 	return x.buf
 }
@@ -5789,6 +7067,8 @@ type TL_encryptedMessageService struct {
 	Bytes     []byte // bytes:bytes
 }
 
+func (e TL_encryptedMessageService) isEncryptedMessage() {}
+
 // Encoding TL_encryptedMessageService
 func (e TL_encryptedMessageService) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5796,10 +7076,16 @@ func (e TL_encryptedMessageService) encode() []byte {
 	x.Long(e.Random_id)
 	x.Int(e.Chat_id)
 	x.Int(e.Date)
-	x.Bytes(e.Bytes)
+	x.StringBytes(e.Bytes)
 	return x.buf
 }
 
+// Messages_DhConfig is implemented by constructors of messages.DhConfig type
+type Messages_DhConfig interface {
+	TL
+	isMessages_DhConfig()
+}
+
 // messages.dhConfigNotModified#c0e24635 random:bytes = messages.DhConfig;
 
 const crc_messages_dhConfigNotModified = 0xc0e24635
@@ -5808,11 +7094,13 @@ type TL_messages_dhConfigNotModified struct {
 	Random []byte // random:bytes
 }
 
+func (e TL_messages_dhConfigNotModified) isMessages_DhConfig() {}
+
 // Encoding TL_messages_dhConfigNotModified
 func (e TL_messages_dhConfigNotModified) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_dhConfigNotModified)
-	x.Bytes(e.Random)
+	x.StringBytes(e.Random)
 	return x.buf
 }
 
@@ -5827,17 +7115,25 @@ type TL_messages_dhConfig struct {
 	Random  []byte // random:bytes
 }
 
+func (e TL_messages_dhConfig) isMessages_DhConfig() {}
+
 // Encoding TL_messages_dhConfig
 func (e TL_messages_dhConfig) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_dhConfig)
 	x.Int(e.G)
-	x.Bytes(e.P)
+	x.StringBytes(e.P)
 	x.Int(e.Version)
-	x.Bytes(e.Random)
+	x.StringBytes(e.Random)
 	return x.buf
 }
 
+// Messages_SentEncryptedMessage is implemented by constructors of messages.SentEncryptedMessage type
+type Messages_SentEncryptedMessage interface {
+	TL
+	isMessages_SentEncryptedMessage()
+}
+
 // messages.sentEncryptedMessage#560f8935 date:int = messages.SentEncryptedMessage;
 
 const crc_messages_sentEncryptedMessage = 0x560f8935
@@ -5846,6 +7142,8 @@ type TL_messages_sentEncryptedMessage struct {
 	Date int32 // date:int
 }
 
+func (e TL_messages_sentEncryptedMessage) isMessages_SentEncryptedMessage() {}
+
 // Encoding TL_messages_sentEncryptedMessage
 func (e TL_messages_sentEncryptedMessage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5859,10 +7157,12 @@ func (e TL_messages_sentEncryptedMessage) encode() []byte {
 const crc_messages_sentEncryptedFile = 0x9493ff32
 
 type TL_messages_sentEncryptedFile struct {
-	Date int32 // date:int
-	File TL    // file:EncryptedFile
+	Date int32         // date:int
+	File EncryptedFile // file:EncryptedFile
 }
 
+func (e TL_messages_sentEncryptedFile) isMessages_SentEncryptedMessage() {}
+
 // Encoding TL_messages_sentEncryptedFile
 func (e TL_messages_sentEncryptedFile) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5872,6 +7172,12 @@ func (e TL_messages_sentEncryptedFile) encode() []byte {
 	return x.buf
 }
 
+// InputDocument is implemented by constructors of InputDocument type
+type InputDocument interface {
+	TL
+	isInputDocument()
+}
+
 // inputDocumentEmpty#72f0eaae = InputDocument;
 
 const crc_inputDocumentEmpty = 0x72f0eaae
@@ -5879,6 +7185,8 @@ const crc_inputDocumentEmpty = 0x72f0eaae
 type TL_inputDocumentEmpty struct {
 }
 
+func (e TL_inputDocumentEmpty) isInputDocument() {}
+
 // Encoding TL_inputDocumentEmpty
 func (e TL_inputDocumentEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5895,6 +7203,8 @@ type TL_inputDocument struct {
 	Access_hash int64 // access_hash:long
 }
 
+func (e TL_inputDocument) isInputDocument() {}
+
 // Encoding TL_inputDocument
 func (e TL_inputDocument) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5904,6 +7214,12 @@ func (e TL_inputDocument) encode() []byte {
 	return x.buf
 }
 
+// Document is implemented by constructors of Document type
+type Document interface {
+	TL
+	isDocument()
+}
+
 // documentEmpty#36f8c871 Id:long = Document;
 
 const crc_documentEmpty = 0x36f8c871
@@ -5912,6 +7228,8 @@ type TL_documentEmpty struct {
 	Id int64 // Id:long
 }
 
+func (e TL_documentEmpty) isDocument() {}
+
 // Encoding TL_documentEmpty
 func (e TL_documentEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5925,17 +7243,19 @@ func (e TL_documentEmpty) encode() []byte {
 const crc_document = 0x87232bc7
 
 type TL_document struct {
-	Id          int64  // Id:long
-	Access_hash int64  // access_hash:long
-	Date        int32  // date:int
-	Mime_type   string // mime_type:string
-	Size        int32  // size:int
-	Thumb       TL     // thumb:PhotoSize
-	Dc_id       int32  // dc_id:int
-	Version     int32  // Version:int
-	Attributes  []TL   // attributes:Vector<DocumentAttribute>
+	Id          int64               // Id:long
+	Access_hash int64               // access_hash:long
+	Date        int32               // date:int
+	Mime_type   string              // mime_type:string
+	Size        int32               // size:int
+	Thumb       PhotoSize           // thumb:PhotoSize
+	Dc_id       int32               // dc_id:int
+	Version     int32               // Version:int
+	Attributes  []DocumentAttribute // attributes:Vector<DocumentAttribute>
 }
 
+func (e TL_document) isDocument() {}
+
 // Encoding TL_document
 func (e TL_document) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5948,19 +7268,31 @@ func (e TL_document) encode() []byte {
 	x.Bytes(e.Thumb.encode())
 	x.Int(e.Dc_id)
 	x.Int(e.Version)
-	x.Vector(e.Attributes)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Attributes)))
+	for _, v := range e.Attributes {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Help_Support is implemented by constructors of help.Support type
+type Help_Support interface {
+	TL
+	isHelp_Support()
+}
+
 // help.support#17c6b5f6 phone_number:string user:User = help.Support;
 
 const crc_help_support = 0x17c6b5f6
 
 type TL_help_support struct {
 	Phone_number string // phone_number:string
-	User         TL     // user:User
+	User         User   // user:User
 }
 
+func (e TL_help_support) isHelp_Support() {}
+
 // Encoding TL_help_support
 func (e TL_help_support) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5970,14 +7302,22 @@ func (e TL_help_support) encode() []byte {
 	return x.buf
 }
 
+// NotifyPeer is implemented by constructors of NotifyPeer type
+type NotifyPeer interface {
+	TL
+	isNotifyPeer()
+}
+
 // notifyPeer#9fd40bd8 peer:Peer = NotifyPeer;
 
 const crc_notifyPeer = 0x9fd40bd8
 
 type TL_notifyPeer struct {
-	Peer TL // peer:Peer
+	Peer Peer // peer:Peer
 }
 
+func (e TL_notifyPeer) isNotifyPeer() {}
+
 // Encoding TL_notifyPeer
 func (e TL_notifyPeer) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -5993,6 +7333,8 @@ const crc_notifyUsers = 0xb4c83b4c
 type TL_notifyUsers struct {
 }
 
+func (e TL_notifyUsers) isNotifyPeer() {}
+
 // Encoding TL_notifyUsers
 func (e TL_notifyUsers) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6007,6 +7349,8 @@ const crc_notifyChats = 0xc007cec3
 type TL_notifyChats struct {
 }
 
+func (e TL_notifyChats) isNotifyPeer() {}
+
 // Encoding TL_notifyChats
 func (e TL_notifyChats) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6021,6 +7365,8 @@ const crc_notifyAll = 0x74d07c60
 type TL_notifyAll struct {
 }
 
+func (e TL_notifyAll) isNotifyPeer() {}
+
 // Encoding TL_notifyAll
 func (e TL_notifyAll) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6028,6 +7374,12 @@ func (e TL_notifyAll) encode() []byte {
 	return x.buf
 }
 
+// SendMessageAction is implemented by constructors of SendMessageAction type
+type SendMessageAction interface {
+	TL
+	isSendMessageAction()
+}
+
 // sendMessageTypingAction#16bf744e = SendMessageAction;
 
 const crc_sendMessageTypingAction = 0x16bf744e
@@ -6035,6 +7387,8 @@ const crc_sendMessageTypingAction = 0x16bf744e
 type TL_sendMessageTypingAction struct {
 }
 
+func (e TL_sendMessageTypingAction) isSendMessageAction() {}
+
 // Encoding TL_sendMessageTypingAction
 func (e TL_sendMessageTypingAction) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6049,6 +7403,8 @@ const crc_sendMessageCancelAction = 0xfd5ec8f5
 type TL_sendMessageCancelAction struct {
 }
 
+func (e TL_sendMessageCancelAction) isSendMessageAction() {}
+
 // Encoding TL_sendMessageCancelAction
 func (e TL_sendMessageCancelAction) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6063,6 +7419,8 @@ const crc_sendMessageRecordVideoAction = 0xa187d66f
 type TL_sendMessageRecordVideoAction struct {
 }
 
+func (e TL_sendMessageRecordVideoAction) isSendMessageAction() {}
+
 // Encoding TL_sendMessageRecordVideoAction
 func (e TL_sendMessageRecordVideoAction) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6078,6 +7436,8 @@ type TL_sendMessageUploadVideoAction struct {
 	Progress int32 // progress:int
 }
 
+func (e TL_sendMessageUploadVideoAction) isSendMessageAction() {}
+
 // Encoding TL_sendMessageUploadVideoAction
 func (e TL_sendMessageUploadVideoAction) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6093,6 +7453,8 @@ const crc_sendMessageRecordAudioAction = 0xd52f73f7
 type TL_sendMessageRecordAudioAction struct {
 }
 
+func (e TL_sendMessageRecordAudioAction) isSendMessageAction() {}
+
 // Encoding TL_sendMessageRecordAudioAction
 func (e TL_sendMessageRecordAudioAction) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6108,6 +7470,8 @@ type TL_sendMessageUploadAudioAction struct {
 	Progress int32 // progress:int
 }
 
+func (e TL_sendMessageUploadAudioAction) isSendMessageAction() {}
+
 // Encoding TL_sendMessageUploadAudioAction
 func (e TL_sendMessageUploadAudioAction) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6124,6 +7488,8 @@ type TL_sendMessageUploadPhotoAction struct {
 	Progress int32 // progress:int
 }
 
+func (e TL_sendMessageUploadPhotoAction) isSendMessageAction() {}
+
 // Encoding TL_sendMessageUploadPhotoAction
 func (e TL_sendMessageUploadPhotoAction) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6140,6 +7506,8 @@ type TL_sendMessageUploadDocumentAction struct {
 	Progress int32 // progress:int
 }
 
+func (e TL_sendMessageUploadDocumentAction) isSendMessageAction() {}
+
 // Encoding TL_sendMessageUploadDocumentAction
 func (e TL_sendMessageUploadDocumentAction) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6155,6 +7523,8 @@ const crc_sendMessageGeoLocationAction = 0x176f8ba1
 type TL_sendMessageGeoLocationAction struct {
 }
 
+func (e TL_sendMessageGeoLocationAction) isSendMessageAction() {}
+
 // Encoding TL_sendMessageGeoLocationAction
 func (e TL_sendMessageGeoLocationAction) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6169,6 +7539,8 @@ const crc_sendMessageChooseContactAction = 0x628cbc6f
 type TL_sendMessageChooseContactAction struct {
 }
 
+func (e TL_sendMessageChooseContactAction) isSendMessageAction() {}
+
 // Encoding TL_sendMessageChooseContactAction
 func (e TL_sendMessageChooseContactAction) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6183,6 +7555,8 @@ const crc_sendMessageGamePlayAction = 0xdd6a8f48
 type TL_sendMessageGamePlayAction struct {
 }
 
+func (e TL_sendMessageGamePlayAction) isSendMessageAction() {}
+
 // Encoding TL_sendMessageGamePlayAction
 func (e TL_sendMessageGamePlayAction) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6190,26 +7564,52 @@ func (e TL_sendMessageGamePlayAction) encode() []byte {
 	return x.buf
 }
 
+// Contacts_Found is implemented by constructors of contacts.Found type
+type Contacts_Found interface {
+	TL
+	isContacts_Found()
+}
+
 // contacts.found#1aa1f784 results:Vector<Peer> chats:Vector<Chat> users:Vector<User> = contacts.Found;
 
 const crc_contacts_found = 0x1aa1f784
 
 type TL_contacts_found struct {
-	Results []TL // results:Vector<Peer>
-	Chats   []TL // chats:Vector<Chat>
-	Users   []TL // users:Vector<User>
+	Results []Peer // results:Vector<Peer>
+	Chats   []Chat // chats:Vector<Chat>
+	Users   []User // users:Vector<User>
 }
 
+func (e TL_contacts_found) isContacts_Found() {}
+
 // Encoding TL_contacts_found
 func (e TL_contacts_found) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_contacts_found)
-	x.Vector(e.Results)
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Results)))
+	for _, v := range e.Results {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// InputPrivacyKey is implemented by constructors of InputPrivacyKey type
+type InputPrivacyKey interface {
+	TL
+	isInputPrivacyKey()
+}
+
 // inputPrivacyKeyStatusTimestamp#4f96cb18 = InputPrivacyKey;
 
 const crc_inputPrivacyKeyStatusTimestamp = 0x4f96cb18
@@ -6217,6 +7617,8 @@ const crc_inputPrivacyKeyStatusTimestamp = 0x4f96cb18
 type TL_inputPrivacyKeyStatusTimestamp struct {
 }
 
+func (e TL_inputPrivacyKeyStatusTimestamp) isInputPrivacyKey() {}
+
 // Encoding TL_inputPrivacyKeyStatusTimestamp
 func (e TL_inputPrivacyKeyStatusTimestamp) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6231,6 +7633,8 @@ const crc_inputPrivacyKeyChatInvite = 0xbdfb0426
 type TL_inputPrivacyKeyChatInvite struct {
 }
 
+func (e TL_inputPrivacyKeyChatInvite) isInputPrivacyKey() {}
+
 // Encoding TL_inputPrivacyKeyChatInvite
 func (e TL_inputPrivacyKeyChatInvite) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6245,6 +7649,8 @@ const crc_inputPrivacyKeyPhoneCall = 0xfabadc5f
 type TL_inputPrivacyKeyPhoneCall struct {
 }
 
+func (e TL_inputPrivacyKeyPhoneCall) isInputPrivacyKey() {}
+
 // Encoding TL_inputPrivacyKeyPhoneCall
 func (e TL_inputPrivacyKeyPhoneCall) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6252,6 +7658,12 @@ func (e TL_inputPrivacyKeyPhoneCall) encode() []byte {
 	return x.buf
 }
 
+// PrivacyKey is implemented by constructors of PrivacyKey type
+type PrivacyKey interface {
+	TL
+	isPrivacyKey()
+}
+
 // privacyKeyStatusTimestamp#bc2eab30 = PrivacyKey;
 
 const crc_privacyKeyStatusTimestamp = 0xbc2eab30
@@ -6259,6 +7671,8 @@ const crc_privacyKeyStatusTimestamp = 0xbc2eab30
 type TL_privacyKeyStatusTimestamp struct {
 }
 
+func (e TL_privacyKeyStatusTimestamp) isPrivacyKey() {}
+
 // Encoding TL_privacyKeyStatusTimestamp
 func (e TL_privacyKeyStatusTimestamp) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6273,6 +7687,8 @@ const crc_privacyKeyChatInvite = 0x500e6dfa
 type TL_privacyKeyChatInvite struct {
 }
 
+func (e TL_privacyKeyChatInvite) isPrivacyKey() {}
+
 // Encoding TL_privacyKeyChatInvite
 func (e TL_privacyKeyChatInvite) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6287,6 +7703,8 @@ const crc_privacyKeyPhoneCall = 0x3d662b7b
 type TL_privacyKeyPhoneCall struct {
 }
 
+func (e TL_privacyKeyPhoneCall) isPrivacyKey() {}
+
 // Encoding TL_privacyKeyPhoneCall
 func (e TL_privacyKeyPhoneCall) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6294,6 +7712,12 @@ func (e TL_privacyKeyPhoneCall) encode() []byte {
 	return x.buf
 }
 
+// InputPrivacyRule is implemented by constructors of InputPrivacyRule type
+type InputPrivacyRule interface {
+	TL
+	isInputPrivacyRule()
+}
+
 // inputPrivacyValueAllowContacts#d09e07b = InputPrivacyRule;
 
 const crc_inputPrivacyValueAllowContacts = 0xd09e07b
@@ -6301,6 +7725,8 @@ const crc_inputPrivacyValueAllowContacts = 0xd09e07b
 type TL_inputPrivacyValueAllowContacts struct {
 }
 
+func (e TL_inputPrivacyValueAllowContacts) isInputPrivacyRule() {}
+
 // Encoding TL_inputPrivacyValueAllowContacts
 func (e TL_inputPrivacyValueAllowContacts) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6315,6 +7741,8 @@ const crc_inputPrivacyValueAllowAll = 0x184b35ce
 type TL_inputPrivacyValueAllowAll struct {
 }
 
+func (e TL_inputPrivacyValueAllowAll) isInputPrivacyRule() {}
+
 // Encoding TL_inputPrivacyValueAllowAll
 func (e TL_inputPrivacyValueAllowAll) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6327,14 +7755,20 @@ func (e TL_inputPrivacyValueAllowAll) encode() []byte {
 const crc_inputPrivacyValueAllowUsers = 0x131cc67f
 
 type TL_inputPrivacyValueAllowUsers struct {
-	Users []TL // users:Vector<InputUser>
+	Users []InputUser // users:Vector<InputUser>
 }
 
+func (e TL_inputPrivacyValueAllowUsers) isInputPrivacyRule() {}
+
 // Encoding TL_inputPrivacyValueAllowUsers
 func (e TL_inputPrivacyValueAllowUsers) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_inputPrivacyValueAllowUsers)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -6345,6 +7779,8 @@ const crc_inputPrivacyValueDisallowContacts = 0xba52007
 type TL_inputPrivacyValueDisallowContacts struct {
 }
 
+func (e TL_inputPrivacyValueDisallowContacts) isInputPrivacyRule() {}
+
 // Encoding TL_inputPrivacyValueDisallowContacts
 func (e TL_inputPrivacyValueDisallowContacts) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6359,6 +7795,8 @@ const crc_inputPrivacyValueDisallowAll = 0xd66b66c9
 type TL_inputPrivacyValueDisallowAll struct {
 }
 
+func (e TL_inputPrivacyValueDisallowAll) isInputPrivacyRule() {}
+
 // Encoding TL_inputPrivacyValueDisallowAll
 func (e TL_inputPrivacyValueDisallowAll) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6371,17 +7809,29 @@ func (e TL_inputPrivacyValueDisallowAll) encode() []byte {
 const crc_inputPrivacyValueDisallowUsers = 0x90110467
 
 type TL_inputPrivacyValueDisallowUsers struct {
-	Users []TL // users:Vector<InputUser>
+	Users []InputUser // users:Vector<InputUser>
 }
 
+func (e TL_inputPrivacyValueDisallowUsers) isInputPrivacyRule() {}
+
 // Encoding TL_inputPrivacyValueDisallowUsers
 func (e TL_inputPrivacyValueDisallowUsers) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_inputPrivacyValueDisallowUsers)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// PrivacyRule is implemented by constructors of PrivacyRule type
+type PrivacyRule interface {
+	TL
+	isPrivacyRule()
+}
+
 // privacyValueAllowContacts#fffe1bac = PrivacyRule;
 
 const crc_privacyValueAllowContacts = 0xfffe1bac
@@ -6389,6 +7839,8 @@ const crc_privacyValueAllowContacts = 0xfffe1bac
 type TL_privacyValueAllowContacts struct {
 }
 
+func (e TL_privacyValueAllowContacts) isPrivacyRule() {}
+
 // Encoding TL_privacyValueAllowContacts
 func (e TL_privacyValueAllowContacts) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6403,6 +7855,8 @@ const crc_privacyValueAllowAll = 0x65427b82
 type TL_privacyValueAllowAll struct {
 }
 
+func (e TL_privacyValueAllowAll) isPrivacyRule() {}
+
 // Encoding TL_privacyValueAllowAll
 func (e TL_privacyValueAllowAll) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6418,6 +7872,8 @@ type TL_privacyValueAllowUsers struct {
 	Users []int32 // users:Vector<int>
 }
 
+func (e TL_privacyValueAllowUsers) isPrivacyRule() {}
+
 // Encoding TL_privacyValueAllowUsers
 func (e TL_privacyValueAllowUsers) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6433,6 +7889,8 @@ const crc_privacyValueDisallowContacts = 0xf888fa1a
 type TL_privacyValueDisallowContacts struct {
 }
 
+func (e TL_privacyValueDisallowContacts) isPrivacyRule() {}
+
 // Encoding TL_privacyValueDisallowContacts
 func (e TL_privacyValueDisallowContacts) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6447,6 +7905,8 @@ const crc_privacyValueDisallowAll = 0x8b73e763
 type TL_privacyValueDisallowAll struct {
 }
 
+func (e TL_privacyValueDisallowAll) isPrivacyRule() {}
+
 // Encoding TL_privacyValueDisallowAll
 func (e TL_privacyValueDisallowAll) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6462,6 +7922,8 @@ type TL_privacyValueDisallowUsers struct {
 	Users []int32 // users:Vector<int>
 }
 
+func (e TL_privacyValueDisallowUsers) isPrivacyRule() {}
+
 // Encoding TL_privacyValueDisallowUsers
 func (e TL_privacyValueDisallowUsers) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6470,24 +7932,46 @@ func (e TL_privacyValueDisallowUsers) encode() []byte {
 	return x.buf
 }
 
+// Account_PrivacyRules is implemented by constructors of account.PrivacyRules type
+type Account_PrivacyRules interface {
+	TL
+	isAccount_PrivacyRules()
+}
+
 // account.privacyRules#554abb6f rules:Vector<PrivacyRule> users:Vector<User> = account.PrivacyRules;
 
 const crc_account_privacyRules = 0x554abb6f
 
 type TL_account_privacyRules struct {
-	Rules []TL // rules:Vector<PrivacyRule>
-	Users []TL // users:Vector<User>
+	Rules []PrivacyRule // rules:Vector<PrivacyRule>
+	Users []User        // users:Vector<User>
 }
 
+func (e TL_account_privacyRules) isAccount_PrivacyRules() {}
+
 // Encoding TL_account_privacyRules
 func (e TL_account_privacyRules) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_account_privacyRules)
-	x.Vector(e.Rules)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Rules)))
+	for _, v := range e.Rules {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// AccountDaysTTL is implemented by constructors of AccountDaysTTL type
+type AccountDaysTTL interface {
+	TL
+	isAccountDaysTTL()
+}
+
 // accountDaysTTL#b8d0afdf days:int = AccountDaysTTL;
 
 const crc_accountDaysTTL = 0xb8d0afdf
@@ -6496,6 +7980,8 @@ type TL_accountDaysTTL struct {
 	Days int32 // days:int
 }
 
+func (e TL_accountDaysTTL) isAccountDaysTTL() {}
+
 // Encoding TL_accountDaysTTL
 func (e TL_accountDaysTTL) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6504,6 +7990,12 @@ func (e TL_accountDaysTTL) encode() []byte {
 	return x.buf
 }
 
+// DocumentAttribute is implemented by constructors of DocumentAttribute type
+type DocumentAttribute interface {
+	TL
+	isDocumentAttribute()
+}
+
 // documentAttributeImageSize#6c37c15c w:int h:int = DocumentAttribute;
 
 const crc_documentAttributeImageSize = 0x6c37c15c
@@ -6513,6 +8005,8 @@ type TL_documentAttributeImageSize struct {
 	H int32 // h:int
 }
 
+func (e TL_documentAttributeImageSize) isDocumentAttribute() {}
+
 // Encoding TL_documentAttributeImageSize
 func (e TL_documentAttributeImageSize) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6529,6 +8023,8 @@ const crc_documentAttributeAnimated = 0x11b58939
 type TL_documentAttributeAnimated struct {
 }
 
+func (e TL_documentAttributeAnimated) isDocumentAttribute() {}
+
 // Encoding TL_documentAttributeAnimated
 func (e TL_documentAttributeAnimated) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6542,12 +8038,14 @@ const crc_documentAttributeSticker = 0x6319d612
 
 type TL_documentAttributeSticker struct {
 	Flags       int32
-	Mask        bool   // mask:flags.1?true
-	Alt         string // alt:string
-	Stickerset  TL     // stickerset:InputStickerSet
-	Mask_coords TL     // mask_coords:flags.0?MaskCoords
+	Mask        bool            // mask:flags.1?true
+	Alt         string          // alt:string
+	Stickerset  InputStickerSet // stickerset:InputStickerSet
+	Mask_coords MaskCoords      // mask_coords:flags.0?MaskCoords
 }
 
+func (e TL_documentAttributeSticker) isDocumentAttribute() {}
+
 // Encoding TL_documentAttributeSticker
 func (e TL_documentAttributeSticker) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6556,7 +8054,7 @@ func (e TL_documentAttributeSticker) encode() []byte {
 	if e.Mask {
 		flags |= (1 << 1)
 	}
-	if _, ok := (e.Mask_coords).(TL_null); !ok {
+	if e.Mask_coords != nil {
 		flags |= (1 << 0)
 	}
 	x.Int(flags)
@@ -6578,6 +8076,8 @@ type TL_documentAttributeVideo struct {
 	H        int32 // h:int
 }
 
+func (e TL_documentAttributeVideo) isDocumentAttribute() {}
+
 // Encoding TL_documentAttributeVideo
 func (e TL_documentAttributeVideo) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6601,6 +8101,8 @@ type TL_documentAttributeAudio struct {
 	Waveform  []byte // waveform:flags.2?bytes
 }
 
+func (e TL_documentAttributeAudio) isDocumentAttribute() {}
+
 // Encoding TL_documentAttributeAudio
 func (e TL_documentAttributeAudio) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6627,7 +8129,7 @@ func (e TL_documentAttributeAudio) encode() []byte {
 		x.String(e.Performer)
 	}
 	if flags&(1<<2) != 0 {
-		x.Bytes(e.Waveform)
+		x.StringBytes(e.Waveform)
 	}
 	return x.buf
 }
@@ -6640,6 +8142,8 @@ type TL_documentAttributeFilename struct {
 	File_name string // file_name:string
 }
 
+func (e TL_documentAttributeFilename) isDocumentAttribute() {}
+
 // Encoding TL_documentAttributeFilename
 func (e TL_documentAttributeFilename) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6655,6 +8159,8 @@ const crc_documentAttributeHasStickers = 0x9801d2f7
 type TL_documentAttributeHasStickers struct {
 }
 
+func (e TL_documentAttributeHasStickers) isDocumentAttribute() {}
+
 // Encoding TL_documentAttributeHasStickers
 func (e TL_documentAttributeHasStickers) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6662,6 +8168,12 @@ func (e TL_documentAttributeHasStickers) encode() []byte {
 	return x.buf
 }
 
+// Messages_Stickers is implemented by constructors of messages.Stickers type
+type Messages_Stickers interface {
+	TL
+	isMessages_Stickers()
+}
+
 // messages.stickersNotModified#f1749a22 = messages.Stickers;
 
 const crc_messages_stickersNotModified = 0xf1749a22
@@ -6669,6 +8181,8 @@ const crc_messages_stickersNotModified = 0xf1749a22
 type TL_messages_stickersNotModified struct {
 }
 
+func (e TL_messages_stickersNotModified) isMessages_Stickers() {}
+
 // Encoding TL_messages_stickersNotModified
 func (e TL_messages_stickersNotModified) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6681,19 +8195,31 @@ func (e TL_messages_stickersNotModified) encode() []byte {
 const crc_messages_stickers = 0x8a8ecd32
 
 type TL_messages_stickers struct {
-	Hash     string // Hash:string
-	Stickers []TL   // stickers:Vector<Document>
+	Hash     string     // Hash:string
+	Stickers []Document // stickers:Vector<Document>
 }
 
+func (e TL_messages_stickers) isMessages_Stickers() {}
+
 // Encoding TL_messages_stickers
 func (e TL_messages_stickers) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_stickers)
 	x.String(e.Hash)
-	x.Vector(e.Stickers)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Stickers)))
+	for _, v := range e.Stickers {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// StickerPack is implemented by constructors of StickerPack type
+type StickerPack interface {
+	TL
+	isStickerPack()
+}
+
 // stickerPack#12b299d4 emoticon:string documents:Vector<long> = StickerPack;
 
 const crc_stickerPack = 0x12b299d4
@@ -6703,6 +8229,8 @@ type TL_stickerPack struct {
 	Documents []int64 // documents:Vector<long>
 }
 
+func (e TL_stickerPack) isStickerPack() {}
+
 // Encoding TL_stickerPack
 func (e TL_stickerPack) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6712,6 +8240,12 @@ func (e TL_stickerPack) encode() []byte {
 	return x.buf
 }
 
+// Messages_AllStickers is implemented by constructors of messages.AllStickers type
+type Messages_AllStickers interface {
+	TL
+	isMessages_AllStickers()
+}
+
 // messages.allStickersNotModified#e86602c3 = messages.AllStickers;
 
 const crc_messages_allStickersNotModified = 0xe86602c3
@@ -6719,6 +8253,8 @@ const crc_messages_allStickersNotModified = 0xe86602c3
 type TL_messages_allStickersNotModified struct {
 }
 
+func (e TL_messages_allStickersNotModified) isMessages_AllStickers() {}
+
 // Encoding TL_messages_allStickersNotModified
 func (e TL_messages_allStickersNotModified) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6731,19 +8267,31 @@ func (e TL_messages_allStickersNotModified) encode() []byte {
 const crc_messages_allStickers = 0xedfd405f
 
 type TL_messages_allStickers struct {
-	Hash int32 // Hash:int
-	Sets []TL  // sets:Vector<StickerSet>
+	Hash int32        // Hash:int
+	Sets []StickerSet // sets:Vector<StickerSet>
 }
 
+func (e TL_messages_allStickers) isMessages_AllStickers() {}
+
 // Encoding TL_messages_allStickers
 func (e TL_messages_allStickers) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_allStickers)
 	x.Int(e.Hash)
-	x.Vector(e.Sets)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Sets)))
+	for _, v := range e.Sets {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// DisabledFeature is implemented by constructors of DisabledFeature type
+type DisabledFeature interface {
+	TL
+	isDisabledFeature()
+}
+
 // disabledFeature#ae636f24 feature:string description:string = DisabledFeature;
 
 const crc_disabledFeature = 0xae636f24
@@ -6753,6 +8301,8 @@ type TL_disabledFeature struct {
 	Description string // description:string
 }
 
+func (e TL_disabledFeature) isDisabledFeature() {}
+
 // Encoding TL_disabledFeature
 func (e TL_disabledFeature) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6762,6 +8312,12 @@ func (e TL_disabledFeature) encode() []byte {
 	return x.buf
 }
 
+// Messages_AffectedMessages is implemented by constructors of messages.AffectedMessages type
+type Messages_AffectedMessages interface {
+	TL
+	isMessages_AffectedMessages()
+}
+
 // messages.affectedMessages#84d19185 pts:int pts_count:int = messages.AffectedMessages;
 
 const crc_messages_affectedMessages = 0x84d19185
@@ -6771,6 +8327,8 @@ type TL_messages_affectedMessages struct {
 	Pts_count int32 // pts_count:int
 }
 
+func (e TL_messages_affectedMessages) isMessages_AffectedMessages() {}
+
 // Encoding TL_messages_affectedMessages
 func (e TL_messages_affectedMessages) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6780,6 +8338,12 @@ func (e TL_messages_affectedMessages) encode() []byte {
 	return x.buf
 }
 
+// ContactLink is implemented by constructors of ContactLink type
+type ContactLink interface {
+	TL
+	isContactLink()
+}
+
 // contactLinkUnknown#5f4f9247 = ContactLink;
 
 const crc_contactLinkUnknown = 0x5f4f9247
@@ -6787,6 +8351,8 @@ const crc_contactLinkUnknown = 0x5f4f9247
 type TL_contactLinkUnknown struct {
 }
 
+func (e TL_contactLinkUnknown) isContactLink() {}
+
 // Encoding TL_contactLinkUnknown
 func (e TL_contactLinkUnknown) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6801,6 +8367,8 @@ const crc_contactLinkNone = 0xfeedd3ad
 type TL_contactLinkNone struct {
 }
 
+func (e TL_contactLinkNone) isContactLink() {}
+
 // Encoding TL_contactLinkNone
 func (e TL_contactLinkNone) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6815,6 +8383,8 @@ const crc_contactLinkHasPhone = 0x268f3f59
 type TL_contactLinkHasPhone struct {
 }
 
+func (e TL_contactLinkHasPhone) isContactLink() {}
+
 // Encoding TL_contactLinkHasPhone
 func (e TL_contactLinkHasPhone) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6829,6 +8399,8 @@ const crc_contactLinkContact = 0xd502c2d0
 type TL_contactLinkContact struct {
 }
 
+func (e TL_contactLinkContact) isContactLink() {}
+
 // Encoding TL_contactLinkContact
 func (e TL_contactLinkContact) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6836,6 +8408,12 @@ func (e TL_contactLinkContact) encode() []byte {
 	return x.buf
 }
 
+// WebPage is implemented by constructors of WebPage type
+type WebPage interface {
+	TL
+	isWebPage()
+}
+
 // webPageEmpty#eb1477e8 Id:long = WebPage;
 
 const crc_webPageEmpty = 0xeb1477e8
@@ -6844,6 +8422,8 @@ type TL_webPageEmpty struct {
 	Id int64 // Id:long
 }
 
+func (e TL_webPageEmpty) isWebPage() {}
+
 // Encoding TL_webPageEmpty
 func (e TL_webPageEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6861,6 +8441,8 @@ type TL_webPagePending struct {
 	Date int32 // date:int
 }
 
+func (e TL_webPagePending) isWebPage() {}
+
 // Encoding TL_webPagePending
 func (e TL_webPagePending) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -6876,24 +8458,26 @@ const crc_webPage = 0x5f07b4bc
 
 type TL_webPage struct {
 	Flags        int32
-	Id           int64  // Id:long
-	Url          string // url:string
-	Display_url  string // display_url:string
-	Hash         int32  // Hash:int
-	Code_type    string // type:flags.0?string
-	Site_name    string // site_name:flags.1?string
-	Title        string // title:flags.2?string
-	Description  string // description:flags.3?string
-	Photo        TL     // photo:flags.4?Photo
-	Embed_url    string // embed_url:flags.5?string
-	Embed_type   string // embed_type:flags.5?string
-	Embed_width  int32  // embed_width:flags.6?int
-	Embed_height int32  // embed_height:flags.6?int
-	Duration     int32  // duration:flags.7?int
-	Author       string // author:flags.8?string
-	Document     TL     // document:flags.9?Document
-	Cached_page  TL     // cached_page:flags.10?Page
-}
+	Id           int64    // Id:long
+	Url          string   // url:string
+	Display_url  string   // display_url:string
+	Hash         int32    // Hash:int
+	Code_type    string   // type:flags.0?string
+	Site_name    string   // site_name:flags.1?string
+	Title        string   // title:flags.2?string
+	Description  string   // description:flags.3?string
+	Photo        Photo    // photo:flags.4?Photo
+	Embed_url    string   // embed_url:flags.5?string
+	Embed_type   string   // embed_type:flags.5?string
+	Embed_width  int32    // embed_width:flags.6?int
+	Embed_height int32    // embed_height:flags.6?int
+	Duration     int32    // duration:flags.7?int
+	Author       string   // author:flags.8?string
+	Document     Document // document:flags.9?Document
+	Cached_page  Page     // cached_page:flags.10?Page
+}
+
+func (e TL_webPage) isWebPage() {}
 
 // Encoding TL_webPage
 func (e TL_webPage) encode() []byte {
@@ -6912,7 +8496,7 @@ func (e TL_webPage) encode() []byte {
 	if e.Description != "" {
 		flags |= (1 << 3)
 	}
-	if _, ok := (e.Photo).(TL_null); !ok {
+	if e.Photo != nil {
 		flags |= (1 << 4)
 	}
 	if e.Embed_url != "" {
@@ -6933,10 +8517,10 @@ func (e TL_webPage) encode() []byte {
 	if e.Author != "" {
 		flags |= (1 << 8)
 	}
-	if _, ok := (e.Document).(TL_null); !ok {
+	if e.Document != nil {
 		flags |= (1 << 9)
 	}
-	if _, ok := (e.Cached_page).(TL_null); !ok {
+	if e.Cached_page != nil {
 		flags |= (1 << 10)
 	}
 	x.Int(flags)
@@ -6993,6 +8577,8 @@ const crc_webPageNotModified = 0x85849473
 type TL_webPageNotModified struct {
 }
 
+func (e TL_webPageNotModified) isWebPage() {}
+
 // Encoding TL_webPageNotModified
 func (e TL_webPageNotModified) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7000,6 +8586,12 @@ func (e TL_webPageNotModified) encode() []byte {
 	return x.buf
 }
 
+// Authorization is implemented by constructors of Authorization type
+type Authorization interface {
+	TL
+	isAuthorization()
+}
+
 // authorization#7bf2e6f6 Hash:long flags:int device_model:string platform:string system_version:string api_id:int app_name:string app_version:string date_created:int date_active:int ip:string country:string region:string = Authorization;
 
 const crc_authorization = 0x7bf2e6f6
@@ -7020,6 +8612,8 @@ type TL_authorization struct {
 	Region         string // region:string
 }
 
+func (e TL_authorization) isAuthorization() {}
+
 // Encoding TL_authorization
 func (e TL_authorization) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7040,22 +8634,40 @@ func (e TL_authorization) encode() []byte {
 	return x.buf
 }
 
+// Account_Authorizations is implemented by constructors of account.Authorizations type
+type Account_Authorizations interface {
+	TL
+	isAccount_Authorizations()
+}
+
 // account.authorizations#1250abde authorizations:Vector<Authorization> = account.Authorizations;
 
 const crc_account_authorizations = 0x1250abde
 
 type TL_account_authorizations struct {
-	Authorizations []TL // authorizations:Vector<Authorization>
+	Authorizations []Authorization // authorizations:Vector<Authorization>
 }
 
+func (e TL_account_authorizations) isAccount_Authorizations() {}
+
 // Encoding TL_account_authorizations
 func (e TL_account_authorizations) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_account_authorizations)
-	x.Vector(e.Authorizations)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Authorizations)))
+	for _, v := range e.Authorizations {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Account_Password is implemented by constructors of account.Password type
+type Account_Password interface {
+	TL
+	isAccount_Password()
+}
+
 // account.noPassword#96dabc18 new_salt:bytes email_unconfirmed_pattern:string = account.Password;
 
 const crc_account_noPassword = 0x96dabc18
@@ -7065,11 +8677,13 @@ type TL_account_noPassword struct {
 	Email_unconfirmed_pattern string // email_unconfirmed_pattern:string
 }
 
+func (e TL_account_noPassword) isAccount_Password() {}
+
 // Encoding TL_account_noPassword
 func (e TL_account_noPassword) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_account_noPassword)
-	x.Bytes(e.New_salt)
+	x.StringBytes(e.New_salt)
 	x.String(e.Email_unconfirmed_pattern)
 	return x.buf
 }
@@ -7082,22 +8696,30 @@ type TL_account_password struct {
 	Current_salt              []byte // current_salt:bytes
 	New_salt                  []byte // new_salt:bytes
 	Hint                      string // hint:string
-	Has_recovery              TL     // has_recovery:Bool
+	Has_recovery              Bool   // has_recovery:Bool
 	Email_unconfirmed_pattern string // email_unconfirmed_pattern:string
 }
 
+func (e TL_account_password) isAccount_Password() {}
+
 // Encoding TL_account_password
 func (e TL_account_password) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_account_password)
-	x.Bytes(e.Current_salt)
-	x.Bytes(e.New_salt)
+	x.StringBytes(e.Current_salt)
+	x.StringBytes(e.New_salt)
 	x.String(e.Hint)
 	x.Bytes(e.Has_recovery.encode())
 	x.String(e.Email_unconfirmed_pattern)
 	return x.buf
 }
 
+// Account_PasswordSettings is implemented by constructors of account.PasswordSettings type
+type Account_PasswordSettings interface {
+	TL
+	isAccount_PasswordSettings()
+}
+
 // account.passwordSettings#b7b72ab3 email:string = account.PasswordSettings;
 
 const crc_account_passwordSettings = 0xb7b72ab3
@@ -7106,6 +8728,8 @@ type TL_account_passwordSettings struct {
 	Email string // email:string
 }
 
+func (e TL_account_passwordSettings) isAccount_PasswordSettings() {}
+
 // Encoding TL_account_passwordSettings
 func (e TL_account_passwordSettings) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7114,6 +8738,12 @@ func (e TL_account_passwordSettings) encode() []byte {
 	return x.buf
 }
 
+// Account_PasswordInputSettings is implemented by constructors of account.PasswordInputSettings type
+type Account_PasswordInputSettings interface {
+	TL
+	isAccount_PasswordInputSettings()
+}
+
 // account.passwordInputSettings#86916deb flags:# new_salt:flags.0?bytes new_password_hash:flags.0?bytes hint:flags.0?string email:flags.1?string = account.PasswordInputSettings;
 
 const crc_account_passwordInputSettings = 0x86916deb
@@ -7126,6 +8756,8 @@ type TL_account_passwordInputSettings struct {
 	Email             string // email:flags.1?string
 }
 
+func (e TL_account_passwordInputSettings) isAccount_PasswordInputSettings() {}
+
 // Encoding TL_account_passwordInputSettings
 func (e TL_account_passwordInputSettings) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7145,10 +8777,10 @@ func (e TL_account_passwordInputSettings) encode() []byte {
 	}
 	x.Int(flags)
 	if flags&(1<<0) != 0 {
-		x.Bytes(e.New_salt)
+		x.StringBytes(e.New_salt)
 	}
 	if flags&(1<<0) != 0 {
-		x.Bytes(e.New_password_hash)
+		x.StringBytes(e.New_password_hash)
 	}
 	if flags&(1<<0) != 0 {
 		x.String(e.Hint)
@@ -7159,6 +8791,12 @@ func (e TL_account_passwordInputSettings) encode() []byte {
 	return x.buf
 }
 
+// Auth_PasswordRecovery is implemented by constructors of auth.PasswordRecovery type
+type Auth_PasswordRecovery interface {
+	TL
+	isAuth_PasswordRecovery()
+}
+
 // auth.passwordRecovery#137948a5 email_pattern:string = auth.PasswordRecovery;
 
 const crc_auth_passwordRecovery = 0x137948a5
@@ -7167,6 +8805,8 @@ type TL_auth_passwordRecovery struct {
 	Email_pattern string // email_pattern:string
 }
 
+func (e TL_auth_passwordRecovery) isAuth_PasswordRecovery() {}
+
 // Encoding TL_auth_passwordRecovery
 func (e TL_auth_passwordRecovery) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7175,6 +8815,12 @@ func (e TL_auth_passwordRecovery) encode() []byte {
 	return x.buf
 }
 
+// ReceivedNotifyMessage is implemented by constructors of ReceivedNotifyMessage type
+type ReceivedNotifyMessage interface {
+	TL
+	isReceivedNotifyMessage()
+}
+
 // receivedNotifyMessage#a384b779 Id:int flags:int = ReceivedNotifyMessage;
 
 const crc_receivedNotifyMessage = 0xa384b779
@@ -7184,6 +8830,8 @@ type TL_receivedNotifyMessage struct {
 	Flags int32 // flags:int
 }
 
+func (e TL_receivedNotifyMessage) isReceivedNotifyMessage() {}
+
 // Encoding TL_receivedNotifyMessage
 func (e TL_receivedNotifyMessage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7193,6 +8841,12 @@ func (e TL_receivedNotifyMessage) encode() []byte {
 	return x.buf
 }
 
+// ExportedChatInvite is implemented by constructors of ExportedChatInvite type
+type ExportedChatInvite interface {
+	TL
+	isExportedChatInvite()
+}
+
 // chatInviteEmpty#69df3769 = ExportedChatInvite;
 
 const crc_chatInviteEmpty = 0x69df3769
@@ -7200,6 +8854,8 @@ const crc_chatInviteEmpty = 0x69df3769
 type TL_chatInviteEmpty struct {
 }
 
+func (e TL_chatInviteEmpty) isExportedChatInvite() {}
+
 // Encoding TL_chatInviteEmpty
 func (e TL_chatInviteEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7215,6 +8871,8 @@ type TL_chatInviteExported struct {
 	Link string // link:string
 }
 
+func (e TL_chatInviteExported) isExportedChatInvite() {}
+
 // Encoding TL_chatInviteExported
 func (e TL_chatInviteExported) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7223,14 +8881,22 @@ func (e TL_chatInviteExported) encode() []byte {
 	return x.buf
 }
 
+// ChatInvite is implemented by constructors of ChatInvite type
+type ChatInvite interface {
+	TL
+	isChatInvite()
+}
+
 // chatInviteAlready#5a686d7c chat:Chat = ChatInvite;
 
 const crc_chatInviteAlready = 0x5a686d7c
 
 type TL_chatInviteAlready struct {
-	Chat TL // chat:Chat
+	Chat Chat // chat:Chat
 }
 
+func (e TL_chatInviteAlready) isChatInvite() {}
+
 // Encoding TL_chatInviteAlready
 func (e TL_chatInviteAlready) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7245,16 +8911,18 @@ const crc_chatInvite = 0xdb74f558
 
 type TL_chatInvite struct {
 	Flags              int32
-	Channel            bool   // channel:flags.0?true
-	Broadcast          bool   // broadcast:flags.1?true
-	Public             bool   // public:flags.2?true
-	Megagroup          bool   // megagroup:flags.3?true
-	Title              string // title:string
-	Photo              TL     // photo:ChatPhoto
-	Participants_count int32  // participants_count:int
-	Participants       []TL   // participants:flags.4?Vector<User>
+	Channel            bool      // channel:flags.0?true
+	Broadcast          bool      // broadcast:flags.1?true
+	Public             bool      // public:flags.2?true
+	Megagroup          bool      // megagroup:flags.3?true
+	Title              string    // title:string
+	Photo              ChatPhoto // photo:ChatPhoto
+	Participants_count int32     // participants_count:int
+	Participants       []User    // participants:flags.4?Vector<User>
 }
 
+func (e TL_chatInvite) isChatInvite() {}
+
 // Encoding TL_chatInvite
 func (e TL_chatInvite) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7280,11 +8948,21 @@ func (e TL_chatInvite) encode() []byte {
 	x.Bytes(e.Photo.encode())
 	x.Int(e.Participants_count)
 	if flags&(1<<4) != 0 {
-		x.Vector(e.Participants)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Participants)))
+		for _, v := range e.Participants {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
 
+// InputStickerSet is implemented by constructors of InputStickerSet type
+type InputStickerSet interface {
+	TL
+	isInputStickerSet()
+}
+
 // inputStickerSetEmpty#ffb62b95 = InputStickerSet;
 
 const crc_inputStickerSetEmpty = 0xffb62b95
@@ -7292,6 +8970,8 @@ const crc_inputStickerSetEmpty = 0xffb62b95
 type TL_inputStickerSetEmpty struct {
 }
 
+func (e TL_inputStickerSetEmpty) isInputStickerSet() {}
+
 // Encoding TL_inputStickerSetEmpty
 func (e TL_inputStickerSetEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7308,6 +8988,8 @@ type TL_inputStickerSetID struct {
 	Access_hash int64 // access_hash:long
 }
 
+func (e TL_inputStickerSetID) isInputStickerSet() {}
+
 // Encoding TL_inputStickerSetID
 func (e TL_inputStickerSetID) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7325,6 +9007,8 @@ type TL_inputStickerSetShortName struct {
 	Short_name string // short_name:string
 }
 
+func (e TL_inputStickerSetShortName) isInputStickerSet() {}
+
 // Encoding TL_inputStickerSetShortName
 func (e TL_inputStickerSetShortName) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7333,6 +9017,12 @@ func (e TL_inputStickerSetShortName) encode() []byte {
 	return x.buf
 }
 
+// StickerSet is implemented by constructors of StickerSet type
+type StickerSet interface {
+	TL
+	isStickerSet()
+}
+
 // stickerSet#cd303b41 flags:# installed:flags.0?true archived:flags.1?true official:flags.2?true masks:flags.3?true Id:long access_hash:long title:string short_name:string count:int Hash:int = StickerSet;
 
 const crc_stickerSet = 0xcd303b41
@@ -7351,6 +9041,8 @@ type TL_stickerSet struct {
 	Hash        int32  // Hash:int
 }
 
+func (e TL_stickerSet) isStickerSet() {}
+
 // Encoding TL_stickerSet
 func (e TL_stickerSet) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7378,26 +9070,48 @@ func (e TL_stickerSet) encode() []byte {
 	return x.buf
 }
 
+// Messages_StickerSet is implemented by constructors of messages.StickerSet type
+type Messages_StickerSet interface {
+	TL
+	isMessages_StickerSet()
+}
+
 // messages.stickerSet#b60a24a6 set:StickerSet packs:Vector<StickerPack> documents:Vector<Document> = messages.StickerSet;
 
 const crc_messages_stickerSet = 0xb60a24a6
 
 type TL_messages_stickerSet struct {
-	Set       TL   // set:StickerSet
-	Packs     []TL // packs:Vector<StickerPack>
-	Documents []TL // documents:Vector<Document>
+	Set       StickerSet    // set:StickerSet
+	Packs     []StickerPack // packs:Vector<StickerPack>
+	Documents []Document    // documents:Vector<Document>
 }
 
+func (e TL_messages_stickerSet) isMessages_StickerSet() {}
+
 // Encoding TL_messages_stickerSet
 func (e TL_messages_stickerSet) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_stickerSet)
 	x.Bytes(e.Set.encode())
-	x.Vector(e.Packs)
-	x.Vector(e.Documents)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Packs)))
+	for _, v := range e.Packs {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Documents)))
+	for _, v := range e.Documents {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// BotCommand is implemented by constructors of BotCommand type
+type BotCommand interface {
+	TL
+	isBotCommand()
+}
+
 // botCommand#c27ac8c7 command:string description:string = BotCommand;
 
 const crc_botCommand = 0xc27ac8c7
@@ -7407,6 +9121,8 @@ type TL_botCommand struct {
 	Description string // description:string
 }
 
+func (e TL_botCommand) isBotCommand() {}
+
 // Encoding TL_botCommand
 func (e TL_botCommand) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7416,26 +9132,44 @@ func (e TL_botCommand) encode() []byte {
 	return x.buf
 }
 
+// BotInfo is implemented by constructors of BotInfo type
+type BotInfo interface {
+	TL
+	isBotInfo()
+}
+
 // botInfo#98e81d3a user_id:int description:string commands:Vector<BotCommand> = BotInfo;
 
 const crc_botInfo = 0x98e81d3a
 
 type TL_botInfo struct {
-	User_id     int32  // user_id:int
-	Description string // description:string
-	Commands    []TL   // commands:Vector<BotCommand>
+	User_id     int32        // user_id:int
+	Description string       // description:string
+	Commands    []BotCommand // commands:Vector<BotCommand>
 }
 
+func (e TL_botInfo) isBotInfo() {}
+
 // Encoding TL_botInfo
 func (e TL_botInfo) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_botInfo)
 	x.Int(e.User_id)
 	x.String(e.Description)
-	x.Vector(e.Commands)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Commands)))
+	for _, v := range e.Commands {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// KeyboardButton is implemented by constructors of KeyboardButton type
+type KeyboardButton interface {
+	TL
+	isKeyboardButton()
+}
+
 // keyboardButton#a2fa4880 text:string = KeyboardButton;
 
 const crc_keyboardButton = 0xa2fa4880
@@ -7444,6 +9178,8 @@ type TL_keyboardButton struct {
 	Text string // text:string
 }
 
+func (e TL_keyboardButton) isKeyboardButton() {}
+
 // Encoding TL_keyboardButton
 func (e TL_keyboardButton) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7461,6 +9197,8 @@ type TL_keyboardButtonUrl struct {
 	Url  string // url:string
 }
 
+func (e TL_keyboardButtonUrl) isKeyboardButton() {}
+
 // Encoding TL_keyboardButtonUrl
 func (e TL_keyboardButtonUrl) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7479,12 +9217,14 @@ type TL_keyboardButtonCallback struct {
 	Data []byte // data:bytes
 }
 
+func (e TL_keyboardButtonCallback) isKeyboardButton() {}
+
 // Encoding TL_keyboardButtonCallback
 func (e TL_keyboardButtonCallback) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_keyboardButtonCallback)
 	x.String(e.Text)
-	x.Bytes(e.Data)
+	x.StringBytes(e.Data)
 	return x.buf
 }
 
@@ -7496,6 +9236,8 @@ type TL_keyboardButtonRequestPhone struct {
 	Text string // text:string
 }
 
+func (e TL_keyboardButtonRequestPhone) isKeyboardButton() {}
+
 // Encoding TL_keyboardButtonRequestPhone
 func (e TL_keyboardButtonRequestPhone) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7512,6 +9254,8 @@ type TL_keyboardButtonRequestGeoLocation struct {
 	Text string // text:string
 }
 
+func (e TL_keyboardButtonRequestGeoLocation) isKeyboardButton() {}
+
 // Encoding TL_keyboardButtonRequestGeoLocation
 func (e TL_keyboardButtonRequestGeoLocation) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7531,6 +9275,8 @@ type TL_keyboardButtonSwitchInline struct {
 	Query     string // query:string
 }
 
+func (e TL_keyboardButtonSwitchInline) isKeyboardButton() {}
+
 // Encoding TL_keyboardButtonSwitchInline
 func (e TL_keyboardButtonSwitchInline) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7553,6 +9299,8 @@ type TL_keyboardButtonGame struct {
 	Text string // text:string
 }
 
+func (e TL_keyboardButtonGame) isKeyboardButton() {}
+
 // Encoding TL_keyboardButtonGame
 func (e TL_keyboardButtonGame) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7569,6 +9317,8 @@ type TL_keyboardButtonBuy struct {
 	Text string // text:string
 }
 
+func (e TL_keyboardButtonBuy) isKeyboardButton() {}
+
 // Encoding TL_keyboardButtonBuy
 func (e TL_keyboardButtonBuy) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7577,22 +9327,40 @@ func (e TL_keyboardButtonBuy) encode() []byte {
 	return x.buf
 }
 
+// KeyboardButtonRow is implemented by constructors of KeyboardButtonRow type
+type KeyboardButtonRow interface {
+	TL
+	isKeyboardButtonRow()
+}
+
 // keyboardButtonRow#77608b83 buttons:Vector<KeyboardButton> = KeyboardButtonRow;
 
 const crc_keyboardButtonRow = 0x77608b83
 
 type TL_keyboardButtonRow struct {
-	Buttons []TL // buttons:Vector<KeyboardButton>
+	Buttons []KeyboardButton // buttons:Vector<KeyboardButton>
 }
 
+func (e TL_keyboardButtonRow) isKeyboardButtonRow() {}
+
 // Encoding TL_keyboardButtonRow
 func (e TL_keyboardButtonRow) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_keyboardButtonRow)
-	x.Vector(e.Buttons)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Buttons)))
+	for _, v := range e.Buttons {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// ReplyMarkup is implemented by constructors of ReplyMarkup type
+type ReplyMarkup interface {
+	TL
+	isReplyMarkup()
+}
+
 // replyKeyboardHide#a03e5b85 flags:# selective:flags.2?true = ReplyMarkup;
 
 const crc_replyKeyboardHide = 0xa03e5b85
@@ -7602,6 +9370,8 @@ type TL_replyKeyboardHide struct {
 	Selective bool // selective:flags.2?true
 }
 
+func (e TL_replyKeyboardHide) isReplyMarkup() {}
+
 // Encoding TL_replyKeyboardHide
 func (e TL_replyKeyboardHide) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7624,6 +9394,8 @@ type TL_replyKeyboardForceReply struct {
 	Selective  bool // selective:flags.2?true
 }
 
+func (e TL_replyKeyboardForceReply) isReplyMarkup() {}
+
 // Encoding TL_replyKeyboardForceReply
 func (e TL_replyKeyboardForceReply) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7645,12 +9417,14 @@ const crc_replyKeyboardMarkup = 0x3502758c
 
 type TL_replyKeyboardMarkup struct {
 	Flags      int32
-	Resize     bool // resize:flags.0?true
-	Single_use bool // single_use:flags.1?true
-	Selective  bool // selective:flags.2?true
-	Rows       []TL // rows:Vector<KeyboardButtonRow>
+	Resize     bool                // resize:flags.0?true
+	Single_use bool                // single_use:flags.1?true
+	Selective  bool                // selective:flags.2?true
+	Rows       []KeyboardButtonRow // rows:Vector<KeyboardButtonRow>
 }
 
+func (e TL_replyKeyboardMarkup) isReplyMarkup() {}
+
 // Encoding TL_replyKeyboardMarkup
 func (e TL_replyKeyboardMarkup) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7666,7 +9440,11 @@ func (e TL_replyKeyboardMarkup) encode() []byte {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
-	x.Vector(e.Rows)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Rows)))
+	for _, v := range e.Rows {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -7675,17 +9453,29 @@ func (e TL_replyKeyboardMarkup) encode() []byte {
 const crc_replyInlineMarkup = 0x48a30254
 
 type TL_replyInlineMarkup struct {
-	Rows []TL // rows:Vector<KeyboardButtonRow>
+	Rows []KeyboardButtonRow // rows:Vector<KeyboardButtonRow>
 }
 
+func (e TL_replyInlineMarkup) isReplyMarkup() {}
+
 // Encoding TL_replyInlineMarkup
 func (e TL_replyInlineMarkup) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_replyInlineMarkup)
-	x.Vector(e.Rows)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Rows)))
+	for _, v := range e.Rows {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// MessageEntity is implemented by constructors of MessageEntity type
+type MessageEntity interface {
+	TL
+	isMessageEntity()
+}
+
 // messageEntityUnknown#bb92ba95 offset:int length:int = MessageEntity;
 
 const crc_messageEntityUnknown = 0xbb92ba95
@@ -7695,6 +9485,8 @@ type TL_messageEntityUnknown struct {
 	Length int32 // length:int
 }
 
+func (e TL_messageEntityUnknown) isMessageEntity() {}
+
 // Encoding TL_messageEntityUnknown
 func (e TL_messageEntityUnknown) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7713,6 +9505,8 @@ type TL_messageEntityMention struct {
 	Length int32 // length:int
 }
 
+func (e TL_messageEntityMention) isMessageEntity() {}
+
 // Encoding TL_messageEntityMention
 func (e TL_messageEntityMention) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7731,6 +9525,8 @@ type TL_messageEntityHashtag struct {
 	Length int32 // length:int
 }
 
+func (e TL_messageEntityHashtag) isMessageEntity() {}
+
 // Encoding TL_messageEntityHashtag
 func (e TL_messageEntityHashtag) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7749,6 +9545,8 @@ type TL_messageEntityBotCommand struct {
 	Length int32 // length:int
 }
 
+func (e TL_messageEntityBotCommand) isMessageEntity() {}
+
 // Encoding TL_messageEntityBotCommand
 func (e TL_messageEntityBotCommand) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7767,6 +9565,8 @@ type TL_messageEntityUrl struct {
 	Length int32 // length:int
 }
 
+func (e TL_messageEntityUrl) isMessageEntity() {}
+
 // Encoding TL_messageEntityUrl
 func (e TL_messageEntityUrl) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7785,6 +9585,8 @@ type TL_messageEntityEmail struct {
 	Length int32 // length:int
 }
 
+func (e TL_messageEntityEmail) isMessageEntity() {}
+
 // Encoding TL_messageEntityEmail
 func (e TL_messageEntityEmail) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7803,6 +9605,8 @@ type TL_messageEntityBold struct {
 	Length int32 // length:int
 }
 
+func (e TL_messageEntityBold) isMessageEntity() {}
+
 // Encoding TL_messageEntityBold
 func (e TL_messageEntityBold) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7821,6 +9625,8 @@ type TL_messageEntityItalic struct {
 	Length int32 // length:int
 }
 
+func (e TL_messageEntityItalic) isMessageEntity() {}
+
 // Encoding TL_messageEntityItalic
 func (e TL_messageEntityItalic) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7839,6 +9645,8 @@ type TL_messageEntityCode struct {
 	Length int32 // length:int
 }
 
+func (e TL_messageEntityCode) isMessageEntity() {}
+
 // Encoding TL_messageEntityCode
 func (e TL_messageEntityCode) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7858,6 +9666,8 @@ type TL_messageEntityPre struct {
 	Language string // Language:string
 }
 
+func (e TL_messageEntityPre) isMessageEntity() {}
+
 // Encoding TL_messageEntityPre
 func (e TL_messageEntityPre) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7878,6 +9688,8 @@ type TL_messageEntityTextUrl struct {
 	Url    string // url:string
 }
 
+func (e TL_messageEntityTextUrl) isMessageEntity() {}
+
 // Encoding TL_messageEntityTextUrl
 func (e TL_messageEntityTextUrl) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7898,6 +9710,8 @@ type TL_messageEntityMentionName struct {
 	User_id int32 // user_id:int
 }
 
+func (e TL_messageEntityMentionName) isMessageEntity() {}
+
 // Encoding TL_messageEntityMentionName
 func (e TL_messageEntityMentionName) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7913,11 +9727,13 @@ func (e TL_messageEntityMentionName) encode() []byte {
 const crc_inputMessageEntityMentionName = 0x208e68c9
 
 type TL_inputMessageEntityMentionName struct {
-	Offset  int32 // offset:int
-	Length  int32 // length:int
-	User_id TL    // user_id:InputUser
+	Offset  int32     // offset:int
+	Length  int32     // length:int
+	User_id InputUser // user_id:InputUser
 }
 
+func (e TL_inputMessageEntityMentionName) isMessageEntity() {}
+
 // Encoding TL_inputMessageEntityMentionName
 func (e TL_inputMessageEntityMentionName) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7928,6 +9744,12 @@ func (e TL_inputMessageEntityMentionName) encode() []byte {
 	return x.buf
 }
 
+// InputChannel is implemented by constructors of InputChannel type
+type InputChannel interface {
+	TL
+	isInputChannel()
+}
+
 // inputChannelEmpty#ee8c1e86 = InputChannel;
 
 const crc_inputChannelEmpty = 0xee8c1e86
@@ -7935,6 +9757,8 @@ const crc_inputChannelEmpty = 0xee8c1e86
 type TL_inputChannelEmpty struct {
 }
 
+func (e TL_inputChannelEmpty) isInputChannel() {}
+
 // Encoding TL_inputChannelEmpty
 func (e TL_inputChannelEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7951,6 +9775,8 @@ type TL_inputChannel struct {
 	Access_hash int64 // access_hash:long
 }
 
+func (e TL_inputChannel) isInputChannel() {}
+
 // Encoding TL_inputChannel
 func (e TL_inputChannel) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7960,26 +9786,48 @@ func (e TL_inputChannel) encode() []byte {
 	return x.buf
 }
 
+// Contacts_ResolvedPeer is implemented by constructors of contacts.ResolvedPeer type
+type Contacts_ResolvedPeer interface {
+	TL
+	isContacts_ResolvedPeer()
+}
+
 // contacts.resolvedPeer#7f077ad9 peer:Peer chats:Vector<Chat> users:Vector<User> = contacts.ResolvedPeer;
 
 const crc_contacts_resolvedPeer = 0x7f077ad9
 
 type TL_contacts_resolvedPeer struct {
-	Peer  TL   // peer:Peer
-	Chats []TL // chats:Vector<Chat>
-	Users []TL // users:Vector<User>
+	Peer  Peer   // peer:Peer
+	Chats []Chat // chats:Vector<Chat>
+	Users []User // users:Vector<User>
 }
 
+func (e TL_contacts_resolvedPeer) isContacts_ResolvedPeer() {}
+
 // Encoding TL_contacts_resolvedPeer
 func (e TL_contacts_resolvedPeer) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_contacts_resolvedPeer)
 	x.Bytes(e.Peer.encode())
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// MessageRange is implemented by constructors of MessageRange type
+type MessageRange interface {
+	TL
+	isMessageRange()
+}
+
 // messageRange#ae30253 min_id:int max_id:int = MessageRange;
 
 const crc_messageRange = 0xae30253
@@ -7989,6 +9837,8 @@ type TL_messageRange struct {
 	Max_id int32 // max_id:int
 }
 
+func (e TL_messageRange) isMessageRange() {}
+
 // Encoding TL_messageRange
 func (e TL_messageRange) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -7998,6 +9848,12 @@ func (e TL_messageRange) encode() []byte {
 	return x.buf
 }
 
+// Updates_ChannelDifference is implemented by constructors of updates.ChannelDifference type
+type Updates_ChannelDifference interface {
+	TL
+	isUpdates_ChannelDifference()
+}
+
 // updates.channelDifferenceEmpty#3e11affb flags:# final:flags.0?true pts:int timeout:flags.1?int = updates.ChannelDifference;
 
 const crc_updates_channelDifferenceEmpty = 0x3e11affb
@@ -8009,6 +9865,8 @@ type TL_updates_channelDifferenceEmpty struct {
 	Timeout int32 // timeout:flags.1?int
 }
 
+func (e TL_updates_channelDifferenceEmpty) isUpdates_ChannelDifference() {}
+
 // Encoding TL_updates_channelDifferenceEmpty
 func (e TL_updates_channelDifferenceEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8034,18 +9892,20 @@ const crc_updates_channelDifferenceTooLong = 0x410dee07
 
 type TL_updates_channelDifferenceTooLong struct {
 	Flags              int32
-	Final              bool  // final:flags.0?true
-	Pts                int32 // pts:int
-	Timeout            int32 // timeout:flags.1?int
-	Top_message        int32 // top_message:int
-	Read_inbox_max_id  int32 // read_inbox_max_id:int
-	Read_outbox_max_id int32 // read_outbox_max_id:int
-	Unread_count       int32 // unread_count:int
-	Messages           []TL  // messages:Vector<Message>
-	Chats              []TL  // chats:Vector<Chat>
-	Users              []TL  // users:Vector<User>
+	Final              bool      // final:flags.0?true
+	Pts                int32     // pts:int
+	Timeout            int32     // timeout:flags.1?int
+	Top_message        int32     // top_message:int
+	Read_inbox_max_id  int32     // read_inbox_max_id:int
+	Read_outbox_max_id int32     // read_outbox_max_id:int
+	Unread_count       int32     // unread_count:int
+	Messages           []Message // messages:Vector<Message>
+	Chats              []Chat    // chats:Vector<Chat>
+	Users              []User    // users:Vector<User>
 }
 
+func (e TL_updates_channelDifferenceTooLong) isUpdates_ChannelDifference() {}
+
 // Encoding TL_updates_channelDifferenceTooLong
 func (e TL_updates_channelDifferenceTooLong) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8066,9 +9926,21 @@ func (e TL_updates_channelDifferenceTooLong) encode() []byte {
 	x.Int(e.Read_inbox_max_id)
 	x.Int(e.Read_outbox_max_id)
 	x.Int(e.Unread_count)
-	x.Vector(e.Messages)
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Messages)))
+	for _, v := range e.Messages {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -8078,15 +9950,17 @@ const crc_updates_channelDifference = 0x2064674e
 
 type TL_updates_channelDifference struct {
 	Flags         int32
-	Final         bool  // final:flags.0?true
-	Pts           int32 // pts:int
-	Timeout       int32 // timeout:flags.1?int
-	New_messages  []TL  // new_messages:Vector<Message>
-	Other_updates []TL  // other_updates:Vector<Update>
-	Chats         []TL  // chats:Vector<Chat>
-	Users         []TL  // users:Vector<User>
+	Final         bool      // final:flags.0?true
+	Pts           int32     // pts:int
+	Timeout       int32     // timeout:flags.1?int
+	New_messages  []Message // new_messages:Vector<Message>
+	Other_updates []Update  // other_updates:Vector<Update>
+	Chats         []Chat    // chats:Vector<Chat>
+	Users         []User    // users:Vector<User>
 }
 
+func (e TL_updates_channelDifference) isUpdates_ChannelDifference() {}
+
 // Encoding TL_updates_channelDifference
 func (e TL_updates_channelDifference) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8103,13 +9977,35 @@ func (e TL_updates_channelDifference) encode() []byte {
 	if flags&(1<<1) != 0 {
 		x.Int(e.Timeout)
 	}
-	x.Vector(e.New_messages)
-	x.Vector(e.Other_updates)
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.New_messages)))
+	for _, v := range e.New_messages {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Other_updates)))
+	for _, v := range e.Other_updates {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// ChannelMessagesFilter is implemented by constructors of ChannelMessagesFilter type
+type ChannelMessagesFilter interface {
+	TL
+	isChannelMessagesFilter()
+}
+
 // channelMessagesFilterEmpty#94d42ee7 = ChannelMessagesFilter;
 
 const crc_channelMessagesFilterEmpty = 0x94d42ee7
@@ -8117,6 +10013,8 @@ const crc_channelMessagesFilterEmpty = 0x94d42ee7
 type TL_channelMessagesFilterEmpty struct {
 }
 
+func (e TL_channelMessagesFilterEmpty) isChannelMessagesFilter() {}
+
 // Encoding TL_channelMessagesFilterEmpty
 func (e TL_channelMessagesFilterEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8130,10 +10028,12 @@ const crc_channelMessagesFilter = 0xcd77d957
 
 type TL_channelMessagesFilter struct {
 	Flags                int32
-	Exclude_new_messages bool // exclude_new_messages:flags.1?true
-	Ranges               []TL // ranges:Vector<MessageRange>
+	Exclude_new_messages bool           // exclude_new_messages:flags.1?true
+	Ranges               []MessageRange // ranges:Vector<MessageRange>
 }
 
+func (e TL_channelMessagesFilter) isChannelMessagesFilter() {}
+
 // Encoding TL_channelMessagesFilter
 func (e TL_channelMessagesFilter) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8143,10 +10043,20 @@ func (e TL_channelMessagesFilter) encode() []byte {
 		flags |= (1 << 1)
 	}
 	x.Int(flags)
-	x.Vector(e.Ranges)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Ranges)))
+	for _, v := range e.Ranges {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// ChannelParticipant is implemented by constructors of ChannelParticipant type
+type ChannelParticipant interface {
+	TL
+	isChannelParticipant()
+}
+
 // channelParticipant#15ebac1d user_id:int date:int = ChannelParticipant;
 
 const crc_channelParticipant = 0x15ebac1d
@@ -8156,6 +10066,8 @@ type TL_channelParticipant struct {
 	Date    int32 // date:int
 }
 
+func (e TL_channelParticipant) isChannelParticipant() {}
+
 // Encoding TL_channelParticipant
 func (e TL_channelParticipant) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8175,6 +10087,8 @@ type TL_channelParticipantSelf struct {
 	Date       int32 // date:int
 }
 
+func (e TL_channelParticipantSelf) isChannelParticipant() {}
+
 // Encoding TL_channelParticipantSelf
 func (e TL_channelParticipantSelf) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8195,6 +10109,8 @@ type TL_channelParticipantModerator struct {
 	Date       int32 // date:int
 }
 
+func (e TL_channelParticipantModerator) isChannelParticipant() {}
+
 // Encoding TL_channelParticipantModerator
 func (e TL_channelParticipantModerator) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8215,6 +10131,8 @@ type TL_channelParticipantEditor struct {
 	Date       int32 // date:int
 }
 
+func (e TL_channelParticipantEditor) isChannelParticipant() {}
+
 // Encoding TL_channelParticipantEditor
 func (e TL_channelParticipantEditor) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8235,6 +10153,8 @@ type TL_channelParticipantKicked struct {
 	Date      int32 // date:int
 }
 
+func (e TL_channelParticipantKicked) isChannelParticipant() {}
+
 // Encoding TL_channelParticipantKicked
 func (e TL_channelParticipantKicked) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8253,6 +10173,8 @@ type TL_channelParticipantCreator struct {
 	User_id int32 // user_id:int
 }
 
+func (e TL_channelParticipantCreator) isChannelParticipant() {}
+
 // Encoding TL_channelParticipantCreator
 func (e TL_channelParticipantCreator) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8261,6 +10183,12 @@ func (e TL_channelParticipantCreator) encode() []byte {
 	return x.buf
 }
 
+// ChannelParticipantsFilter is implemented by constructors of ChannelParticipantsFilter type
+type ChannelParticipantsFilter interface {
+	TL
+	isChannelParticipantsFilter()
+}
+
 // channelParticipantsRecent#de3f3c79 = ChannelParticipantsFilter;
 
 const crc_channelParticipantsRecent = 0xde3f3c79
@@ -8268,6 +10196,8 @@ const crc_channelParticipantsRecent = 0xde3f3c79
 type TL_channelParticipantsRecent struct {
 }
 
+func (e TL_channelParticipantsRecent) isChannelParticipantsFilter() {}
+
 // Encoding TL_channelParticipantsRecent
 func (e TL_channelParticipantsRecent) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8282,6 +10212,8 @@ const crc_channelParticipantsAdmins = 0xb4608969
 type TL_channelParticipantsAdmins struct {
 }
 
+func (e TL_channelParticipantsAdmins) isChannelParticipantsFilter() {}
+
 // Encoding TL_channelParticipantsAdmins
 func (e TL_channelParticipantsAdmins) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8296,6 +10228,8 @@ const crc_channelParticipantsKicked = 0x3c37bb7a
 type TL_channelParticipantsKicked struct {
 }
 
+func (e TL_channelParticipantsKicked) isChannelParticipantsFilter() {}
+
 // Encoding TL_channelParticipantsKicked
 func (e TL_channelParticipantsKicked) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8310,6 +10244,8 @@ const crc_channelParticipantsBots = 0xb0d1865b
 type TL_channelParticipantsBots struct {
 }
 
+func (e TL_channelParticipantsBots) isChannelParticipantsFilter() {}
+
 // Encoding TL_channelParticipantsBots
 func (e TL_channelParticipantsBots) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8317,6 +10253,12 @@ func (e TL_channelParticipantsBots) encode() []byte {
 	return x.buf
 }
 
+// ChannelParticipantRole is implemented by constructors of ChannelParticipantRole type
+type ChannelParticipantRole interface {
+	TL
+	isChannelParticipantRole()
+}
+
 // channelRoleEmpty#b285a0c6 = ChannelParticipantRole;
 
 const crc_channelRoleEmpty = 0xb285a0c6
@@ -8324,6 +10266,8 @@ const crc_channelRoleEmpty = 0xb285a0c6
 type TL_channelRoleEmpty struct {
 }
 
+func (e TL_channelRoleEmpty) isChannelParticipantRole() {}
+
 // Encoding TL_channelRoleEmpty
 func (e TL_channelRoleEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8338,6 +10282,8 @@ const crc_channelRoleModerator = 0x9618d975
 type TL_channelRoleModerator struct {
 }
 
+func (e TL_channelRoleModerator) isChannelParticipantRole() {}
+
 // Encoding TL_channelRoleModerator
 func (e TL_channelRoleModerator) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8352,6 +10298,8 @@ const crc_channelRoleEditor = 0x820bfe8c
 type TL_channelRoleEditor struct {
 }
 
+func (e TL_channelRoleEditor) isChannelParticipantRole() {}
+
 // Encoding TL_channelRoleEditor
 func (e TL_channelRoleEditor) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8359,44 +10307,78 @@ func (e TL_channelRoleEditor) encode() []byte {
 	return x.buf
 }
 
+// Channels_ChannelParticipants is implemented by constructors of channels.ChannelParticipants type
+type Channels_ChannelParticipants interface {
+	TL
+	isChannels_ChannelParticipants()
+}
+
 // channels.channelParticipants#f56ee2a8 count:int participants:Vector<ChannelParticipant> users:Vector<User> = channels.ChannelParticipants;
 
 const crc_channels_channelParticipants = 0xf56ee2a8
 
 type TL_channels_channelParticipants struct {
-	Count        int32 // count:int
-	Participants []TL  // participants:Vector<ChannelParticipant>
-	Users        []TL  // users:Vector<User>
+	Count        int32                // count:int
+	Participants []ChannelParticipant // participants:Vector<ChannelParticipant>
+	Users        []User               // users:Vector<User>
 }
 
+func (e TL_channels_channelParticipants) isChannels_ChannelParticipants() {}
+
 // Encoding TL_channels_channelParticipants
 func (e TL_channels_channelParticipants) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_channels_channelParticipants)
 	x.Int(e.Count)
-	x.Vector(e.Participants)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Participants)))
+	for _, v := range e.Participants {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Channels_ChannelParticipant is implemented by constructors of channels.ChannelParticipant type
+type Channels_ChannelParticipant interface {
+	TL
+	isChannels_ChannelParticipant()
+}
+
 // channels.channelParticipant#d0d9b163 participant:ChannelParticipant users:Vector<User> = channels.ChannelParticipant;
 
 const crc_channels_channelParticipant = 0xd0d9b163
 
 type TL_channels_channelParticipant struct {
-	Participant TL   // participant:ChannelParticipant
-	Users       []TL // users:Vector<User>
+	Participant ChannelParticipant // participant:ChannelParticipant
+	Users       []User             // users:Vector<User>
 }
 
+func (e TL_channels_channelParticipant) isChannels_ChannelParticipant() {}
+
 // Encoding TL_channels_channelParticipant
 func (e TL_channels_channelParticipant) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_channels_channelParticipant)
 	x.Bytes(e.Participant.encode())
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Help_TermsOfService is implemented by constructors of help.TermsOfService type
+type Help_TermsOfService interface {
+	TL
+	isHelp_TermsOfService()
+}
+
 // help.termsOfService#f1ee3e90 text:string = help.TermsOfService;
 
 const crc_help_termsOfService = 0xf1ee3e90
@@ -8405,6 +10387,8 @@ type TL_help_termsOfService struct {
 	Text string // text:string
 }
 
+func (e TL_help_termsOfService) isHelp_TermsOfService() {}
+
 // Encoding TL_help_termsOfService
 func (e TL_help_termsOfService) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8413,6 +10397,12 @@ func (e TL_help_termsOfService) encode() []byte {
 	return x.buf
 }
 
+// FoundGif is implemented by constructors of FoundGif type
+type FoundGif interface {
+	TL
+	isFoundGif()
+}
+
 // foundGif#162ecc1f url:string thumb_url:string content_url:string content_type:string w:int h:int = FoundGif;
 
 const crc_foundGif = 0x162ecc1f
@@ -8426,6 +10416,8 @@ type TL_foundGif struct {
 	H            int32  // h:int
 }
 
+func (e TL_foundGif) isFoundGif() {}
+
 // Encoding TL_foundGif
 func (e TL_foundGif) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8444,11 +10436,13 @@ func (e TL_foundGif) encode() []byte {
 const crc_foundGifCached = 0x9c750409
 
 type TL_foundGifCached struct {
-	Url      string // url:string
-	Photo    TL     // photo:Photo
-	Document TL     // document:Document
+	Url      string   // url:string
+	Photo    Photo    // photo:Photo
+	Document Document // document:Document
 }
 
+func (e TL_foundGifCached) isFoundGif() {}
+
 // Encoding TL_foundGifCached
 func (e TL_foundGifCached) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8459,24 +10453,42 @@ func (e TL_foundGifCached) encode() []byte {
 	return x.buf
 }
 
+// Messages_FoundGifs is implemented by constructors of messages.FoundGifs type
+type Messages_FoundGifs interface {
+	TL
+	isMessages_FoundGifs()
+}
+
 // messages.foundGifs#450a1c0a next_offset:int results:Vector<FoundGif> = messages.FoundGifs;
 
 const crc_messages_foundGifs = 0x450a1c0a
 
 type TL_messages_foundGifs struct {
-	Next_offset int32 // next_offset:int
-	Results     []TL  // results:Vector<FoundGif>
+	Next_offset int32      // next_offset:int
+	Results     []FoundGif // results:Vector<FoundGif>
 }
 
+func (e TL_messages_foundGifs) isMessages_FoundGifs() {}
+
 // Encoding TL_messages_foundGifs
 func (e TL_messages_foundGifs) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_foundGifs)
 	x.Int(e.Next_offset)
-	x.Vector(e.Results)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Results)))
+	for _, v := range e.Results {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Messages_SavedGifs is implemented by constructors of messages.SavedGifs type
+type Messages_SavedGifs interface {
+	TL
+	isMessages_SavedGifs()
+}
+
 // messages.savedGifsNotModified#e8025ca2 = messages.SavedGifs;
 
 const crc_messages_savedGifsNotModified = 0xe8025ca2
@@ -8484,6 +10496,8 @@ const crc_messages_savedGifsNotModified = 0xe8025ca2
 type TL_messages_savedGifsNotModified struct {
 }
 
+func (e TL_messages_savedGifsNotModified) isMessages_SavedGifs() {}
+
 // Encoding TL_messages_savedGifsNotModified
 func (e TL_messages_savedGifsNotModified) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8496,35 +10510,49 @@ func (e TL_messages_savedGifsNotModified) encode() []byte {
 const crc_messages_savedGifs = 0x2e0709a5
 
 type TL_messages_savedGifs struct {
-	Hash int32 // Hash:int
-	Gifs []TL  // gifs:Vector<Document>
+	Hash int32      // Hash:int
+	Gifs []Document // gifs:Vector<Document>
 }
 
+func (e TL_messages_savedGifs) isMessages_SavedGifs() {}
+
 // Encoding TL_messages_savedGifs
 func (e TL_messages_savedGifs) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_savedGifs)
 	x.Int(e.Hash)
-	x.Vector(e.Gifs)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Gifs)))
+	for _, v := range e.Gifs {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// InputBotInlineMessage is implemented by constructors of InputBotInlineMessage type
+type InputBotInlineMessage interface {
+	TL
+	isInputBotInlineMessage()
+}
+
 // inputBotInlineMessageMediaAuto#292fed13 flags:# caption:string reply_markup:flags.2?ReplyMarkup = InputBotInlineMessage;
 
 const crc_inputBotInlineMessageMediaAuto = 0x292fed13
 
 type TL_inputBotInlineMessageMediaAuto struct {
 	Flags        int32
-	Caption      string // caption:string
-	Reply_markup TL     // reply_markup:flags.2?ReplyMarkup
+	Caption      string      // caption:string
+	Reply_markup ReplyMarkup // reply_markup:flags.2?ReplyMarkup
 }
 
+func (e TL_inputBotInlineMessageMediaAuto) isInputBotInlineMessage() {}
+
 // Encoding TL_inputBotInlineMessageMediaAuto
 func (e TL_inputBotInlineMessageMediaAuto) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_inputBotInlineMessageMediaAuto)
 	var flags int32
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
@@ -8541,12 +10569,14 @@ const crc_inputBotInlineMessageText = 0x3dcd7a87
 
 type TL_inputBotInlineMessageText struct {
 	Flags        int32
-	No_webpage   bool   // no_webpage:flags.0?true
-	Message      string // message:string
-	Entities     []TL   // entities:flags.1?Vector<MessageEntity>
-	Reply_markup TL     // reply_markup:flags.2?ReplyMarkup
+	No_webpage   bool            // no_webpage:flags.0?true
+	Message      string          // message:string
+	Entities     []MessageEntity // entities:flags.1?Vector<MessageEntity>
+	Reply_markup ReplyMarkup     // reply_markup:flags.2?ReplyMarkup
 }
 
+func (e TL_inputBotInlineMessageText) isInputBotInlineMessage() {}
+
 // Encoding TL_inputBotInlineMessageText
 func (e TL_inputBotInlineMessageText) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8558,13 +10588,17 @@ func (e TL_inputBotInlineMessageText) encode() []byte {
 	if len(e.Entities) != 0 {
 		flags |= (1 << 1)
 	}
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
 	x.String(e.Message)
 	if flags&(1<<1) != 0 {
-		x.Vector(e.Entities)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Entities)))
+		for _, v := range e.Entities {
+			x.Bytes(v.encode())
+		}
 	}
 	if flags&(1<<2) != 0 {
 		x.Bytes(e.Reply_markup.encode())
@@ -8578,16 +10612,18 @@ const crc_inputBotInlineMessageMediaGeo = 0xf4a59de1
 
 type TL_inputBotInlineMessageMediaGeo struct {
 	Flags        int32
-	Geo_point    TL // geo_point:InputGeoPoint
-	Reply_markup TL // reply_markup:flags.2?ReplyMarkup
+	Geo_point    InputGeoPoint // geo_point:InputGeoPoint
+	Reply_markup ReplyMarkup   // reply_markup:flags.2?ReplyMarkup
 }
 
+func (e TL_inputBotInlineMessageMediaGeo) isInputBotInlineMessage() {}
+
 // Encoding TL_inputBotInlineMessageMediaGeo
 func (e TL_inputBotInlineMessageMediaGeo) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_inputBotInlineMessageMediaGeo)
 	var flags int32
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
@@ -8604,20 +10640,22 @@ const crc_inputBotInlineMessageMediaVenue = 0xaaafadc8
 
 type TL_inputBotInlineMessageMediaVenue struct {
 	Flags        int32
-	Geo_point    TL     // geo_point:InputGeoPoint
-	Title        string // title:string
-	Address      string // address:string
-	Provider     string // provider:string
-	Venue_id     string // venue_id:string
-	Reply_markup TL     // reply_markup:flags.2?ReplyMarkup
+	Geo_point    InputGeoPoint // geo_point:InputGeoPoint
+	Title        string        // title:string
+	Address      string        // address:string
+	Provider     string        // provider:string
+	Venue_id     string        // venue_id:string
+	Reply_markup ReplyMarkup   // reply_markup:flags.2?ReplyMarkup
 }
 
+func (e TL_inputBotInlineMessageMediaVenue) isInputBotInlineMessage() {}
+
 // Encoding TL_inputBotInlineMessageMediaVenue
 func (e TL_inputBotInlineMessageMediaVenue) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_inputBotInlineMessageMediaVenue)
 	var flags int32
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
@@ -8638,18 +10676,20 @@ const crc_inputBotInlineMessageMediaContact = 0x2daf01a7
 
 type TL_inputBotInlineMessageMediaContact struct {
 	Flags        int32
-	Phone_number string // phone_number:string
-	First_name   string // first_name:string
-	Last_name    string // last_name:string
-	Reply_markup TL     // reply_markup:flags.2?ReplyMarkup
+	Phone_number string      // phone_number:string
+	First_name   string      // first_name:string
+	Last_name    string      // last_name:string
+	Reply_markup ReplyMarkup // reply_markup:flags.2?ReplyMarkup
 }
 
+func (e TL_inputBotInlineMessageMediaContact) isInputBotInlineMessage() {}
+
 // Encoding TL_inputBotInlineMessageMediaContact
 func (e TL_inputBotInlineMessageMediaContact) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_inputBotInlineMessageMediaContact)
 	var flags int32
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
@@ -8668,15 +10708,17 @@ const crc_inputBotInlineMessageGame = 0x4b425864
 
 type TL_inputBotInlineMessageGame struct {
 	Flags        int32
-	Reply_markup TL // reply_markup:flags.2?ReplyMarkup
+	Reply_markup ReplyMarkup // reply_markup:flags.2?ReplyMarkup
 }
 
+func (e TL_inputBotInlineMessageGame) isInputBotInlineMessage() {}
+
 // Encoding TL_inputBotInlineMessageGame
 func (e TL_inputBotInlineMessageGame) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_inputBotInlineMessageGame)
 	var flags int32
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
@@ -8686,25 +10728,33 @@ func (e TL_inputBotInlineMessageGame) encode() []byte {
 	return x.buf
 }
 
+// InputBotInlineResult is implemented by constructors of InputBotInlineResult type
+type InputBotInlineResult interface {
+	TL
+	isInputBotInlineResult()
+}
+
 // inputBotInlineResult#2cbbe15a flags:# Id:string type:string title:flags.1?string description:flags.2?string url:flags.3?string thumb_url:flags.4?string content_url:flags.5?string content_type:flags.5?string w:flags.6?int h:flags.6?int duration:flags.7?int send_message:InputBotInlineMessage = InputBotInlineResult;
 
 const crc_inputBotInlineResult = 0x2cbbe15a
 
 type TL_inputBotInlineResult struct {
 	Flags        int32
-	Id           string // Id:string
-	Code_type    string // type:string
-	Title        string // title:flags.1?string
-	Description  string // description:flags.2?string
-	Url          string // url:flags.3?string
-	Thumb_url    string // thumb_url:flags.4?string
-	Content_url  string // content_url:flags.5?string
-	Content_type string // content_type:flags.5?string
-	W            int32  // w:flags.6?int
-	H            int32  // h:flags.6?int
-	Duration     int32  // duration:flags.7?int
-	Send_message TL     // send_message:InputBotInlineMessage
-}
+	Id           string                // Id:string
+	Code_type    string                // type:string
+	Title        string                // title:flags.1?string
+	Description  string                // description:flags.2?string
+	Url          string                // url:flags.3?string
+	Thumb_url    string                // thumb_url:flags.4?string
+	Content_url  string                // content_url:flags.5?string
+	Content_type string                // content_type:flags.5?string
+	W            int32                 // w:flags.6?int
+	H            int32                 // h:flags.6?int
+	Duration     int32                 // duration:flags.7?int
+	Send_message InputBotInlineMessage // send_message:InputBotInlineMessage
+}
+
+func (e TL_inputBotInlineResult) isInputBotInlineResult() {}
 
 // Encoding TL_inputBotInlineResult
 func (e TL_inputBotInlineResult) encode() []byte {
@@ -8777,12 +10827,14 @@ func (e TL_inputBotInlineResult) encode() []byte {
 const crc_inputBotInlineResultPhoto = 0xa8d864a7
 
 type TL_inputBotInlineResultPhoto struct {
-	Id           string // Id:string
-	Code_type    string // type:string
-	Photo        TL     // photo:InputPhoto
-	Send_message TL     // send_message:InputBotInlineMessage
+	Id           string                // Id:string
+	Code_type    string                // type:string
+	Photo        InputPhoto            // photo:InputPhoto
+	Send_message InputBotInlineMessage // send_message:InputBotInlineMessage
 }
 
+func (e TL_inputBotInlineResultPhoto) isInputBotInlineResult() {}
+
 // Encoding TL_inputBotInlineResultPhoto
 func (e TL_inputBotInlineResultPhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8800,14 +10852,16 @@ const crc_inputBotInlineResultDocument = 0xfff8fdc4
 
 type TL_inputBotInlineResultDocument struct {
 	Flags        int32
-	Id           string // Id:string
-	Code_type    string // type:string
-	Title        string // title:flags.1?string
-	Description  string // description:flags.2?string
-	Document     TL     // document:InputDocument
-	Send_message TL     // send_message:InputBotInlineMessage
+	Id           string                // Id:string
+	Code_type    string                // type:string
+	Title        string                // title:flags.1?string
+	Description  string                // description:flags.2?string
+	Document     InputDocument         // document:InputDocument
+	Send_message InputBotInlineMessage // send_message:InputBotInlineMessage
 }
 
+func (e TL_inputBotInlineResultDocument) isInputBotInlineResult() {}
+
 // Encoding TL_inputBotInlineResultDocument
 func (e TL_inputBotInlineResultDocument) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8838,11 +10892,13 @@ func (e TL_inputBotInlineResultDocument) encode() []byte {
 const crc_inputBotInlineResultGame = 0x4fa417f2
 
 type TL_inputBotInlineResultGame struct {
-	Id           string // Id:string
-	Short_name   string // short_name:string
-	Send_message TL     // send_message:InputBotInlineMessage
+	Id           string                // Id:string
+	Short_name   string                // short_name:string
+	Send_message InputBotInlineMessage // send_message:InputBotInlineMessage
 }
 
+func (e TL_inputBotInlineResultGame) isInputBotInlineResult() {}
+
 // Encoding TL_inputBotInlineResultGame
 func (e TL_inputBotInlineResultGame) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8853,22 +10909,30 @@ func (e TL_inputBotInlineResultGame) encode() []byte {
 	return x.buf
 }
 
+// BotInlineMessage is implemented by constructors of BotInlineMessage type
+type BotInlineMessage interface {
+	TL
+	isBotInlineMessage()
+}
+
 // botInlineMessageMediaAuto#a74b15b flags:# caption:string reply_markup:flags.2?ReplyMarkup = BotInlineMessage;
 
 const crc_botInlineMessageMediaAuto = 0xa74b15b
 
 type TL_botInlineMessageMediaAuto struct {
 	Flags        int32
-	Caption      string // caption:string
-	Reply_markup TL     // reply_markup:flags.2?ReplyMarkup
+	Caption      string      // caption:string
+	Reply_markup ReplyMarkup // reply_markup:flags.2?ReplyMarkup
 }
 
+func (e TL_botInlineMessageMediaAuto) isBotInlineMessage() {}
+
 // Encoding TL_botInlineMessageMediaAuto
 func (e TL_botInlineMessageMediaAuto) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_botInlineMessageMediaAuto)
 	var flags int32
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
@@ -8885,12 +10949,14 @@ const crc_botInlineMessageText = 0x8c7f65e2
 
 type TL_botInlineMessageText struct {
 	Flags        int32
-	No_webpage   bool   // no_webpage:flags.0?true
-	Message      string // message:string
-	Entities     []TL   // entities:flags.1?Vector<MessageEntity>
-	Reply_markup TL     // reply_markup:flags.2?ReplyMarkup
+	No_webpage   bool            // no_webpage:flags.0?true
+	Message      string          // message:string
+	Entities     []MessageEntity // entities:flags.1?Vector<MessageEntity>
+	Reply_markup ReplyMarkup     // reply_markup:flags.2?ReplyMarkup
 }
 
+func (e TL_botInlineMessageText) isBotInlineMessage() {}
+
 // Encoding TL_botInlineMessageText
 func (e TL_botInlineMessageText) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -8902,13 +10968,17 @@ func (e TL_botInlineMessageText) encode() []byte {
 	if len(e.Entities) != 0 {
 		flags |= (1 << 1)
 	}
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
 	x.String(e.Message)
 	if flags&(1<<1) != 0 {
-		x.Vector(e.Entities)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Entities)))
+		for _, v := range e.Entities {
+			x.Bytes(v.encode())
+		}
 	}
 	if flags&(1<<2) != 0 {
 		x.Bytes(e.Reply_markup.encode())
@@ -8922,16 +10992,18 @@ const crc_botInlineMessageMediaGeo = 0x3a8fd8b8
 
 type TL_botInlineMessageMediaGeo struct {
 	Flags        int32
-	Geo          TL // geo:GeoPoint
-	Reply_markup TL // reply_markup:flags.2?ReplyMarkup
+	Geo          GeoPoint    // geo:GeoPoint
+	Reply_markup ReplyMarkup // reply_markup:flags.2?ReplyMarkup
 }
 
+func (e TL_botInlineMessageMediaGeo) isBotInlineMessage() {}
+
 // Encoding TL_botInlineMessageMediaGeo
 func (e TL_botInlineMessageMediaGeo) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_botInlineMessageMediaGeo)
 	var flags int32
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
@@ -8948,20 +11020,22 @@ const crc_botInlineMessageMediaVenue = 0x4366232e
 
 type TL_botInlineMessageMediaVenue struct {
 	Flags        int32
-	Geo          TL     // geo:GeoPoint
-	Title        string // title:string
-	Address      string // address:string
-	Provider     string // provider:string
-	Venue_id     string // venue_id:string
-	Reply_markup TL     // reply_markup:flags.2?ReplyMarkup
+	Geo          GeoPoint    // geo:GeoPoint
+	Title        string      // title:string
+	Address      string      // address:string
+	Provider     string      // provider:string
+	Venue_id     string      // venue_id:string
+	Reply_markup ReplyMarkup // reply_markup:flags.2?ReplyMarkup
 }
 
+func (e TL_botInlineMessageMediaVenue) isBotInlineMessage() {}
+
 // Encoding TL_botInlineMessageMediaVenue
 func (e TL_botInlineMessageMediaVenue) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_botInlineMessageMediaVenue)
 	var flags int32
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
@@ -8982,18 +11056,20 @@ const crc_botInlineMessageMediaContact = 0x35edb4d4
 
 type TL_botInlineMessageMediaContact struct {
 	Flags        int32
-	Phone_number string // phone_number:string
-	First_name   string // first_name:string
-	Last_name    string // last_name:string
-	Reply_markup TL     // reply_markup:flags.2?ReplyMarkup
+	Phone_number string      // phone_number:string
+	First_name   string      // first_name:string
+	Last_name    string      // last_name:string
+	Reply_markup ReplyMarkup // reply_markup:flags.2?ReplyMarkup
 }
 
+func (e TL_botInlineMessageMediaContact) isBotInlineMessage() {}
+
 // Encoding TL_botInlineMessageMediaContact
 func (e TL_botInlineMessageMediaContact) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_botInlineMessageMediaContact)
 	var flags int32
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
@@ -9006,25 +11082,33 @@ func (e TL_botInlineMessageMediaContact) encode() []byte {
 	return x.buf
 }
 
+// BotInlineResult is implemented by constructors of BotInlineResult type
+type BotInlineResult interface {
+	TL
+	isBotInlineResult()
+}
+
 // botInlineResult#9bebaeb9 flags:# Id:string type:string title:flags.1?string description:flags.2?string url:flags.3?string thumb_url:flags.4?string content_url:flags.5?string content_type:flags.5?string w:flags.6?int h:flags.6?int duration:flags.7?int send_message:BotInlineMessage = BotInlineResult;
 
 const crc_botInlineResult = 0x9bebaeb9
 
 type TL_botInlineResult struct {
 	Flags        int32
-	Id           string // Id:string
-	Code_type    string // type:string
-	Title        string // title:flags.1?string
-	Description  string // description:flags.2?string
-	Url          string // url:flags.3?string
-	Thumb_url    string // thumb_url:flags.4?string
-	Content_url  string // content_url:flags.5?string
-	Content_type string // content_type:flags.5?string
-	W            int32  // w:flags.6?int
-	H            int32  // h:flags.6?int
-	Duration     int32  // duration:flags.7?int
-	Send_message TL     // send_message:BotInlineMessage
-}
+	Id           string           // Id:string
+	Code_type    string           // type:string
+	Title        string           // title:flags.1?string
+	Description  string           // description:flags.2?string
+	Url          string           // url:flags.3?string
+	Thumb_url    string           // thumb_url:flags.4?string
+	Content_url  string           // content_url:flags.5?string
+	Content_type string           // content_type:flags.5?string
+	W            int32            // w:flags.6?int
+	H            int32            // h:flags.6?int
+	Duration     int32            // duration:flags.7?int
+	Send_message BotInlineMessage // send_message:BotInlineMessage
+}
+
+func (e TL_botInlineResult) isBotInlineResult() {}
 
 // Encoding TL_botInlineResult
 func (e TL_botInlineResult) encode() []byte {
@@ -9098,24 +11182,26 @@ const crc_botInlineMediaResult = 0x17db940b
 
 type TL_botInlineMediaResult struct {
 	Flags        int32
-	Id           string // Id:string
-	Code_type    string // type:string
-	Photo        TL     // photo:flags.0?Photo
-	Document     TL     // document:flags.1?Document
-	Title        string // title:flags.2?string
-	Description  string // description:flags.3?string
-	Send_message TL     // send_message:BotInlineMessage
+	Id           string           // Id:string
+	Code_type    string           // type:string
+	Photo        Photo            // photo:flags.0?Photo
+	Document     Document         // document:flags.1?Document
+	Title        string           // title:flags.2?string
+	Description  string           // description:flags.3?string
+	Send_message BotInlineMessage // send_message:BotInlineMessage
 }
 
+func (e TL_botInlineMediaResult) isBotInlineResult() {}
+
 // Encoding TL_botInlineMediaResult
 func (e TL_botInlineMediaResult) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_botInlineMediaResult)
 	var flags int32
-	if _, ok := (e.Photo).(TL_null); !ok {
+	if e.Photo != nil {
 		flags |= (1 << 0)
 	}
-	if _, ok := (e.Document).(TL_null); !ok {
+	if e.Document != nil {
 		flags |= (1 << 1)
 	}
 	if e.Title != "" {
@@ -9143,20 +11229,28 @@ func (e TL_botInlineMediaResult) encode() []byte {
 	return x.buf
 }
 
+// Messages_BotResults is implemented by constructors of messages.BotResults type
+type Messages_BotResults interface {
+	TL
+	isMessages_BotResults()
+}
+
 // messages.botResults#ccd3563d flags:# gallery:flags.0?true query_id:long next_offset:flags.1?string switch_pm:flags.2?InlineBotSwitchPM results:Vector<BotInlineResult> cache_time:int = messages.BotResults;
 
 const crc_messages_botResults = 0xccd3563d
 
 type TL_messages_botResults struct {
 	Flags       int32
-	Gallery     bool   // gallery:flags.0?true
-	Query_id    int64  // query_id:long
-	Next_offset string // next_offset:flags.1?string
-	Switch_pm   TL     // switch_pm:flags.2?InlineBotSwitchPM
-	Results     []TL   // results:Vector<BotInlineResult>
-	Cache_time  int32  // cache_time:int
+	Gallery     bool              // gallery:flags.0?true
+	Query_id    int64             // query_id:long
+	Next_offset string            // next_offset:flags.1?string
+	Switch_pm   InlineBotSwitchPM // switch_pm:flags.2?InlineBotSwitchPM
+	Results     []BotInlineResult // results:Vector<BotInlineResult>
+	Cache_time  int32             // cache_time:int
 }
 
+func (e TL_messages_botResults) isMessages_BotResults() {}
+
 // Encoding TL_messages_botResults
 func (e TL_messages_botResults) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9168,7 +11262,7 @@ func (e TL_messages_botResults) encode() []byte {
 	if e.Next_offset != "" {
 		flags |= (1 << 1)
 	}
-	if _, ok := (e.Switch_pm).(TL_null); !ok {
+	if e.Switch_pm != nil {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
@@ -9179,11 +11273,21 @@ func (e TL_messages_botResults) encode() []byte {
 	if flags&(1<<2) != 0 {
 		x.Bytes(e.Switch_pm.encode())
 	}
-	x.Vector(e.Results)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Results)))
+	for _, v := range e.Results {
+		x.Bytes(v.encode())
+	}
 	x.Int(e.Cache_time)
 	return x.buf
 }
 
+// ExportedMessageLink is implemented by constructors of ExportedMessageLink type
+type ExportedMessageLink interface {
+	TL
+	isExportedMessageLink()
+}
+
 // exportedMessageLink#1f486803 link:string = ExportedMessageLink;
 
 const crc_exportedMessageLink = 0x1f486803
@@ -9192,6 +11296,8 @@ type TL_exportedMessageLink struct {
 	Link string // link:string
 }
 
+func (e TL_exportedMessageLink) isExportedMessageLink() {}
+
 // Encoding TL_exportedMessageLink
 func (e TL_exportedMessageLink) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9200,6 +11306,12 @@ func (e TL_exportedMessageLink) encode() []byte {
 	return x.buf
 }
 
+// MessageFwdHeader is implemented by constructors of MessageFwdHeader type
+type MessageFwdHeader interface {
+	TL
+	isMessageFwdHeader()
+}
+
 // messageFwdHeader#c786ddcb flags:# from_id:flags.0?int date:int channel_id:flags.1?int channel_post:flags.2?int = MessageFwdHeader;
 
 const crc_messageFwdHeader = 0xc786ddcb
@@ -9212,6 +11324,8 @@ type TL_messageFwdHeader struct {
 	Channel_post int32 // channel_post:flags.2?int
 }
 
+func (e TL_messageFwdHeader) isMessageFwdHeader() {}
+
 // Encoding TL_messageFwdHeader
 func (e TL_messageFwdHeader) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9240,6 +11354,12 @@ func (e TL_messageFwdHeader) encode() []byte {
 	return x.buf
 }
 
+// Auth_CodeType is implemented by constructors of auth.CodeType type
+type Auth_CodeType interface {
+	TL
+	isAuth_CodeType()
+}
+
 // auth.codeTypeSms#72a3158c = auth.CodeType;
 
 const crc_auth_codeTypeSms = 0x72a3158c
@@ -9247,6 +11367,8 @@ const crc_auth_codeTypeSms = 0x72a3158c
 type TL_auth_codeTypeSms struct {
 }
 
+func (e TL_auth_codeTypeSms) isAuth_CodeType() {}
+
 // Encoding TL_auth_codeTypeSms
 func (e TL_auth_codeTypeSms) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9261,6 +11383,8 @@ const crc_auth_codeTypeCall = 0x741cd3e3
 type TL_auth_codeTypeCall struct {
 }
 
+func (e TL_auth_codeTypeCall) isAuth_CodeType() {}
+
 // Encoding TL_auth_codeTypeCall
 func (e TL_auth_codeTypeCall) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9275,6 +11399,8 @@ const crc_auth_codeTypeFlashCall = 0x226ccefb
 type TL_auth_codeTypeFlashCall struct {
 }
 
+func (e TL_auth_codeTypeFlashCall) isAuth_CodeType() {}
+
 // Encoding TL_auth_codeTypeFlashCall
 func (e TL_auth_codeTypeFlashCall) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9282,6 +11408,12 @@ func (e TL_auth_codeTypeFlashCall) encode() []byte {
 	return x.buf
 }
 
+// Auth_SentCodeType is implemented by constructors of auth.SentCodeType type
+type Auth_SentCodeType interface {
+	TL
+	isAuth_SentCodeType()
+}
+
 // auth.sentCodeTypeApp#3dbb5986 length:int = auth.SentCodeType;
 
 const crc_auth_sentCodeTypeApp = 0x3dbb5986
@@ -9290,6 +11422,8 @@ type TL_auth_sentCodeTypeApp struct {
 	Length int32 // length:int
 }
 
+func (e TL_auth_sentCodeTypeApp) isAuth_SentCodeType() {}
+
 // Encoding TL_auth_sentCodeTypeApp
 func (e TL_auth_sentCodeTypeApp) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9306,6 +11440,8 @@ type TL_auth_sentCodeTypeSms struct {
 	Length int32 // length:int
 }
 
+func (e TL_auth_sentCodeTypeSms) isAuth_SentCodeType() {}
+
 // Encoding TL_auth_sentCodeTypeSms
 func (e TL_auth_sentCodeTypeSms) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9322,6 +11458,8 @@ type TL_auth_sentCodeTypeCall struct {
 	Length int32 // length:int
 }
 
+func (e TL_auth_sentCodeTypeCall) isAuth_SentCodeType() {}
+
 // Encoding TL_auth_sentCodeTypeCall
 func (e TL_auth_sentCodeTypeCall) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9338,6 +11476,8 @@ type TL_auth_sentCodeTypeFlashCall struct {
 	Pattern string // pattern:string
 }
 
+func (e TL_auth_sentCodeTypeFlashCall) isAuth_SentCodeType() {}
+
 // Encoding TL_auth_sentCodeTypeFlashCall
 func (e TL_auth_sentCodeTypeFlashCall) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9346,6 +11486,12 @@ func (e TL_auth_sentCodeTypeFlashCall) encode() []byte {
 	return x.buf
 }
 
+// Messages_BotCallbackAnswer is implemented by constructors of messages.BotCallbackAnswer type
+type Messages_BotCallbackAnswer interface {
+	TL
+	isMessages_BotCallbackAnswer()
+}
+
 // messages.botCallbackAnswer#36585ea4 flags:# alert:flags.1?true has_url:flags.3?true message:flags.0?string url:flags.2?string cache_time:int = messages.BotCallbackAnswer;
 
 const crc_messages_botCallbackAnswer = 0x36585ea4
@@ -9359,6 +11505,8 @@ type TL_messages_botCallbackAnswer struct {
 	Cache_time int32  // cache_time:int
 }
 
+func (e TL_messages_botCallbackAnswer) isMessages_BotCallbackAnswer() {}
+
 // Encoding TL_messages_botCallbackAnswer
 func (e TL_messages_botCallbackAnswer) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9387,6 +11535,12 @@ func (e TL_messages_botCallbackAnswer) encode() []byte {
 	return x.buf
 }
 
+// Messages_MessageEditData is implemented by constructors of messages.MessageEditData type
+type Messages_MessageEditData interface {
+	TL
+	isMessages_MessageEditData()
+}
+
 // messages.messageEditData#26b5dde6 flags:# caption:flags.0?true = messages.MessageEditData;
 
 const crc_messages_messageEditData = 0x26b5dde6
@@ -9396,6 +11550,8 @@ type TL_messages_messageEditData struct {
 	Caption bool // caption:flags.0?true
 }
 
+func (e TL_messages_messageEditData) isMessages_MessageEditData() {}
+
 // Encoding TL_messages_messageEditData
 func (e TL_messages_messageEditData) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9408,6 +11564,12 @@ func (e TL_messages_messageEditData) encode() []byte {
 	return x.buf
 }
 
+// InputBotInlineMessageID is implemented by constructors of InputBotInlineMessageID type
+type InputBotInlineMessageID interface {
+	TL
+	isInputBotInlineMessageID()
+}
+
 // inputBotInlineMessageID#890c3d89 dc_id:int Id:long access_hash:long = InputBotInlineMessageID;
 
 const crc_inputBotInlineMessageID = 0x890c3d89
@@ -9418,6 +11580,8 @@ type TL_inputBotInlineMessageID struct {
 	Access_hash int64 // access_hash:long
 }
 
+func (e TL_inputBotInlineMessageID) isInputBotInlineMessageID() {}
+
 // Encoding TL_inputBotInlineMessageID
 func (e TL_inputBotInlineMessageID) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9428,6 +11592,12 @@ func (e TL_inputBotInlineMessageID) encode() []byte {
 	return x.buf
 }
 
+// InlineBotSwitchPM is implemented by constructors of InlineBotSwitchPM type
+type InlineBotSwitchPM interface {
+	TL
+	isInlineBotSwitchPM()
+}
+
 // inlineBotSwitchPM#3c20629f text:string start_param:string = InlineBotSwitchPM;
 
 const crc_inlineBotSwitchPM = 0x3c20629f
@@ -9437,6 +11607,8 @@ type TL_inlineBotSwitchPM struct {
 	Start_param string // start_param:string
 }
 
+func (e TL_inlineBotSwitchPM) isInlineBotSwitchPM() {}
+
 // Encoding TL_inlineBotSwitchPM
 func (e TL_inlineBotSwitchPM) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9446,39 +11618,71 @@ func (e TL_inlineBotSwitchPM) encode() []byte {
 	return x.buf
 }
 
+// Messages_PeerDialogs is implemented by constructors of messages.PeerDialogs type
+type Messages_PeerDialogs interface {
+	TL
+	isMessages_PeerDialogs()
+}
+
 // messages.peerDialogs#3371c354 dialogs:Vector<Dialog> messages:Vector<Message> chats:Vector<Chat> users:Vector<User> state:updates.State = messages.PeerDialogs;
 
 const crc_messages_peerDialogs = 0x3371c354
 
 type TL_messages_peerDialogs struct {
-	Dialogs  []TL // dialogs:Vector<Dialog>
-	Messages []TL // messages:Vector<Message>
-	Chats    []TL // chats:Vector<Chat>
-	Users    []TL // users:Vector<User>
-	State    TL   // state:updates.State
+	Dialogs  []Dialog      // dialogs:Vector<Dialog>
+	Messages []Message     // messages:Vector<Message>
+	Chats    []Chat        // chats:Vector<Chat>
+	Users    []User        // users:Vector<User>
+	State    Updates_State // state:updates.State
 }
 
+func (e TL_messages_peerDialogs) isMessages_PeerDialogs() {}
+
 // Encoding TL_messages_peerDialogs
 func (e TL_messages_peerDialogs) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_peerDialogs)
-	x.Vector(e.Dialogs)
-	x.Vector(e.Messages)
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Dialogs)))
+	for _, v := range e.Dialogs {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Messages)))
+	for _, v := range e.Messages {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	x.Bytes(e.State.encode())
 	return x.buf
 }
 
+// TopPeer is implemented by constructors of TopPeer type
+type TopPeer interface {
+	TL
+	isTopPeer()
+}
+
 // topPeer#edcdc05b peer:Peer rating:double = TopPeer;
 
 const crc_topPeer = 0xedcdc05b
 
 type TL_topPeer struct {
-	Peer   TL      // peer:Peer
+	Peer   Peer    // peer:Peer
 	Rating float64 // rating:double
 }
 
+func (e TL_topPeer) isTopPeer() {}
+
 // Encoding TL_topPeer
 func (e TL_topPeer) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9488,6 +11692,12 @@ func (e TL_topPeer) encode() []byte {
 	return x.buf
 }
 
+// TopPeerCategory is implemented by constructors of TopPeerCategory type
+type TopPeerCategory interface {
+	TL
+	isTopPeerCategory()
+}
+
 // topPeerCategoryBotsPM#ab661b5b = TopPeerCategory;
 
 const crc_topPeerCategoryBotsPM = 0xab661b5b
@@ -9495,6 +11705,8 @@ const crc_topPeerCategoryBotsPM = 0xab661b5b
 type TL_topPeerCategoryBotsPM struct {
 }
 
+func (e TL_topPeerCategoryBotsPM) isTopPeerCategory() {}
+
 // Encoding TL_topPeerCategoryBotsPM
 func (e TL_topPeerCategoryBotsPM) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9509,6 +11721,8 @@ const crc_topPeerCategoryBotsInline = 0x148677e2
 type TL_topPeerCategoryBotsInline struct {
 }
 
+func (e TL_topPeerCategoryBotsInline) isTopPeerCategory() {}
+
 // Encoding TL_topPeerCategoryBotsInline
 func (e TL_topPeerCategoryBotsInline) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9523,6 +11737,8 @@ const crc_topPeerCategoryCorrespondents = 0x637b7ed
 type TL_topPeerCategoryCorrespondents struct {
 }
 
+func (e TL_topPeerCategoryCorrespondents) isTopPeerCategory() {}
+
 // Encoding TL_topPeerCategoryCorrespondents
 func (e TL_topPeerCategoryCorrespondents) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9537,6 +11753,8 @@ const crc_topPeerCategoryGroups = 0xbd17a14a
 type TL_topPeerCategoryGroups struct {
 }
 
+func (e TL_topPeerCategoryGroups) isTopPeerCategory() {}
+
 // Encoding TL_topPeerCategoryGroups
 func (e TL_topPeerCategoryGroups) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9551,6 +11769,8 @@ const crc_topPeerCategoryChannels = 0x161d9628
 type TL_topPeerCategoryChannels struct {
 }
 
+func (e TL_topPeerCategoryChannels) isTopPeerCategory() {}
+
 // Encoding TL_topPeerCategoryChannels
 func (e TL_topPeerCategoryChannels) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9558,26 +11778,44 @@ func (e TL_topPeerCategoryChannels) encode() []byte {
 	return x.buf
 }
 
+// TopPeerCategoryPeers is implemented by constructors of TopPeerCategoryPeers type
+type TopPeerCategoryPeers interface {
+	TL
+	isTopPeerCategoryPeers()
+}
+
 // topPeerCategoryPeers#fb834291 category:TopPeerCategory count:int peers:Vector<TopPeer> = TopPeerCategoryPeers;
 
 const crc_topPeerCategoryPeers = 0xfb834291
 
 type TL_topPeerCategoryPeers struct {
-	Category TL    // category:TopPeerCategory
-	Count    int32 // count:int
-	Peers    []TL  // peers:Vector<TopPeer>
+	Category TopPeerCategory // category:TopPeerCategory
+	Count    int32           // count:int
+	Peers    []TopPeer       // peers:Vector<TopPeer>
 }
 
+func (e TL_topPeerCategoryPeers) isTopPeerCategoryPeers() {}
+
 // Encoding TL_topPeerCategoryPeers
 func (e TL_topPeerCategoryPeers) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_topPeerCategoryPeers)
 	x.Bytes(e.Category.encode())
 	x.Int(e.Count)
-	x.Vector(e.Peers)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Peers)))
+	for _, v := range e.Peers {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Contacts_TopPeers is implemented by constructors of contacts.TopPeers type
+type Contacts_TopPeers interface {
+	TL
+	isContacts_TopPeers()
+}
+
 // contacts.topPeersNotModified#de266ef5 = contacts.TopPeers;
 
 const crc_contacts_topPeersNotModified = 0xde266ef5
@@ -9585,6 +11823,8 @@ const crc_contacts_topPeersNotModified = 0xde266ef5
 type TL_contacts_topPeersNotModified struct {
 }
 
+func (e TL_contacts_topPeersNotModified) isContacts_TopPeers() {}
+
 // Encoding TL_contacts_topPeersNotModified
 func (e TL_contacts_topPeersNotModified) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9597,21 +11837,41 @@ func (e TL_contacts_topPeersNotModified) encode() []byte {
 const crc_contacts_topPeers = 0x70b772a8
 
 type TL_contacts_topPeers struct {
-	Categories []TL // categories:Vector<TopPeerCategoryPeers>
-	Chats      []TL // chats:Vector<Chat>
-	Users      []TL // users:Vector<User>
+	Categories []TopPeerCategoryPeers // categories:Vector<TopPeerCategoryPeers>
+	Chats      []Chat                 // chats:Vector<Chat>
+	Users      []User                 // users:Vector<User>
 }
 
+func (e TL_contacts_topPeers) isContacts_TopPeers() {}
+
 // Encoding TL_contacts_topPeers
 func (e TL_contacts_topPeers) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_contacts_topPeers)
-	x.Vector(e.Categories)
-	x.Vector(e.Chats)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Categories)))
+	for _, v := range e.Categories {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Chats)))
+	for _, v := range e.Chats {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// DraftMessage is implemented by constructors of DraftMessage type
+type DraftMessage interface {
+	TL
+	isDraftMessage()
+}
+
 // draftMessageEmpty#ba4baec5 = DraftMessage;
 
 const crc_draftMessageEmpty = 0xba4baec5
@@ -9619,6 +11879,8 @@ const crc_draftMessageEmpty = 0xba4baec5
 type TL_draftMessageEmpty struct {
 }
 
+func (e TL_draftMessageEmpty) isDraftMessage() {}
+
 // Encoding TL_draftMessageEmpty
 func (e TL_draftMessageEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9632,13 +11894,15 @@ const crc_draftMessage = 0xfd8e711f
 
 type TL_draftMessage struct {
 	Flags           int32
-	No_webpage      bool   // no_webpage:flags.1?true
-	Reply_to_msg_id int32  // reply_to_msg_id:flags.0?int
-	Message         string // message:string
-	Entities        []TL   // entities:flags.3?Vector<MessageEntity>
-	Date            int32  // date:int
+	No_webpage      bool            // no_webpage:flags.1?true
+	Reply_to_msg_id int32           // reply_to_msg_id:flags.0?int
+	Message         string          // message:string
+	Entities        []MessageEntity // entities:flags.3?Vector<MessageEntity>
+	Date            int32           // date:int
 }
 
+func (e TL_draftMessage) isDraftMessage() {}
+
 // Encoding TL_draftMessage
 func (e TL_draftMessage) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9659,12 +11923,22 @@ func (e TL_draftMessage) encode() []byte {
 	}
 	x.String(e.Message)
 	if flags&(1<<3) != 0 {
-		x.Vector(e.Entities)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Entities)))
+		for _, v := range e.Entities {
+			x.Bytes(v.encode())
+		}
 	}
 	x.Int(e.Date)
 	return x.buf
 }
 
+// Messages_FeaturedStickers is implemented by constructors of messages.FeaturedStickers type
+type Messages_FeaturedStickers interface {
+	TL
+	isMessages_FeaturedStickers()
+}
+
 // messages.featuredStickersNotModified#4ede3cf = messages.FeaturedStickers;
 
 const crc_messages_featuredStickersNotModified = 0x4ede3cf
@@ -9672,6 +11946,8 @@ const crc_messages_featuredStickersNotModified = 0x4ede3cf
 type TL_messages_featuredStickersNotModified struct {
 }
 
+func (e TL_messages_featuredStickersNotModified) isMessages_FeaturedStickers() {}
+
 // Encoding TL_messages_featuredStickersNotModified
 func (e TL_messages_featuredStickersNotModified) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9684,21 +11960,33 @@ func (e TL_messages_featuredStickersNotModified) encode() []byte {
 const crc_messages_featuredStickers = 0xf89d88e5
 
 type TL_messages_featuredStickers struct {
-	Hash   int32   // Hash:int
-	Sets   []TL    // sets:Vector<StickerSetCovered>
-	Unread []int64 // unread:Vector<long>
+	Hash   int32               // Hash:int
+	Sets   []StickerSetCovered // sets:Vector<StickerSetCovered>
+	Unread []int64             // unread:Vector<long>
 }
 
+func (e TL_messages_featuredStickers) isMessages_FeaturedStickers() {}
+
 // Encoding TL_messages_featuredStickers
 func (e TL_messages_featuredStickers) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_featuredStickers)
 	x.Int(e.Hash)
-	x.Vector(e.Sets)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Sets)))
+	for _, v := range e.Sets {
+		x.Bytes(v.encode())
+	}
 	x.VectorLong(e.Unread)
 	return x.buf
 }
 
+// Messages_RecentStickers is implemented by constructors of messages.RecentStickers type
+type Messages_RecentStickers interface {
+	TL
+	isMessages_RecentStickers()
+}
+
 // messages.recentStickersNotModified#b17f890 = messages.RecentStickers;
 
 const crc_messages_recentStickersNotModified = 0xb17f890
@@ -9706,6 +11994,8 @@ const crc_messages_recentStickersNotModified = 0xb17f890
 type TL_messages_recentStickersNotModified struct {
 }
 
+func (e TL_messages_recentStickersNotModified) isMessages_RecentStickers() {}
+
 // Encoding TL_messages_recentStickersNotModified
 func (e TL_messages_recentStickersNotModified) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9718,37 +12008,61 @@ func (e TL_messages_recentStickersNotModified) encode() []byte {
 const crc_messages_recentStickers = 0x5ce20970
 
 type TL_messages_recentStickers struct {
-	Hash     int32 // Hash:int
-	Stickers []TL  // stickers:Vector<Document>
+	Hash     int32      // Hash:int
+	Stickers []Document // stickers:Vector<Document>
 }
 
+func (e TL_messages_recentStickers) isMessages_RecentStickers() {}
+
 // Encoding TL_messages_recentStickers
 func (e TL_messages_recentStickers) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_recentStickers)
 	x.Int(e.Hash)
-	x.Vector(e.Stickers)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Stickers)))
+	for _, v := range e.Stickers {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Messages_ArchivedStickers is implemented by constructors of messages.ArchivedStickers type
+type Messages_ArchivedStickers interface {
+	TL
+	isMessages_ArchivedStickers()
+}
+
 // messages.archivedStickers#4fcba9c8 count:int sets:Vector<StickerSetCovered> = messages.ArchivedStickers;
 
 const crc_messages_archivedStickers = 0x4fcba9c8
 
 type TL_messages_archivedStickers struct {
-	Count int32 // count:int
-	Sets  []TL  // sets:Vector<StickerSetCovered>
+	Count int32               // count:int
+	Sets  []StickerSetCovered // sets:Vector<StickerSetCovered>
 }
 
+func (e TL_messages_archivedStickers) isMessages_ArchivedStickers() {}
+
 // Encoding TL_messages_archivedStickers
 func (e TL_messages_archivedStickers) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_archivedStickers)
 	x.Int(e.Count)
-	x.Vector(e.Sets)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Sets)))
+	for _, v := range e.Sets {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Messages_StickerSetInstallResult is implemented by constructors of messages.StickerSetInstallResult type
+type Messages_StickerSetInstallResult interface {
+	TL
+	isMessages_StickerSetInstallResult()
+}
+
 // messages.stickerSetInstallResultSuccess#38641628 = messages.StickerSetInstallResult;
 
 const crc_messages_stickerSetInstallResultSuccess = 0x38641628
@@ -9756,6 +12070,8 @@ const crc_messages_stickerSetInstallResultSuccess = 0x38641628
 type TL_messages_stickerSetInstallResultSuccess struct {
 }
 
+func (e TL_messages_stickerSetInstallResultSuccess) isMessages_StickerSetInstallResult() {}
+
 // Encoding TL_messages_stickerSetInstallResultSuccess
 func (e TL_messages_stickerSetInstallResultSuccess) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9768,26 +12084,40 @@ func (e TL_messages_stickerSetInstallResultSuccess) encode() []byte {
 const crc_messages_stickerSetInstallResultArchive = 0x35e410a8
 
 type TL_messages_stickerSetInstallResultArchive struct {
-	Sets []TL // sets:Vector<StickerSetCovered>
+	Sets []StickerSetCovered // sets:Vector<StickerSetCovered>
 }
 
+func (e TL_messages_stickerSetInstallResultArchive) isMessages_StickerSetInstallResult() {}
+
 // Encoding TL_messages_stickerSetInstallResultArchive
 func (e TL_messages_stickerSetInstallResultArchive) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_stickerSetInstallResultArchive)
-	x.Vector(e.Sets)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Sets)))
+	for _, v := range e.Sets {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// StickerSetCovered is implemented by constructors of StickerSetCovered type
+type StickerSetCovered interface {
+	TL
+	isStickerSetCovered()
+}
+
 // stickerSetCovered#6410a5d2 set:StickerSet cover:Document = StickerSetCovered;
 
 const crc_stickerSetCovered = 0x6410a5d2
 
 type TL_stickerSetCovered struct {
-	Set   TL // set:StickerSet
-	Cover TL // cover:Document
+	Set   StickerSet // set:StickerSet
+	Cover Document   // cover:Document
 }
 
+func (e TL_stickerSetCovered) isStickerSetCovered() {}
+
 // Encoding TL_stickerSetCovered
 func (e TL_stickerSetCovered) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9802,19 +12132,31 @@ func (e TL_stickerSetCovered) encode() []byte {
 const crc_stickerSetMultiCovered = 0x3407e51b
 
 type TL_stickerSetMultiCovered struct {
-	Set    TL   // set:StickerSet
-	Covers []TL // covers:Vector<Document>
+	Set    StickerSet // set:StickerSet
+	Covers []Document // covers:Vector<Document>
 }
 
+func (e TL_stickerSetMultiCovered) isStickerSetCovered() {}
+
 // Encoding TL_stickerSetMultiCovered
 func (e TL_stickerSetMultiCovered) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_stickerSetMultiCovered)
 	x.Bytes(e.Set.encode())
-	x.Vector(e.Covers)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Covers)))
+	for _, v := range e.Covers {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// MaskCoords is implemented by constructors of MaskCoords type
+type MaskCoords interface {
+	TL
+	isMaskCoords()
+}
+
 // maskCoords#aed6dbb2 n:int x:double y:double zoom:double = MaskCoords;
 
 const crc_maskCoords = 0xaed6dbb2
@@ -9826,6 +12168,8 @@ type TL_maskCoords struct {
 	Zoom float64 // zoom:double
 }
 
+func (e TL_maskCoords) isMaskCoords() {}
+
 // Encoding TL_maskCoords
 func (e TL_maskCoords) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9837,14 +12181,22 @@ func (e TL_maskCoords) encode() []byte {
 	return x.buf
 }
 
+// InputStickeredMedia is implemented by constructors of InputStickeredMedia type
+type InputStickeredMedia interface {
+	TL
+	isInputStickeredMedia()
+}
+
 // inputStickeredMediaPhoto#4a992157 Id:InputPhoto = InputStickeredMedia;
 
 const crc_inputStickeredMediaPhoto = 0x4a992157
 
 type TL_inputStickeredMediaPhoto struct {
-	Id TL // Id:InputPhoto
+	Id InputPhoto // Id:InputPhoto
 }
 
+func (e TL_inputStickeredMediaPhoto) isInputStickeredMedia() {}
+
 // Encoding TL_inputStickeredMediaPhoto
 func (e TL_inputStickeredMediaPhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9858,9 +12210,11 @@ func (e TL_inputStickeredMediaPhoto) encode() []byte {
 const crc_inputStickeredMediaDocument = 0x438865b
 
 type TL_inputStickeredMediaDocument struct {
-	Id TL // Id:InputDocument
+	Id InputDocument // Id:InputDocument
 }
 
+func (e TL_inputStickeredMediaDocument) isInputStickeredMedia() {}
+
 // Encoding TL_inputStickeredMediaDocument
 func (e TL_inputStickeredMediaDocument) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9869,27 +12223,35 @@ func (e TL_inputStickeredMediaDocument) encode() []byte {
 	return x.buf
 }
 
+// Game is implemented by constructors of Game type
+type Game interface {
+	TL
+	isGame()
+}
+
 // game#bdf9653b flags:# Id:long access_hash:long short_name:string title:string description:string photo:Photo document:flags.0?Document = Game;
 
 const crc_game = 0xbdf9653b
 
 type TL_game struct {
 	Flags       int32
-	Id          int64  // Id:long
-	Access_hash int64  // access_hash:long
-	Short_name  string // short_name:string
-	Title       string // title:string
-	Description string // description:string
-	Photo       TL     // photo:Photo
-	Document    TL     // document:flags.0?Document
+	Id          int64    // Id:long
+	Access_hash int64    // access_hash:long
+	Short_name  string   // short_name:string
+	Title       string   // title:string
+	Description string   // description:string
+	Photo       Photo    // photo:Photo
+	Document    Document // document:flags.0?Document
 }
 
+func (e TL_game) isGame() {}
+
 // Encoding TL_game
 func (e TL_game) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_game)
 	var flags int32
-	if _, ok := (e.Document).(TL_null); !ok {
+	if e.Document != nil {
 		flags |= (1 << 0)
 	}
 	x.Int(flags)
@@ -9905,6 +12267,12 @@ func (e TL_game) encode() []byte {
 	return x.buf
 }
 
+// InputGame is implemented by constructors of InputGame type
+type InputGame interface {
+	TL
+	isInputGame()
+}
+
 // inputGameID#32c3e77 Id:long access_hash:long = InputGame;
 
 const crc_inputGameID = 0x32c3e77
@@ -9914,6 +12282,8 @@ type TL_inputGameID struct {
 	Access_hash int64 // access_hash:long
 }
 
+func (e TL_inputGameID) isInputGame() {}
+
 // Encoding TL_inputGameID
 func (e TL_inputGameID) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9928,10 +12298,12 @@ func (e TL_inputGameID) encode() []byte {
 const crc_inputGameShortName = 0xc331e80a
 
 type TL_inputGameShortName struct {
-	Bot_id     TL     // bot_id:InputUser
-	Short_name string // short_name:string
+	Bot_id     InputUser // bot_id:InputUser
+	Short_name string    // short_name:string
 }
 
+func (e TL_inputGameShortName) isInputGame() {}
+
 // Encoding TL_inputGameShortName
 func (e TL_inputGameShortName) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9941,6 +12313,12 @@ func (e TL_inputGameShortName) encode() []byte {
 	return x.buf
 }
 
+// HighScore is implemented by constructors of HighScore type
+type HighScore interface {
+	TL
+	isHighScore()
+}
+
 // highScore#58fffcd0 pos:int user_id:int score:int = HighScore;
 
 const crc_highScore = 0x58fffcd0
@@ -9951,6 +12329,8 @@ type TL_highScore struct {
 	Score   int32 // score:int
 }
 
+func (e TL_highScore) isHighScore() {}
+
 // Encoding TL_highScore
 func (e TL_highScore) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -9961,24 +12341,46 @@ func (e TL_highScore) encode() []byte {
 	return x.buf
 }
 
+// Messages_HighScores is implemented by constructors of messages.HighScores type
+type Messages_HighScores interface {
+	TL
+	isMessages_HighScores()
+}
+
 // messages.highScores#9a3bfd99 scores:Vector<HighScore> users:Vector<User> = messages.HighScores;
 
 const crc_messages_highScores = 0x9a3bfd99
 
 type TL_messages_highScores struct {
-	Scores []TL // scores:Vector<HighScore>
-	Users  []TL // users:Vector<User>
+	Scores []HighScore // scores:Vector<HighScore>
+	Users  []User      // users:Vector<User>
 }
 
+func (e TL_messages_highScores) isMessages_HighScores() {}
+
 // Encoding TL_messages_highScores
 func (e TL_messages_highScores) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_highScores)
-	x.Vector(e.Scores)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Scores)))
+	for _, v := range e.Scores {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// RichText is implemented by constructors of RichText type
+type RichText interface {
+	TL
+	isRichText()
+}
+
 // textEmpty#dc3d824f = RichText;
 
 const crc_textEmpty = 0xdc3d824f
@@ -9986,6 +12388,8 @@ const crc_textEmpty = 0xdc3d824f
 type TL_textEmpty struct {
 }
 
+func (e TL_textEmpty) isRichText() {}
+
 // Encoding TL_textEmpty
 func (e TL_textEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10001,6 +12405,8 @@ type TL_textPlain struct {
 	Text string // text:string
 }
 
+func (e TL_textPlain) isRichText() {}
+
 // Encoding TL_textPlain
 func (e TL_textPlain) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10014,9 +12420,11 @@ func (e TL_textPlain) encode() []byte {
 const crc_textBold = 0x6724abc4
 
 type TL_textBold struct {
-	Text TL // text:RichText
+	Text RichText // text:RichText
 }
 
+func (e TL_textBold) isRichText() {}
+
 // Encoding TL_textBold
 func (e TL_textBold) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10030,9 +12438,11 @@ func (e TL_textBold) encode() []byte {
 const crc_textItalic = 0xd912a59c
 
 type TL_textItalic struct {
-	Text TL // text:RichText
+	Text RichText // text:RichText
 }
 
+func (e TL_textItalic) isRichText() {}
+
 // Encoding TL_textItalic
 func (e TL_textItalic) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10046,9 +12456,11 @@ func (e TL_textItalic) encode() []byte {
 const crc_textUnderline = 0xc12622c4
 
 type TL_textUnderline struct {
-	Text TL // text:RichText
+	Text RichText // text:RichText
 }
 
+func (e TL_textUnderline) isRichText() {}
+
 // Encoding TL_textUnderline
 func (e TL_textUnderline) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10062,9 +12474,11 @@ func (e TL_textUnderline) encode() []byte {
 const crc_textStrike = 0x9bf8bb95
 
 type TL_textStrike struct {
-	Text TL // text:RichText
+	Text RichText // text:RichText
 }
 
+func (e TL_textStrike) isRichText() {}
+
 // Encoding TL_textStrike
 func (e TL_textStrike) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10078,9 +12492,11 @@ func (e TL_textStrike) encode() []byte {
 const crc_textFixed = 0x6c3f19b9
 
 type TL_textFixed struct {
-	Text TL // text:RichText
+	Text RichText // text:RichText
 }
 
+func (e TL_textFixed) isRichText() {}
+
 // Encoding TL_textFixed
 func (e TL_textFixed) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10094,11 +12510,13 @@ func (e TL_textFixed) encode() []byte {
 const crc_textUrl = 0x3c2884c1
 
 type TL_textUrl struct {
-	Text       TL     // text:RichText
-	Url        string // url:string
-	Webpage_id int64  // webpage_id:long
+	Text       RichText // text:RichText
+	Url        string   // url:string
+	Webpage_id int64    // webpage_id:long
 }
 
+func (e TL_textUrl) isRichText() {}
+
 // Encoding TL_textUrl
 func (e TL_textUrl) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10114,10 +12532,12 @@ func (e TL_textUrl) encode() []byte {
 const crc_textEmail = 0xde5a0dd6
 
 type TL_textEmail struct {
-	Text  TL     // text:RichText
-	Email string // email:string
+	Text  RichText // text:RichText
+	Email string   // email:string
 }
 
+func (e TL_textEmail) isRichText() {}
+
 // Encoding TL_textEmail
 func (e TL_textEmail) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10132,17 +12552,29 @@ func (e TL_textEmail) encode() []byte {
 const crc_textConcat = 0x7e6260d7
 
 type TL_textConcat struct {
-	Texts []TL // texts:Vector<RichText>
+	Texts []RichText // texts:Vector<RichText>
 }
 
+func (e TL_textConcat) isRichText() {}
+
 // Encoding TL_textConcat
 func (e TL_textConcat) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_textConcat)
-	x.Vector(e.Texts)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Texts)))
+	for _, v := range e.Texts {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// PageBlock is implemented by constructors of PageBlock type
+type PageBlock interface {
+	TL
+	isPageBlock()
+}
+
 // pageBlockUnsupported#13567e8a = PageBlock;
 
 const crc_pageBlockUnsupported = 0x13567e8a
@@ -10150,6 +12582,8 @@ const crc_pageBlockUnsupported = 0x13567e8a
 type TL_pageBlockUnsupported struct {
 }
 
+func (e TL_pageBlockUnsupported) isPageBlock() {}
+
 // Encoding TL_pageBlockUnsupported
 func (e TL_pageBlockUnsupported) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10162,9 +12596,11 @@ func (e TL_pageBlockUnsupported) encode() []byte {
 const crc_pageBlockTitle = 0x70abc3fd
 
 type TL_pageBlockTitle struct {
-	Text TL // text:RichText
+	Text RichText // text:RichText
 }
 
+func (e TL_pageBlockTitle) isPageBlock() {}
+
 // Encoding TL_pageBlockTitle
 func (e TL_pageBlockTitle) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10178,9 +12614,11 @@ func (e TL_pageBlockTitle) encode() []byte {
 const crc_pageBlockSubtitle = 0x8ffa9a1f
 
 type TL_pageBlockSubtitle struct {
-	Text TL // text:RichText
+	Text RichText // text:RichText
 }
 
+func (e TL_pageBlockSubtitle) isPageBlock() {}
+
 // Encoding TL_pageBlockSubtitle
 func (e TL_pageBlockSubtitle) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10194,10 +12632,12 @@ func (e TL_pageBlockSubtitle) encode() []byte {
 const crc_pageBlockAuthorDate = 0xbaafe5e0
 
 type TL_pageBlockAuthorDate struct {
-	Author         TL    // author:RichText
-	Published_date int32 // published_date:int
+	Author         RichText // author:RichText
+	Published_date int32    // published_date:int
 }
 
+func (e TL_pageBlockAuthorDate) isPageBlock() {}
+
 // Encoding TL_pageBlockAuthorDate
 func (e TL_pageBlockAuthorDate) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10212,9 +12652,11 @@ func (e TL_pageBlockAuthorDate) encode() []byte {
 const crc_pageBlockHeader = 0xbfd064ec
 
 type TL_pageBlockHeader struct {
-	Text TL // text:RichText
+	Text RichText // text:RichText
 }
 
+func (e TL_pageBlockHeader) isPageBlock() {}
+
 // Encoding TL_pageBlockHeader
 func (e TL_pageBlockHeader) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10228,9 +12670,11 @@ func (e TL_pageBlockHeader) encode() []byte {
 const crc_pageBlockSubheader = 0xf12bb6e1
 
 type TL_pageBlockSubheader struct {
-	Text TL // text:RichText
+	Text RichText // text:RichText
 }
 
+func (e TL_pageBlockSubheader) isPageBlock() {}
+
 // Encoding TL_pageBlockSubheader
 func (e TL_pageBlockSubheader) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10244,9 +12688,11 @@ func (e TL_pageBlockSubheader) encode() []byte {
 const crc_pageBlockParagraph = 0x467a0766
 
 type TL_pageBlockParagraph struct {
-	Text TL // text:RichText
+	Text RichText // text:RichText
 }
 
+func (e TL_pageBlockParagraph) isPageBlock() {}
+
 // Encoding TL_pageBlockParagraph
 func (e TL_pageBlockParagraph) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10260,10 +12706,12 @@ func (e TL_pageBlockParagraph) encode() []byte {
 const crc_pageBlockPreformatted = 0xc070d93e
 
 type TL_pageBlockPreformatted struct {
-	Text     TL     // text:RichText
-	Language string // Language:string
+	Text     RichText // text:RichText
+	Language string   // Language:string
 }
 
+func (e TL_pageBlockPreformatted) isPageBlock() {}
+
 // Encoding TL_pageBlockPreformatted
 func (e TL_pageBlockPreformatted) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10278,9 +12726,11 @@ func (e TL_pageBlockPreformatted) encode() []byte {
 const crc_pageBlockFooter = 0x48870999
 
 type TL_pageBlockFooter struct {
-	Text TL // text:RichText
+	Text RichText // text:RichText
 }
 
+func (e TL_pageBlockFooter) isPageBlock() {}
+
 // Encoding TL_pageBlockFooter
 func (e TL_pageBlockFooter) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10296,6 +12746,8 @@ const crc_pageBlockDivider = 0xdb20b188
 type TL_pageBlockDivider struct {
 }
 
+func (e TL_pageBlockDivider) isPageBlock() {}
+
 // Encoding TL_pageBlockDivider
 func (e TL_pageBlockDivider) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10311,6 +12763,8 @@ type TL_pageBlockAnchor struct {
 	Name string // name:string
 }
 
+func (e TL_pageBlockAnchor) isPageBlock() {}
+
 // Encoding TL_pageBlockAnchor
 func (e TL_pageBlockAnchor) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10324,16 +12778,22 @@ func (e TL_pageBlockAnchor) encode() []byte {
 const crc_pageBlockList = 0x3a58c7f4
 
 type TL_pageBlockList struct {
-	Ordered TL   // ordered:Bool
-	Items   []TL // items:Vector<RichText>
+	Ordered Bool       // ordered:Bool
+	Items   []RichText // items:Vector<RichText>
 }
 
+func (e TL_pageBlockList) isPageBlock() {}
+
 // Encoding TL_pageBlockList
 func (e TL_pageBlockList) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_pageBlockList)
 	x.Bytes(e.Ordered.encode())
-	x.Vector(e.Items)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Items)))
+	for _, v := range e.Items {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -10342,10 +12802,12 @@ func (e TL_pageBlockList) encode() []byte {
 const crc_pageBlockBlockquote = 0x263d7c26
 
 type TL_pageBlockBlockquote struct {
-	Text    TL // text:RichText
-	Caption TL // caption:RichText
+	Text    RichText // text:RichText
+	Caption RichText // caption:RichText
 }
 
+func (e TL_pageBlockBlockquote) isPageBlock() {}
+
 // Encoding TL_pageBlockBlockquote
 func (e TL_pageBlockBlockquote) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10360,10 +12822,12 @@ func (e TL_pageBlockBlockquote) encode() []byte {
 const crc_pageBlockPullquote = 0x4f4456d3
 
 type TL_pageBlockPullquote struct {
-	Text    TL // text:RichText
-	Caption TL // caption:RichText
+	Text    RichText // text:RichText
+	Caption RichText // caption:RichText
 }
 
+func (e TL_pageBlockPullquote) isPageBlock() {}
+
 // Encoding TL_pageBlockPullquote
 func (e TL_pageBlockPullquote) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10378,10 +12842,12 @@ func (e TL_pageBlockPullquote) encode() []byte {
 const crc_pageBlockPhoto = 0xe9c69982
 
 type TL_pageBlockPhoto struct {
-	Photo_id int64 // photo_id:long
-	Caption  TL    // caption:RichText
+	Photo_id int64    // photo_id:long
+	Caption  RichText // caption:RichText
 }
 
+func (e TL_pageBlockPhoto) isPageBlock() {}
+
 // Encoding TL_pageBlockPhoto
 func (e TL_pageBlockPhoto) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10397,12 +12863,14 @@ const crc_pageBlockVideo = 0xd9d71866
 
 type TL_pageBlockVideo struct {
 	Flags    int32
-	Autoplay bool  // autoplay:flags.0?true
-	Loop     bool  // loop:flags.1?true
-	Video_id int64 // video_id:long
-	Caption  TL    // caption:RichText
+	Autoplay bool     // autoplay:flags.0?true
+	Loop     bool     // loop:flags.1?true
+	Video_id int64    // video_id:long
+	Caption  RichText // caption:RichText
 }
 
+func (e TL_pageBlockVideo) isPageBlock() {}
+
 // Encoding TL_pageBlockVideo
 func (e TL_pageBlockVideo) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10425,9 +12893,11 @@ func (e TL_pageBlockVideo) encode() []byte {
 const crc_pageBlockCover = 0x39f23300
 
 type TL_pageBlockCover struct {
-	Cover TL // cover:PageBlock
+	Cover PageBlock // cover:PageBlock
 }
 
+func (e TL_pageBlockCover) isPageBlock() {}
+
 // Encoding TL_pageBlockCover
 func (e TL_pageBlockCover) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10442,16 +12912,18 @@ const crc_pageBlockEmbed = 0xcde200d1
 
 type TL_pageBlockEmbed struct {
 	Flags           int32
-	Full_width      bool   // full_width:flags.0?true
-	Allow_scrolling bool   // allow_scrolling:flags.3?true
-	Url             string // url:flags.1?string
-	Html            string // html:flags.2?string
-	Poster_photo_id int64  // poster_photo_id:flags.4?long
-	W               int32  // w:int
-	H               int32  // h:int
-	Caption         TL     // caption:RichText
+	Full_width      bool     // full_width:flags.0?true
+	Allow_scrolling bool     // allow_scrolling:flags.3?true
+	Url             string   // url:flags.1?string
+	Html            string   // html:flags.2?string
+	Poster_photo_id int64    // poster_photo_id:flags.4?long
+	W               int32    // w:int
+	H               int32    // h:int
+	Caption         RichText // caption:RichText
 }
 
+func (e TL_pageBlockEmbed) isPageBlock() {}
+
 // Encoding TL_pageBlockEmbed
 func (e TL_pageBlockEmbed) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10493,15 +12965,17 @@ func (e TL_pageBlockEmbed) encode() []byte {
 const crc_pageBlockEmbedPost = 0x292c7be9
 
 type TL_pageBlockEmbedPost struct {
-	Url             string // url:string
-	Webpage_id      int64  // webpage_id:long
-	Author_photo_id int64  // author_photo_id:long
-	Author          string // author:string
-	Date            int32  // date:int
-	Blocks          []TL   // blocks:Vector<PageBlock>
-	Caption         TL     // caption:RichText
+	Url             string      // url:string
+	Webpage_id      int64       // webpage_id:long
+	Author_photo_id int64       // author_photo_id:long
+	Author          string      // author:string
+	Date            int32       // date:int
+	Blocks          []PageBlock // blocks:Vector<PageBlock>
+	Caption         RichText    // caption:RichText
 }
 
+func (e TL_pageBlockEmbedPost) isPageBlock() {}
+
 // Encoding TL_pageBlockEmbedPost
 func (e TL_pageBlockEmbedPost) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10511,7 +12985,11 @@ func (e TL_pageBlockEmbedPost) encode() []byte {
 	x.Long(e.Author_photo_id)
 	x.String(e.Author)
 	x.Int(e.Date)
-	x.Vector(e.Blocks)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Blocks)))
+	for _, v := range e.Blocks {
+		x.Bytes(v.encode())
+	}
 	x.Bytes(e.Caption.encode())
 	return x.buf
 }
@@ -10521,15 +12999,21 @@ func (e TL_pageBlockEmbedPost) encode() []byte {
 const crc_pageBlockCollage = 0x8b31c4f
 
 type TL_pageBlockCollage struct {
-	Items   []TL // items:Vector<PageBlock>
-	Caption TL   // caption:RichText
+	Items   []PageBlock // items:Vector<PageBlock>
+	Caption RichText    // caption:RichText
 }
 
+func (e TL_pageBlockCollage) isPageBlock() {}
+
 // Encoding TL_pageBlockCollage
 func (e TL_pageBlockCollage) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_pageBlockCollage)
-	x.Vector(e.Items)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Items)))
+	for _, v := range e.Items {
+		x.Bytes(v.encode())
+	}
 	x.Bytes(e.Caption.encode())
 	return x.buf
 }
@@ -10539,36 +13023,62 @@ func (e TL_pageBlockCollage) encode() []byte {
 const crc_pageBlockSlideshow = 0x130c8963
 
 type TL_pageBlockSlideshow struct {
-	Items   []TL // items:Vector<PageBlock>
-	Caption TL   // caption:RichText
+	Items   []PageBlock // items:Vector<PageBlock>
+	Caption RichText    // caption:RichText
 }
 
+func (e TL_pageBlockSlideshow) isPageBlock() {}
+
 // Encoding TL_pageBlockSlideshow
 func (e TL_pageBlockSlideshow) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_pageBlockSlideshow)
-	x.Vector(e.Items)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Items)))
+	for _, v := range e.Items {
+		x.Bytes(v.encode())
+	}
 	x.Bytes(e.Caption.encode())
 	return x.buf
 }
 
+// Page is implemented by constructors of Page type
+type Page interface {
+	TL
+	isPage()
+}
+
 // pagePart#8dee6c44 blocks:Vector<PageBlock> photos:Vector<Photo> videos:Vector<Document> = Page;
 
 const crc_pagePart = 0x8dee6c44
 
 type TL_pagePart struct {
-	Blocks []TL // blocks:Vector<PageBlock>
-	Photos []TL // photos:Vector<Photo>
-	Videos []TL // videos:Vector<Document>
+	Blocks []PageBlock // blocks:Vector<PageBlock>
+	Photos []Photo     // photos:Vector<Photo>
+	Videos []Document  // videos:Vector<Document>
 }
 
+func (e TL_pagePart) isPage() {}
+
 // Encoding TL_pagePart
 func (e TL_pagePart) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_pagePart)
-	x.Vector(e.Blocks)
-	x.Vector(e.Photos)
-	x.Vector(e.Videos)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Blocks)))
+	for _, v := range e.Blocks {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Photos)))
+	for _, v := range e.Photos {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Videos)))
+	for _, v := range e.Videos {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -10577,21 +13087,41 @@ func (e TL_pagePart) encode() []byte {
 const crc_pageFull = 0xd7a19d69
 
 type TL_pageFull struct {
-	Blocks []TL // blocks:Vector<PageBlock>
-	Photos []TL // photos:Vector<Photo>
-	Videos []TL // videos:Vector<Document>
+	Blocks []PageBlock // blocks:Vector<PageBlock>
+	Photos []Photo     // photos:Vector<Photo>
+	Videos []Document  // videos:Vector<Document>
 }
 
+func (e TL_pageFull) isPage() {}
+
 // Encoding TL_pageFull
 func (e TL_pageFull) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_pageFull)
-	x.Vector(e.Blocks)
-	x.Vector(e.Photos)
-	x.Vector(e.Videos)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Blocks)))
+	for _, v := range e.Blocks {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Photos)))
+	for _, v := range e.Photos {
+		x.Bytes(v.encode())
+	}
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Videos)))
+	for _, v := range e.Videos {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// PhoneCallDiscardReason is implemented by constructors of PhoneCallDiscardReason type
+type PhoneCallDiscardReason interface {
+	TL
+	isPhoneCallDiscardReason()
+}
+
 // phoneCallDiscardReasonMissed#85e42301 = PhoneCallDiscardReason;
 
 const crc_phoneCallDiscardReasonMissed = 0x85e42301
@@ -10599,6 +13129,8 @@ const crc_phoneCallDiscardReasonMissed = 0x85e42301
 type TL_phoneCallDiscardReasonMissed struct {
 }
 
+func (e TL_phoneCallDiscardReasonMissed) isPhoneCallDiscardReason() {}
+
 // Encoding TL_phoneCallDiscardReasonMissed
 func (e TL_phoneCallDiscardReasonMissed) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10613,6 +13145,8 @@ const crc_phoneCallDiscardReasonDisconnect = 0xe095c1a0
 type TL_phoneCallDiscardReasonDisconnect struct {
 }
 
+func (e TL_phoneCallDiscardReasonDisconnect) isPhoneCallDiscardReason() {}
+
 // Encoding TL_phoneCallDiscardReasonDisconnect
 func (e TL_phoneCallDiscardReasonDisconnect) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10627,6 +13161,8 @@ const crc_phoneCallDiscardReasonHangup = 0x57adc690
 type TL_phoneCallDiscardReasonHangup struct {
 }
 
+func (e TL_phoneCallDiscardReasonHangup) isPhoneCallDiscardReason() {}
+
 // Encoding TL_phoneCallDiscardReasonHangup
 func (e TL_phoneCallDiscardReasonHangup) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10641,6 +13177,8 @@ const crc_phoneCallDiscardReasonBusy = 0xfaf7e8c9
 type TL_phoneCallDiscardReasonBusy struct {
 }
 
+func (e TL_phoneCallDiscardReasonBusy) isPhoneCallDiscardReason() {}
+
 // Encoding TL_phoneCallDiscardReasonBusy
 func (e TL_phoneCallDiscardReasonBusy) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10648,6 +13186,12 @@ func (e TL_phoneCallDiscardReasonBusy) encode() []byte {
 	return x.buf
 }
 
+// DataJSON is implemented by constructors of DataJSON type
+type DataJSON interface {
+	TL
+	isDataJSON()
+}
+
 // dataJSON#7d748d04 data:string = DataJSON;
 
 const crc_dataJSON = 0x7d748d04
@@ -10656,6 +13200,8 @@ type TL_dataJSON struct {
 	Data string // data:string
 }
 
+func (e TL_dataJSON) isDataJSON() {}
+
 // Encoding TL_dataJSON
 func (e TL_dataJSON) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10664,6 +13210,12 @@ func (e TL_dataJSON) encode() []byte {
 	return x.buf
 }
 
+// LabeledPrice is implemented by constructors of LabeledPrice type
+type LabeledPrice interface {
+	TL
+	isLabeledPrice()
+}
+
 // labeledPrice#cb296bf8 label:string amount:long = LabeledPrice;
 
 const crc_labeledPrice = 0xcb296bf8
@@ -10673,6 +13225,8 @@ type TL_labeledPrice struct {
 	Amount int64  // amount:long
 }
 
+func (e TL_labeledPrice) isLabeledPrice() {}
+
 // Encoding TL_labeledPrice
 func (e TL_labeledPrice) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10682,22 +13236,30 @@ func (e TL_labeledPrice) encode() []byte {
 	return x.buf
 }
 
+// Invoice is implemented by constructors of Invoice type
+type Invoice interface {
+	TL
+	isInvoice()
+}
+
 // invoice#c30aa358 flags:# test:flags.0?true name_requested:flags.1?true phone_requested:flags.2?true email_requested:flags.3?true shipping_address_requested:flags.4?true flexible:flags.5?true currency:string prices:Vector<LabeledPrice> = Invoice;
 
 const crc_invoice = 0xc30aa358
 
 type TL_invoice struct {
 	Flags                      int32
-	Test                       bool   // test:flags.0?true
-	Name_requested             bool   // name_requested:flags.1?true
-	Phone_requested            bool   // phone_requested:flags.2?true
-	Email_requested            bool   // email_requested:flags.3?true
-	Shipping_address_requested bool   // shipping_address_requested:flags.4?true
-	Flexible                   bool   // flexible:flags.5?true
-	Currency                   string // currency:string
-	Prices                     []TL   // prices:Vector<LabeledPrice>
+	Test                       bool           // test:flags.0?true
+	Name_requested             bool           // name_requested:flags.1?true
+	Phone_requested            bool           // phone_requested:flags.2?true
+	Email_requested            bool           // email_requested:flags.3?true
+	Shipping_address_requested bool           // shipping_address_requested:flags.4?true
+	Flexible                   bool           // flexible:flags.5?true
+	Currency                   string         // currency:string
+	Prices                     []LabeledPrice // prices:Vector<LabeledPrice>
 }
 
+func (e TL_invoice) isInvoice() {}
+
 // Encoding TL_invoice
 func (e TL_invoice) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10723,10 +13285,20 @@ func (e TL_invoice) encode() []byte {
 	}
 	x.Int(flags)
 	x.String(e.Currency)
-	x.Vector(e.Prices)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Prices)))
+	for _, v := range e.Prices {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// PaymentCharge is implemented by constructors of PaymentCharge type
+type PaymentCharge interface {
+	TL
+	isPaymentCharge()
+}
+
 // paymentCharge#ea02c27e Id:string provider_charge_id:string = PaymentCharge;
 
 const crc_paymentCharge = 0xea02c27e
@@ -10736,6 +13308,8 @@ type TL_paymentCharge struct {
 	Provider_charge_id string // provider_charge_id:string
 }
 
+func (e TL_paymentCharge) isPaymentCharge() {}
+
 // Encoding TL_paymentCharge
 func (e TL_paymentCharge) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10745,6 +13319,12 @@ func (e TL_paymentCharge) encode() []byte {
 	return x.buf
 }
 
+// PostAddress is implemented by constructors of PostAddress type
+type PostAddress interface {
+	TL
+	isPostAddress()
+}
+
 // postAddress#1e8caaeb street_line1:string street_line2:string city:string state:string country_iso2:string post_code:string = PostAddress;
 
 const crc_postAddress = 0x1e8caaeb
@@ -10758,6 +13338,8 @@ type TL_postAddress struct {
 	Post_code    string // post_code:string
 }
 
+func (e TL_postAddress) isPostAddress() {}
+
 // Encoding TL_postAddress
 func (e TL_postAddress) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10771,18 +13353,26 @@ func (e TL_postAddress) encode() []byte {
 	return x.buf
 }
 
+// PaymentRequestedInfo is implemented by constructors of PaymentRequestedInfo type
+type PaymentRequestedInfo interface {
+	TL
+	isPaymentRequestedInfo()
+}
+
 // paymentRequestedInfo#909c3f94 flags:# name:flags.0?string phone:flags.1?string email:flags.2?string shipping_address:flags.3?PostAddress = PaymentRequestedInfo;
 
 const crc_paymentRequestedInfo = 0x909c3f94
 
 type TL_paymentRequestedInfo struct {
 	Flags            int32
-	Name             string // name:flags.0?string
-	Phone            string // phone:flags.1?string
-	Email            string // email:flags.2?string
-	Shipping_address TL     // shipping_address:flags.3?PostAddress
+	Name             string      // name:flags.0?string
+	Phone            string      // phone:flags.1?string
+	Email            string      // email:flags.2?string
+	Shipping_address PostAddress // shipping_address:flags.3?PostAddress
 }
 
+func (e TL_paymentRequestedInfo) isPaymentRequestedInfo() {}
+
 // Encoding TL_paymentRequestedInfo
 func (e TL_paymentRequestedInfo) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10797,7 +13387,7 @@ func (e TL_paymentRequestedInfo) encode() []byte {
 	if e.Email != "" {
 		flags |= (1 << 2)
 	}
-	if _, ok := (e.Shipping_address).(TL_null); !ok {
+	if e.Shipping_address != nil {
 		flags |= (1 << 3)
 	}
 	x.Int(flags)
@@ -10816,6 +13406,12 @@ func (e TL_paymentRequestedInfo) encode() []byte {
 	return x.buf
 }
 
+// PaymentSavedCredentials is implemented by constructors of PaymentSavedCredentials type
+type PaymentSavedCredentials interface {
+	TL
+	isPaymentSavedCredentials()
+}
+
 // paymentSavedCredentialsCard#cdc27a1f Id:string title:string = PaymentSavedCredentials;
 
 const crc_paymentSavedCredentialsCard = 0xcdc27a1f
@@ -10825,6 +13421,8 @@ type TL_paymentSavedCredentialsCard struct {
 	Title string // title:string
 }
 
+func (e TL_paymentSavedCredentialsCard) isPaymentSavedCredentials() {}
+
 // Encoding TL_paymentSavedCredentialsCard
 func (e TL_paymentSavedCredentialsCard) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10834,19 +13432,27 @@ func (e TL_paymentSavedCredentialsCard) encode() []byte {
 	return x.buf
 }
 
+// WebDocument is implemented by constructors of WebDocument type
+type WebDocument interface {
+	TL
+	isWebDocument()
+}
+
 // webDocument#c61acbd8 url:string access_hash:long size:int mime_type:string attributes:Vector<DocumentAttribute> dc_id:int = WebDocument;
 
 const crc_webDocument = 0xc61acbd8
 
 type TL_webDocument struct {
-	Url         string // url:string
-	Access_hash int64  // access_hash:long
-	Size        int32  // size:int
-	Mime_type   string // mime_type:string
-	Attributes  []TL   // attributes:Vector<DocumentAttribute>
-	Dc_id       int32  // dc_id:int
+	Url         string              // url:string
+	Access_hash int64               // access_hash:long
+	Size        int32               // size:int
+	Mime_type   string              // mime_type:string
+	Attributes  []DocumentAttribute // attributes:Vector<DocumentAttribute>
+	Dc_id       int32               // dc_id:int
 }
 
+func (e TL_webDocument) isWebDocument() {}
+
 // Encoding TL_webDocument
 func (e TL_webDocument) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10855,22 +13461,34 @@ func (e TL_webDocument) encode() []byte {
 	x.Long(e.Access_hash)
 	x.Int(e.Size)
 	x.String(e.Mime_type)
-	x.Vector(e.Attributes)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Attributes)))
+	for _, v := range e.Attributes {
+		x.Bytes(v.encode())
+	}
 	x.Int(e.Dc_id)
 	return x.buf
 }
 
+// InputWebDocument is implemented by constructors of InputWebDocument type
+type InputWebDocument interface {
+	TL
+	isInputWebDocument()
+}
+
 // inputWebDocument#9bed434d url:string size:int mime_type:string attributes:Vector<DocumentAttribute> = InputWebDocument;
 
 const crc_inputWebDocument = 0x9bed434d
 
 type TL_inputWebDocument struct {
-	Url        string // url:string
-	Size       int32  // size:int
-	Mime_type  string // mime_type:string
-	Attributes []TL   // attributes:Vector<DocumentAttribute>
+	Url        string              // url:string
+	Size       int32               // size:int
+	Mime_type  string              // mime_type:string
+	Attributes []DocumentAttribute // attributes:Vector<DocumentAttribute>
 }
 
+func (e TL_inputWebDocument) isInputWebDocument() {}
+
 // Encoding TL_inputWebDocument
 func (e TL_inputWebDocument) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10878,10 +13496,20 @@ func (e TL_inputWebDocument) encode() []byte {
 	x.String(e.Url)
 	x.Int(e.Size)
 	x.String(e.Mime_type)
-	x.Vector(e.Attributes)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Attributes)))
+	for _, v := range e.Attributes {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// InputWebFileLocation is implemented by constructors of InputWebFileLocation type
+type InputWebFileLocation interface {
+	TL
+	isInputWebFileLocation()
+}
+
 // inputWebFileLocation#c239d686 url:string access_hash:long = InputWebFileLocation;
 
 const crc_inputWebFileLocation = 0xc239d686
@@ -10891,6 +13519,8 @@ type TL_inputWebFileLocation struct {
 	Access_hash int64  // access_hash:long
 }
 
+func (e TL_inputWebFileLocation) isInputWebFileLocation() {}
+
 // Encoding TL_inputWebFileLocation
 func (e TL_inputWebFileLocation) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10900,18 +13530,26 @@ func (e TL_inputWebFileLocation) encode() []byte {
 	return x.buf
 }
 
+// Upload_WebFile is implemented by constructors of upload.WebFile type
+type Upload_WebFile interface {
+	TL
+	isUpload_WebFile()
+}
+
 // upload.webFile#21e753bc size:int mime_type:string file_type:storage.FileType mtime:int bytes:bytes = upload.WebFile;
 
 const crc_upload_webFile = 0x21e753bc
 
 type TL_upload_webFile struct {
-	Size      int32  // size:int
-	Mime_type string // mime_type:string
-	File_type TL     // file_type:storage.FileType
-	Mtime     int32  // mtime:int
-	Bytes     []byte // bytes:bytes
+	Size      int32            // size:int
+	Mime_type string           // mime_type:string
+	File_type Storage_FileType // file_type:storage.FileType
+	Mtime     int32            // mtime:int
+	Bytes     []byte           // bytes:bytes
 }
 
+func (e TL_upload_webFile) isUpload_WebFile() {}
+
 // Encoding TL_upload_webFile
 func (e TL_upload_webFile) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10920,29 +13558,37 @@ func (e TL_upload_webFile) encode() []byte {
 	x.String(e.Mime_type)
 	x.Bytes(e.File_type.encode())
 	x.Int(e.Mtime)
-	x.Bytes(e.Bytes)
+	x.StringBytes(e.Bytes)
 	return x.buf
 }
 
+// Payments_PaymentForm is implemented by constructors of payments.PaymentForm type
+type Payments_PaymentForm interface {
+	TL
+	isPayments_PaymentForm()
+}
+
 // payments.paymentForm#3f56aea3 flags:# can_save_credentials:flags.2?true password_missing:flags.3?true bot_id:int invoice:Invoice provider_id:int url:string native_provider:flags.4?string native_params:flags.4?DataJSON saved_info:flags.0?PaymentRequestedInfo saved_credentials:flags.1?PaymentSavedCredentials users:Vector<User> = payments.PaymentForm;
 
 const crc_payments_paymentForm = 0x3f56aea3
 
 type TL_payments_paymentForm struct {
 	Flags                int32
-	Can_save_credentials bool   // can_save_credentials:flags.2?true
-	Password_missing     bool   // password_missing:flags.3?true
-	Bot_id               int32  // bot_id:int
-	Invoice              TL     // invoice:Invoice
-	Provider_id          int32  // provider_id:int
-	Url                  string // url:string
-	Native_provider      string // native_provider:flags.4?string
-	Native_params        TL     // native_params:flags.4?DataJSON
-	Saved_info           TL     // saved_info:flags.0?PaymentRequestedInfo
-	Saved_credentials    TL     // saved_credentials:flags.1?PaymentSavedCredentials
-	Users                []TL   // users:Vector<User>
+	Can_save_credentials bool                    // can_save_credentials:flags.2?true
+	Password_missing     bool                    // password_missing:flags.3?true
+	Bot_id               int32                   // bot_id:int
+	Invoice              Invoice                 // invoice:Invoice
+	Provider_id          int32                   // provider_id:int
+	Url                  string                  // url:string
+	Native_provider      string                  // native_provider:flags.4?string
+	Native_params        DataJSON                // native_params:flags.4?DataJSON
+	Saved_info           PaymentRequestedInfo    // saved_info:flags.0?PaymentRequestedInfo
+	Saved_credentials    PaymentSavedCredentials // saved_credentials:flags.1?PaymentSavedCredentials
+	Users                []User                  // users:Vector<User>
 }
 
+func (e TL_payments_paymentForm) isPayments_PaymentForm() {}
+
 // Encoding TL_payments_paymentForm
 func (e TL_payments_paymentForm) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -10957,13 +13603,13 @@ func (e TL_payments_paymentForm) encode() []byte {
 	if e.Native_provider != "" {
 		flags |= (1 << 4)
 	}
-	if _, ok := (e.Native_params).(TL_null); !ok {
+	if e.Native_params != nil {
 		flags |= (1 << 4)
 	}
-	if _, ok := (e.Saved_info).(TL_null); !ok {
+	if e.Saved_info != nil {
 		flags |= (1 << 0)
 	}
-	if _, ok := (e.Saved_credentials).(TL_null); !ok {
+	if e.Saved_credentials != nil {
 		flags |= (1 << 1)
 	}
 	x.Int(flags)
@@ -10983,20 +13629,32 @@ func (e TL_payments_paymentForm) encode() []byte {
 	if flags&(1<<1) != 0 {
 		x.Bytes(e.Saved_credentials.encode())
 	}
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Payments_ValidatedRequestedInfo is implemented by constructors of payments.ValidatedRequestedInfo type
+type Payments_ValidatedRequestedInfo interface {
+	TL
+	isPayments_ValidatedRequestedInfo()
+}
+
 // payments.validatedRequestedInfo#d1451883 flags:# Id:flags.0?string shipping_options:flags.1?Vector<ShippingOption> = payments.ValidatedRequestedInfo;
 
 const crc_payments_validatedRequestedInfo = 0xd1451883
 
 type TL_payments_validatedRequestedInfo struct {
 	Flags            int32
-	Id               string // Id:flags.0?string
-	Shipping_options []TL   // shipping_options:flags.1?Vector<ShippingOption>
+	Id               string           // Id:flags.0?string
+	Shipping_options []ShippingOption // shipping_options:flags.1?Vector<ShippingOption>
 }
 
+func (e TL_payments_validatedRequestedInfo) isPayments_ValidatedRequestedInfo() {}
+
 // Encoding TL_payments_validatedRequestedInfo
 func (e TL_payments_validatedRequestedInfo) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11013,19 +13671,31 @@ func (e TL_payments_validatedRequestedInfo) encode() []byte {
 		x.String(e.Id)
 	}
 	if flags&(1<<1) != 0 {
-		x.Vector(e.Shipping_options)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Shipping_options)))
+		for _, v := range e.Shipping_options {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
 
+// Payments_PaymentResult is implemented by constructors of payments.PaymentResult type
+type Payments_PaymentResult interface {
+	TL
+	isPayments_PaymentResult()
+}
+
 // payments.paymentResult#4e5f810d updates:Updates = payments.PaymentResult;
 
 const crc_payments_paymentResult = 0x4e5f810d
 
 type TL_payments_paymentResult struct {
-	Updates TL // updates:Updates
+	Updates Updates // updates:Updates
 }
 
+func (e TL_payments_paymentResult) isPayments_PaymentResult() {}
+
 // Encoding TL_payments_paymentResult
 func (e TL_payments_paymentResult) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11042,6 +13712,8 @@ type TL_payments_paymentVerficationNeeded struct {
 	Url string // url:string
 }
 
+func (e TL_payments_paymentVerficationNeeded) isPayments_PaymentResult() {}
+
 // Encoding TL_payments_paymentVerficationNeeded
 func (e TL_payments_paymentVerficationNeeded) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11050,33 +13722,41 @@ func (e TL_payments_paymentVerficationNeeded) encode() []byte {
 	return x.buf
 }
 
+// Payments_PaymentReceipt is implemented by constructors of payments.PaymentReceipt type
+type Payments_PaymentReceipt interface {
+	TL
+	isPayments_PaymentReceipt()
+}
+
 // payments.paymentReceipt#500911e1 flags:# date:int bot_id:int invoice:Invoice provider_id:int info:flags.0?PaymentRequestedInfo shipping:flags.1?ShippingOption currency:string total_amount:long credentials_title:string users:Vector<User> = payments.PaymentReceipt;
 
 const crc_payments_paymentReceipt = 0x500911e1
 
 type TL_payments_paymentReceipt struct {
 	Flags             int32
-	Date              int32  // date:int
-	Bot_id            int32  // bot_id:int
-	Invoice           TL     // invoice:Invoice
-	Provider_id       int32  // provider_id:int
-	Info              TL     // info:flags.0?PaymentRequestedInfo
-	Shipping          TL     // shipping:flags.1?ShippingOption
-	Currency          string // currency:string
-	Total_amount      int64  // total_amount:long
-	Credentials_title string // credentials_title:string
-	Users             []TL   // users:Vector<User>
+	Date              int32                // date:int
+	Bot_id            int32                // bot_id:int
+	Invoice           Invoice              // invoice:Invoice
+	Provider_id       int32                // provider_id:int
+	Info              PaymentRequestedInfo // info:flags.0?PaymentRequestedInfo
+	Shipping          ShippingOption       // shipping:flags.1?ShippingOption
+	Currency          string               // currency:string
+	Total_amount      int64                // total_amount:long
+	Credentials_title string               // credentials_title:string
+	Users             []User               // users:Vector<User>
 }
 
+func (e TL_payments_paymentReceipt) isPayments_PaymentReceipt() {}
+
 // Encoding TL_payments_paymentReceipt
 func (e TL_payments_paymentReceipt) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_payments_paymentReceipt)
 	var flags int32
-	if _, ok := (e.Info).(TL_null); !ok {
+	if e.Info != nil {
 		flags |= (1 << 0)
 	}
-	if _, ok := (e.Shipping).(TL_null); !ok {
+	if e.Shipping != nil {
 		flags |= (1 << 1)
 	}
 	x.Int(flags)
@@ -11093,20 +13773,32 @@ func (e TL_payments_paymentReceipt) encode() []byte {
 	x.String(e.Currency)
 	x.Long(e.Total_amount)
 	x.String(e.Credentials_title)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// Payments_SavedInfo is implemented by constructors of payments.SavedInfo type
+type Payments_SavedInfo interface {
+	TL
+	isPayments_SavedInfo()
+}
+
 // payments.savedInfo#fb8fe43c flags:# has_saved_credentials:flags.1?true saved_info:flags.0?PaymentRequestedInfo = payments.SavedInfo;
 
 const crc_payments_savedInfo = 0xfb8fe43c
 
 type TL_payments_savedInfo struct {
 	Flags                 int32
-	Has_saved_credentials bool // has_saved_credentials:flags.1?true
-	Saved_info            TL   // saved_info:flags.0?PaymentRequestedInfo
+	Has_saved_credentials bool                 // has_saved_credentials:flags.1?true
+	Saved_info            PaymentRequestedInfo // saved_info:flags.0?PaymentRequestedInfo
 }
 
+func (e TL_payments_savedInfo) isPayments_SavedInfo() {}
+
 // Encoding TL_payments_savedInfo
 func (e TL_payments_savedInfo) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11115,7 +13807,7 @@ func (e TL_payments_savedInfo) encode() []byte {
 	if e.Has_saved_credentials {
 		flags |= (1 << 1)
 	}
-	if _, ok := (e.Saved_info).(TL_null); !ok {
+	if e.Saved_info != nil {
 		flags |= (1 << 0)
 	}
 	x.Int(flags)
@@ -11125,6 +13817,12 @@ func (e TL_payments_savedInfo) encode() []byte {
 	return x.buf
 }
 
+// InputPaymentCredentials is implemented by constructors of InputPaymentCredentials type
+type InputPaymentCredentials interface {
+	TL
+	isInputPaymentCredentials()
+}
+
 // inputPaymentCredentialsSaved#c10eb2cf Id:string tmp_password:bytes = InputPaymentCredentials;
 
 const crc_inputPaymentCredentialsSaved = 0xc10eb2cf
@@ -11134,12 +13832,14 @@ type TL_inputPaymentCredentialsSaved struct {
 	Tmp_password []byte // tmp_password:bytes
 }
 
+func (e TL_inputPaymentCredentialsSaved) isInputPaymentCredentials() {}
+
 // Encoding TL_inputPaymentCredentialsSaved
 func (e TL_inputPaymentCredentialsSaved) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_inputPaymentCredentialsSaved)
 	x.String(e.Id)
-	x.Bytes(e.Tmp_password)
+	x.StringBytes(e.Tmp_password)
 	return x.buf
 }
 
@@ -11149,10 +13849,12 @@ const crc_inputPaymentCredentials = 0x3417d728
 
 type TL_inputPaymentCredentials struct {
 	Flags int32
-	Save  bool // save:flags.0?true
-	Data  TL   // data:DataJSON
+	Save  bool     // save:flags.0?true
+	Data  DataJSON // data:DataJSON
 }
 
+func (e TL_inputPaymentCredentials) isInputPaymentCredentials() {}
+
 // Encoding TL_inputPaymentCredentials
 func (e TL_inputPaymentCredentials) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11166,6 +13868,12 @@ func (e TL_inputPaymentCredentials) encode() []byte {
 	return x.buf
 }
 
+// Account_TmpPassword is implemented by constructors of account.TmpPassword type
+type Account_TmpPassword interface {
+	TL
+	isAccount_TmpPassword()
+}
+
 // account.tmpPassword#db64fd34 tmp_password:bytes valid_until:int = account.TmpPassword;
 
 const crc_account_tmpPassword = 0xdb64fd34
@@ -11175,35 +13883,55 @@ type TL_account_tmpPassword struct {
 	Valid_until  int32  // valid_until:int
 }
 
+func (e TL_account_tmpPassword) isAccount_TmpPassword() {}
+
 // Encoding TL_account_tmpPassword
 func (e TL_account_tmpPassword) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_account_tmpPassword)
-	x.Bytes(e.Tmp_password)
+	x.StringBytes(e.Tmp_password)
 	x.Int(e.Valid_until)
 	return x.buf
 }
 
+// ShippingOption is implemented by constructors of ShippingOption type
+type ShippingOption interface {
+	TL
+	isShippingOption()
+}
+
 // shippingOption#b6213cdf Id:string title:string prices:Vector<LabeledPrice> = ShippingOption;
 
 const crc_shippingOption = 0xb6213cdf
 
 type TL_shippingOption struct {
-	Id     string // Id:string
-	Title  string // title:string
-	Prices []TL   // prices:Vector<LabeledPrice>
+	Id     string         // Id:string
+	Title  string         // title:string
+	Prices []LabeledPrice // prices:Vector<LabeledPrice>
 }
 
+func (e TL_shippingOption) isShippingOption() {}
+
 // Encoding TL_shippingOption
 func (e TL_shippingOption) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_shippingOption)
 	x.String(e.Id)
 	x.String(e.Title)
-	x.Vector(e.Prices)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Prices)))
+	for _, v := range e.Prices {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
+// InputPhoneCall is implemented by constructors of InputPhoneCall type
+type InputPhoneCall interface {
+	TL
+	isInputPhoneCall()
+}
+
 // inputPhoneCall#1e36fded Id:long access_hash:long = InputPhoneCall;
 
 const crc_inputPhoneCall = 0x1e36fded
@@ -11213,6 +13941,8 @@ type TL_inputPhoneCall struct {
 	Access_hash int64 // access_hash:long
 }
 
+func (e TL_inputPhoneCall) isInputPhoneCall() {}
+
 // Encoding TL_inputPhoneCall
 func (e TL_inputPhoneCall) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11222,6 +13952,12 @@ func (e TL_inputPhoneCall) encode() []byte {
 	return x.buf
 }
 
+// PhoneCall is implemented by constructors of PhoneCall type
+type PhoneCall interface {
+	TL
+	isPhoneCall()
+}
+
 // phoneCallEmpty#5366c915 Id:long = PhoneCall;
 
 const crc_phoneCallEmpty = 0x5366c915
@@ -11230,6 +13966,8 @@ type TL_phoneCallEmpty struct {
 	Id int64 // Id:long
 }
 
+func (e TL_phoneCallEmpty) isPhoneCall() {}
+
 // Encoding TL_phoneCallEmpty
 func (e TL_phoneCallEmpty) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11244,15 +13982,17 @@ const crc_phoneCallWaiting = 0x1b8f4ad1
 
 type TL_phoneCallWaiting struct {
 	Flags          int32
-	Id             int64 // Id:long
-	Access_hash    int64 // access_hash:long
-	Date           int32 // date:int
-	Admin_id       int32 // admin_id:int
-	Participant_id int32 // participant_id:int
-	Protocol       TL    // protocol:PhoneCallProtocol
-	Receive_date   int32 // receive_date:flags.0?int
+	Id             int64             // Id:long
+	Access_hash    int64             // access_hash:long
+	Date           int32             // date:int
+	Admin_id       int32             // admin_id:int
+	Participant_id int32             // participant_id:int
+	Protocol       PhoneCallProtocol // protocol:PhoneCallProtocol
+	Receive_date   int32             // receive_date:flags.0?int
 }
 
+func (e TL_phoneCallWaiting) isPhoneCall() {}
+
 // Encoding TL_phoneCallWaiting
 func (e TL_phoneCallWaiting) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11279,15 +14019,17 @@ func (e TL_phoneCallWaiting) encode() []byte {
 const crc_phoneCallRequested = 0x83761ce4
 
 type TL_phoneCallRequested struct {
-	Id             int64  // Id:long
-	Access_hash    int64  // access_hash:long
-	Date           int32  // date:int
-	Admin_id       int32  // admin_id:int
-	Participant_id int32  // participant_id:int
-	G_a_hash       []byte // g_a_hash:bytes
-	Protocol       TL     // protocol:PhoneCallProtocol
+	Id             int64             // Id:long
+	Access_hash    int64             // access_hash:long
+	Date           int32             // date:int
+	Admin_id       int32             // admin_id:int
+	Participant_id int32             // participant_id:int
+	G_a_hash       []byte            // g_a_hash:bytes
+	Protocol       PhoneCallProtocol // protocol:PhoneCallProtocol
 }
 
+func (e TL_phoneCallRequested) isPhoneCall() {}
+
 // Encoding TL_phoneCallRequested
 func (e TL_phoneCallRequested) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11297,7 +14039,7 @@ func (e TL_phoneCallRequested) encode() []byte {
 	x.Int(e.Date)
 	x.Int(e.Admin_id)
 	x.Int(e.Participant_id)
-	x.Bytes(e.G_a_hash)
+	x.StringBytes(e.G_a_hash)
 	x.Bytes(e.Protocol.encode())
 	return x.buf
 }
@@ -11307,15 +14049,17 @@ func (e TL_phoneCallRequested) encode() []byte {
 const crc_phoneCallAccepted = 0x6d003d3f
 
 type TL_phoneCallAccepted struct {
-	Id             int64  // Id:long
-	Access_hash    int64  // access_hash:long
-	Date           int32  // date:int
-	Admin_id       int32  // admin_id:int
-	Participant_id int32  // participant_id:int
-	G_b            []byte // g_b:bytes
-	Protocol       TL     // protocol:PhoneCallProtocol
+	Id             int64             // Id:long
+	Access_hash    int64             // access_hash:long
+	Date           int32             // date:int
+	Admin_id       int32             // admin_id:int
+	Participant_id int32             // participant_id:int
+	G_b            []byte            // g_b:bytes
+	Protocol       PhoneCallProtocol // protocol:PhoneCallProtocol
 }
 
+func (e TL_phoneCallAccepted) isPhoneCall() {}
+
 // Encoding TL_phoneCallAccepted
 func (e TL_phoneCallAccepted) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11325,7 +14069,7 @@ func (e TL_phoneCallAccepted) encode() []byte {
 	x.Int(e.Date)
 	x.Int(e.Admin_id)
 	x.Int(e.Participant_id)
-	x.Bytes(e.G_b)
+	x.StringBytes(e.G_b)
 	x.Bytes(e.Protocol.encode())
 	return x.buf
 }
@@ -11335,19 +14079,21 @@ func (e TL_phoneCallAccepted) encode() []byte {
 const crc_phoneCall = 0xffe6ab67
 
 type TL_phoneCall struct {
-	Id                      int64  // Id:long
-	Access_hash             int64  // access_hash:long
-	Date                    int32  // date:int
-	Admin_id                int32  // admin_id:int
-	Participant_id          int32  // participant_id:int
-	G_a_or_b                []byte // g_a_or_b:bytes
-	Key_fingerprint         int64  // key_fingerprint:long
-	Protocol                TL     // protocol:PhoneCallProtocol
-	Connection              TL     // connection:PhoneConnection
-	Alternative_connections []TL   // alternative_connections:Vector<PhoneConnection>
-	Start_date              int32  // start_date:int
+	Id                      int64             // Id:long
+	Access_hash             int64             // access_hash:long
+	Date                    int32             // date:int
+	Admin_id                int32             // admin_id:int
+	Participant_id          int32             // participant_id:int
+	G_a_or_b                []byte            // g_a_or_b:bytes
+	Key_fingerprint         int64             // key_fingerprint:long
+	Protocol                PhoneCallProtocol // protocol:PhoneCallProtocol
+	Connection              PhoneConnection   // connection:PhoneConnection
+	Alternative_connections []PhoneConnection // alternative_connections:Vector<PhoneConnection>
+	Start_date              int32             // start_date:int
 }
 
+func (e TL_phoneCall) isPhoneCall() {}
+
 // Encoding TL_phoneCall
 func (e TL_phoneCall) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11357,11 +14103,15 @@ func (e TL_phoneCall) encode() []byte {
 	x.Int(e.Date)
 	x.Int(e.Admin_id)
 	x.Int(e.Participant_id)
-	x.Bytes(e.G_a_or_b)
+	x.StringBytes(e.G_a_or_b)
 	x.Long(e.Key_fingerprint)
 	x.Bytes(e.Protocol.encode())
 	x.Bytes(e.Connection.encode())
-	x.Vector(e.Alternative_connections)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Alternative_connections)))
+	for _, v := range e.Alternative_connections {
+		x.Bytes(v.encode())
+	}
 	x.Int(e.Start_date)
 	return x.buf
 }
@@ -11372,13 +14122,15 @@ const crc_phoneCallDiscarded = 0x50ca4de1
 
 type TL_phoneCallDiscarded struct {
 	Flags       int32
-	Need_rating bool  // need_rating:flags.2?true
-	Need_debug  bool  // need_debug:flags.3?true
-	Id          int64 // Id:long
-	Reason      TL    // reason:flags.0?PhoneCallDiscardReason
-	Duration    int32 // duration:flags.1?int
+	Need_rating bool                   // need_rating:flags.2?true
+	Need_debug  bool                   // need_debug:flags.3?true
+	Id          int64                  // Id:long
+	Reason      PhoneCallDiscardReason // reason:flags.0?PhoneCallDiscardReason
+	Duration    int32                  // duration:flags.1?int
 }
 
+func (e TL_phoneCallDiscarded) isPhoneCall() {}
+
 // Encoding TL_phoneCallDiscarded
 func (e TL_phoneCallDiscarded) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11390,7 +14142,7 @@ func (e TL_phoneCallDiscarded) encode() []byte {
 	if e.Need_debug {
 		flags |= (1 << 3)
 	}
-	if _, ok := (e.Reason).(TL_null); !ok {
+	if e.Reason != nil {
 		flags |= (1 << 0)
 	}
 	if e.Duration > 0 {
@@ -11407,6 +14159,12 @@ func (e TL_phoneCallDiscarded) encode() []byte {
 	return x.buf
 }
 
+// PhoneConnection is implemented by constructors of PhoneConnection type
+type PhoneConnection interface {
+	TL
+	isPhoneConnection()
+}
+
 // phoneConnection#9d4c17c0 Id:long ip:string ipv6:string port:int peer_tag:bytes = PhoneConnection;
 
 const crc_phoneConnection = 0x9d4c17c0
@@ -11419,6 +14177,8 @@ type TL_phoneConnection struct {
 	Peer_tag []byte // peer_tag:bytes
 }
 
+func (e TL_phoneConnection) isPhoneConnection() {}
+
 // Encoding TL_phoneConnection
 func (e TL_phoneConnection) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11427,10 +14187,16 @@ func (e TL_phoneConnection) encode() []byte {
 	x.String(e.Ip)
 	x.String(e.Ipv6)
 	x.Int(e.Port)
-	x.Bytes(e.Peer_tag)
+	x.StringBytes(e.Peer_tag)
 	return x.buf
 }
 
+// PhoneCallProtocol is implemented by constructors of PhoneCallProtocol type
+type PhoneCallProtocol interface {
+	TL
+	isPhoneCallProtocol()
+}
+
 // phoneCallProtocol#a2bb35cb flags:# udp_p2p:flags.0?true udp_reflector:flags.1?true min_layer:int max_layer:int = PhoneCallProtocol;
 
 const crc_phoneCallProtocol = 0xa2bb35cb
@@ -11443,6 +14209,8 @@ type TL_phoneCallProtocol struct {
 	Max_layer     int32 // max_layer:int
 }
 
+func (e TL_phoneCallProtocol) isPhoneCallProtocol() {}
+
 // Encoding TL_phoneCallProtocol
 func (e TL_phoneCallProtocol) encode() []byte {
 	x := NewEncodeBuf(512)
@@ -11460,21 +14228,33 @@ func (e TL_phoneCallProtocol) encode() []byte {
 	return x.buf
 }
 
+// Phone_PhoneCall is implemented by constructors of phone.PhoneCall type
+type Phone_PhoneCall interface {
+	TL
+	isPhone_PhoneCall()
+}
+
 // phone.phoneCall#ec82e140 phone_call:PhoneCall users:Vector<User> = phone.PhoneCall;
 
 const crc_phone_phoneCall = 0xec82e140
 
 type TL_phone_phoneCall struct {
-	Phone_call TL   // phone_call:PhoneCall
-	Users      []TL // users:Vector<User>
+	Phone_call PhoneCall // phone_call:PhoneCall
+	Users      []User    // users:Vector<User>
 }
 
+func (e TL_phone_phoneCall) isPhone_PhoneCall() {}
+
 // Encoding TL_phone_phoneCall
 func (e TL_phone_phoneCall) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_phone_phoneCall)
 	x.Bytes(e.Phone_call.encode())
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -11598,7 +14378,7 @@ type TL_auth_sendCode struct {
 	Flags           int32
 	Allow_flashcall bool   // allow_flashcall:flags.0?true
 	Phone_number    string // phone_number:string
-	Current_number  TL     // current_number:flags.0?Bool
+	Current_number  Bool   // current_number:flags.0?Bool
 	Api_id          int32  // api_id:int
 	Api_hash        string // api_hash:string
 }
@@ -11611,7 +14391,7 @@ func (e TL_auth_sendCode) encode() []byte {
 	if e.Allow_flashcall {
 		flags |= (1 << 0)
 	}
-	if _, ok := (e.Current_number).(TL_null); !ok {
+	if e.Current_number != nil {
 		flags |= (1 << 0)
 	}
 	x.Int(flags)
@@ -11744,7 +14524,7 @@ func (e TL_auth_importAuthorization) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_auth_importAuthorization)
 	x.Int(e.Id)
-	x.Bytes(e.Bytes)
+	x.StringBytes(e.Bytes)
 	return x.buf
 }
 
@@ -11766,7 +14546,7 @@ func (e TL_auth_bindTempAuthKey) encode() []byte {
 	x.Long(e.Perm_auth_key_id)
 	x.Long(e.Nonce)
 	x.Int(e.Expires_at)
-	x.Bytes(e.Encrypted_message)
+	x.StringBytes(e.Encrypted_message)
 	return x.buf
 }
 
@@ -11804,7 +14584,7 @@ type TL_auth_checkPassword struct {
 func (e TL_auth_checkPassword) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_auth_checkPassword)
-	x.Bytes(e.Password_hash)
+	x.StringBytes(e.Password_hash)
 	return x.buf
 }
 
@@ -11931,8 +14711,8 @@ func (e TL_account_unregisterDevice) encode() []byte {
 const crc_account_updateNotifySettings = 0x84be5b93
 
 type TL_account_updateNotifySettings struct {
-	Peer     TL // peer:InputNotifyPeer
-	Settings TL // settings:InputPeerNotifySettings
+	Peer     InputNotifyPeer         // peer:InputNotifyPeer
+	Settings InputPeerNotifySettings // settings:InputPeerNotifySettings
 }
 
 // Encoding TL_account_updateNotifySettings
@@ -11949,7 +14729,7 @@ func (e TL_account_updateNotifySettings) encode() []byte {
 const crc_account_getNotifySettings = 0x12b3ad31
 
 type TL_account_getNotifySettings struct {
-	Peer TL // peer:InputNotifyPeer
+	Peer InputNotifyPeer // peer:InputNotifyPeer
 }
 
 // Encoding TL_account_getNotifySettings
@@ -12017,7 +14797,7 @@ func (e TL_account_updateProfile) encode() []byte {
 const crc_account_updateStatus = 0x6628562c
 
 type TL_account_updateStatus struct {
-	Offline TL // offline:Bool
+	Offline Bool // offline:Bool
 }
 
 // Encoding TL_account_updateStatus
@@ -12047,8 +14827,8 @@ func (e TL_account_getWallPapers) encode() []byte {
 const crc_account_reportPeer = 0xae189d5f
 
 type TL_account_reportPeer struct {
-	Peer   TL // peer:InputPeer
-	Reason TL // reason:ReportReason
+	Peer   InputPeer    // peer:InputPeer
+	Reason ReportReason // reason:ReportReason
 }
 
 // Encoding TL_account_reportPeer
@@ -12097,7 +14877,7 @@ func (e TL_account_updateUsername) encode() []byte {
 const crc_account_getPrivacy = 0xdadbc950
 
 type TL_account_getPrivacy struct {
-	Key TL // key:InputPrivacyKey
+	Key InputPrivacyKey // key:InputPrivacyKey
 }
 
 // Encoding TL_account_getPrivacy
@@ -12113,8 +14893,8 @@ func (e TL_account_getPrivacy) encode() []byte {
 const crc_account_setPrivacy = 0xc9f81ce8
 
 type TL_account_setPrivacy struct {
-	Key   TL   // key:InputPrivacyKey
-	Rules []TL // rules:Vector<InputPrivacyRule>
+	Key   InputPrivacyKey    // key:InputPrivacyKey
+	Rules []InputPrivacyRule // rules:Vector<InputPrivacyRule>
 }
 
 // Encoding TL_account_setPrivacy
@@ -12122,7 +14902,11 @@ func (e TL_account_setPrivacy) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_account_setPrivacy)
 	x.Bytes(e.Key.encode())
-	x.Vector(e.Rules)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Rules)))
+	for _, v := range e.Rules {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -12161,7 +14945,7 @@ func (e TL_account_getAccountTTL) encode() []byte {
 const crc_account_setAccountTTL = 0x2442485e
 
 type TL_account_setAccountTTL struct {
-	Ttl TL // ttl:AccountDaysTTL
+	Ttl AccountDaysTTL // ttl:AccountDaysTTL
 }
 
 // Encoding TL_account_setAccountTTL
@@ -12180,7 +14964,7 @@ type TL_account_sendChangePhoneCode struct {
 	Flags           int32
 	Allow_flashcall bool   // allow_flashcall:flags.0?true
 	Phone_number    string // phone_number:string
-	Current_number  TL     // current_number:flags.0?Bool
+	Current_number  Bool   // current_number:flags.0?Bool
 }
 
 // Encoding TL_account_sendChangePhoneCode
@@ -12191,7 +14975,7 @@ func (e TL_account_sendChangePhoneCode) encode() []byte {
 	if e.Allow_flashcall {
 		flags |= (1 << 0)
 	}
-	if _, ok := (e.Current_number).(TL_null); !ok {
+	if e.Current_number != nil {
 		flags |= (1 << 0)
 	}
 	x.Int(flags)
@@ -12294,7 +15078,7 @@ type TL_account_getPasswordSettings struct {
 func (e TL_account_getPasswordSettings) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_account_getPasswordSettings)
-	x.Bytes(e.Current_password_hash)
+	x.StringBytes(e.Current_password_hash)
 	return x.buf
 }
 
@@ -12303,15 +15087,15 @@ func (e TL_account_getPasswordSettings) encode() []byte {
 const crc_account_updatePasswordSettings = 0xfa7c4b86
 
 type TL_account_updatePasswordSettings struct {
-	Current_password_hash []byte // current_password_hash:bytes
-	New_settings          TL     // new_settings:account.PasswordInputSettings
+	Current_password_hash []byte                        // current_password_hash:bytes
+	New_settings          Account_PasswordInputSettings // new_settings:account.PasswordInputSettings
 }
 
 // Encoding TL_account_updatePasswordSettings
 func (e TL_account_updatePasswordSettings) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_account_updatePasswordSettings)
-	x.Bytes(e.Current_password_hash)
+	x.StringBytes(e.Current_password_hash)
 	x.Bytes(e.New_settings.encode())
 	return x.buf
 }
@@ -12324,7 +15108,7 @@ type TL_account_sendConfirmPhoneCode struct {
 	Flags           int32
 	Allow_flashcall bool   // allow_flashcall:flags.0?true
 	Hash            string // Hash:string
-	Current_number  TL     // current_number:flags.0?Bool
+	Current_number  Bool   // current_number:flags.0?Bool
 }
 
 // Encoding TL_account_sendConfirmPhoneCode
@@ -12335,7 +15119,7 @@ func (e TL_account_sendConfirmPhoneCode) encode() []byte {
 	if e.Allow_flashcall {
 		flags |= (1 << 0)
 	}
-	if _, ok := (e.Current_number).(TL_null); !ok {
+	if e.Current_number != nil {
 		flags |= (1 << 0)
 	}
 	x.Int(flags)
@@ -12377,7 +15161,7 @@ type TL_account_getTmpPassword struct {
 func (e TL_account_getTmpPassword) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_account_getTmpPassword)
-	x.Bytes(e.Password_hash)
+	x.StringBytes(e.Password_hash)
 	x.Int(e.Period)
 	return x.buf
 }
@@ -12387,14 +15171,18 @@ func (e TL_account_getTmpPassword) encode() []byte {
 const crc_users_getUsers = 0xd91a548
 
 type TL_users_getUsers struct {
-	Id []TL // Id:Vector<InputUser>
+	Id []InputUser // Id:Vector<InputUser>
 }
 
 // Encoding TL_users_getUsers
 func (e TL_users_getUsers) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_users_getUsers)
-	x.Vector(e.Id)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Id)))
+	for _, v := range e.Id {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -12403,7 +15191,7 @@ func (e TL_users_getUsers) encode() []byte {
 const crc_users_getFullUser = 0xca30a5b1
 
 type TL_users_getFullUser struct {
-	Id TL // Id:InputUser
+	Id InputUser // Id:InputUser
 }
 
 // Encoding TL_users_getFullUser
@@ -12449,15 +15237,19 @@ func (e TL_contacts_getContacts) encode() []byte {
 const crc_contacts_importContacts = 0xda30b32d
 
 type TL_contacts_importContacts struct {
-	Contacts []TL // contacts:Vector<InputContact>
-	Replace  TL   // replace:Bool
+	Contacts []InputContact // contacts:Vector<InputContact>
+	Replace  Bool           // replace:Bool
 }
 
 // Encoding TL_contacts_importContacts
 func (e TL_contacts_importContacts) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_contacts_importContacts)
-	x.Vector(e.Contacts)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Contacts)))
+	for _, v := range e.Contacts {
+		x.Bytes(v.encode())
+	}
 	x.Bytes(e.Replace.encode())
 	return x.buf
 }
@@ -12467,7 +15259,7 @@ func (e TL_contacts_importContacts) encode() []byte {
 const crc_contacts_deleteContact = 0x8e953744
 
 type TL_contacts_deleteContact struct {
-	Id TL // Id:InputUser
+	Id InputUser // Id:InputUser
 }
 
 // Encoding TL_contacts_deleteContact
@@ -12483,14 +15275,18 @@ func (e TL_contacts_deleteContact) encode() []byte {
 const crc_contacts_deleteContacts = 0x59ab389e
 
 type TL_contacts_deleteContacts struct {
-	Id []TL // Id:Vector<InputUser>
+	Id []InputUser // Id:Vector<InputUser>
 }
 
 // Encoding TL_contacts_deleteContacts
 func (e TL_contacts_deleteContacts) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_contacts_deleteContacts)
-	x.Vector(e.Id)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Id)))
+	for _, v := range e.Id {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -12499,7 +15295,7 @@ func (e TL_contacts_deleteContacts) encode() []byte {
 const crc_contacts_block = 0x332b49fc
 
 type TL_contacts_block struct {
-	Id TL // Id:InputUser
+	Id InputUser // Id:InputUser
 }
 
 // Encoding TL_contacts_block
@@ -12515,7 +15311,7 @@ func (e TL_contacts_block) encode() []byte {
 const crc_contacts_unblock = 0xe54100bd
 
 type TL_contacts_unblock struct {
-	Id TL // Id:InputUser
+	Id InputUser // Id:InputUser
 }
 
 // Encoding TL_contacts_unblock
@@ -12656,8 +15452,8 @@ func (e TL_contacts_getTopPeers) encode() []byte {
 const crc_contacts_resetTopPeerRating = 0x1ae373ac
 
 type TL_contacts_resetTopPeerRating struct {
-	Category TL // category:TopPeerCategory
-	Peer     TL // peer:InputPeer
+	Category TopPeerCategory // category:TopPeerCategory
+	Peer     InputPeer       // peer:InputPeer
 }
 
 // Encoding TL_contacts_resetTopPeerRating
@@ -12691,11 +15487,11 @@ const crc_messages_getDialogs = 0x191ba9c5
 
 type TL_messages_getDialogs struct {
 	Flags          int32
-	Exclude_pinned bool  // exclude_pinned:flags.0?true
-	Offset_date    int32 // offset_date:int
-	Offset_id      int32 // offset_id:int
-	Offset_peer    TL    // offset_peer:InputPeer
-	Limit          int32 // limit:int
+	Exclude_pinned bool      // exclude_pinned:flags.0?true
+	Offset_date    int32     // offset_date:int
+	Offset_id      int32     // offset_id:int
+	Offset_peer    InputPeer // offset_peer:InputPeer
+	Limit          int32     // limit:int
 }
 
 // Encoding TL_messages_getDialogs
@@ -12719,13 +15515,13 @@ func (e TL_messages_getDialogs) encode() []byte {
 const crc_messages_getHistory = 0xafa92846
 
 type TL_messages_getHistory struct {
-	Peer        TL    // peer:InputPeer
-	Offset_id   int32 // offset_id:int
-	Offset_date int32 // offset_date:int
-	Add_offset  int32 // add_offset:int
-	Limit       int32 // limit:int
-	Max_id      int32 // max_id:int
-	Min_id      int32 // min_id:int
+	Peer        InputPeer // peer:InputPeer
+	Offset_id   int32     // offset_id:int
+	Offset_date int32     // offset_date:int
+	Add_offset  int32     // add_offset:int
+	Limit       int32     // limit:int
+	Max_id      int32     // max_id:int
+	Min_id      int32     // min_id:int
 }
 
 // Encoding TL_messages_getHistory
@@ -12748,14 +15544,14 @@ const crc_messages_search = 0xd4569248
 
 type TL_messages_search struct {
 	Flags    int32
-	Peer     TL     // peer:InputPeer
-	Q        string // q:string
-	Filter   TL     // filter:MessagesFilter
-	Min_date int32  // min_date:int
-	Max_date int32  // max_date:int
-	Offset   int32  // offset:int
-	Max_id   int32  // max_id:int
-	Limit    int32  // limit:int
+	Peer     InputPeer      // peer:InputPeer
+	Q        string         // q:string
+	Filter   MessagesFilter // filter:MessagesFilter
+	Min_date int32          // min_date:int
+	Max_date int32          // max_date:int
+	Offset   int32          // offset:int
+	Max_id   int32          // max_id:int
+	Limit    int32          // limit:int
 }
 
 // Encoding TL_messages_search
@@ -12780,8 +15576,8 @@ func (e TL_messages_search) encode() []byte {
 const crc_messages_readHistory = 0xe306d3a
 
 type TL_messages_readHistory struct {
-	Peer   TL    // peer:InputPeer
-	Max_id int32 // max_id:int
+	Peer   InputPeer // peer:InputPeer
+	Max_id int32     // max_id:int
 }
 
 // Encoding TL_messages_readHistory
@@ -12799,9 +15595,9 @@ const crc_messages_deleteHistory = 0x1c015b09
 
 type TL_messages_deleteHistory struct {
 	Flags      int32
-	Just_clear bool  // just_clear:flags.0?true
-	Peer       TL    // peer:InputPeer
-	Max_id     int32 // max_id:int
+	Just_clear bool      // just_clear:flags.0?true
+	Peer       InputPeer // peer:InputPeer
+	Max_id     int32     // max_id:int
 }
 
 // Encoding TL_messages_deleteHistory
@@ -12862,8 +15658,8 @@ func (e TL_messages_receivedMessages) encode() []byte {
 const crc_messages_setTyping = 0xa3825e50
 
 type TL_messages_setTyping struct {
-	Peer   TL // peer:InputPeer
-	Action TL // action:SendMessageAction
+	Peer   InputPeer         // peer:InputPeer
+	Action SendMessageAction // action:SendMessageAction
 }
 
 // Encoding TL_messages_setTyping
@@ -12881,16 +15677,16 @@ const crc_messages_sendMessage = 0xfa88427a
 
 type TL_messages_sendMessage struct {
 	Flags           int32
-	No_webpage      bool   // no_webpage:flags.1?true
-	Silent          bool   // silent:flags.5?true
-	Background      bool   // background:flags.6?true
-	Clear_draft     bool   // clear_draft:flags.7?true
-	Peer            TL     // peer:InputPeer
-	Reply_to_msg_id int32  // reply_to_msg_id:flags.0?int
-	Message         string // message:string
-	Random_id       int64  // random_id:long
-	Reply_markup    TL     // reply_markup:flags.2?ReplyMarkup
-	Entities        []TL   // entities:flags.3?Vector<MessageEntity>
+	No_webpage      bool            // no_webpage:flags.1?true
+	Silent          bool            // silent:flags.5?true
+	Background      bool            // background:flags.6?true
+	Clear_draft     bool            // clear_draft:flags.7?true
+	Peer            InputPeer       // peer:InputPeer
+	Reply_to_msg_id int32           // reply_to_msg_id:flags.0?int
+	Message         string          // message:string
+	Random_id       int64           // random_id:long
+	Reply_markup    ReplyMarkup     // reply_markup:flags.2?ReplyMarkup
+	Entities        []MessageEntity // entities:flags.3?Vector<MessageEntity>
 }
 
 // Encoding TL_messages_sendMessage
@@ -12913,7 +15709,7 @@ func (e TL_messages_sendMessage) encode() []byte {
 	if e.Reply_to_msg_id > 0 {
 		flags |= (1 << 0)
 	}
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	if len(e.Entities) != 0 {
@@ -12930,7 +15726,11 @@ func (e TL_messages_sendMessage) encode() []byte {
 		x.Bytes(e.Reply_markup.encode())
 	}
 	if flags&(1<<3) != 0 {
-		x.Vector(e.Entities)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Entities)))
+		for _, v := range e.Entities {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
@@ -12941,14 +15741,14 @@ const crc_messages_sendMedia = 0xc8f16791
 
 type TL_messages_sendMedia struct {
 	Flags           int32
-	Silent          bool  // silent:flags.5?true
-	Background      bool  // background:flags.6?true
-	Clear_draft     bool  // clear_draft:flags.7?true
-	Peer            TL    // peer:InputPeer
-	Reply_to_msg_id int32 // reply_to_msg_id:flags.0?int
-	Media           TL    // media:InputMedia
-	Random_id       int64 // random_id:long
-	Reply_markup    TL    // reply_markup:flags.2?ReplyMarkup
+	Silent          bool        // silent:flags.5?true
+	Background      bool        // background:flags.6?true
+	Clear_draft     bool        // clear_draft:flags.7?true
+	Peer            InputPeer   // peer:InputPeer
+	Reply_to_msg_id int32       // reply_to_msg_id:flags.0?int
+	Media           InputMedia  // media:InputMedia
+	Random_id       int64       // random_id:long
+	Reply_markup    ReplyMarkup // reply_markup:flags.2?ReplyMarkup
 }
 
 // Encoding TL_messages_sendMedia
@@ -12968,7 +15768,7 @@ func (e TL_messages_sendMedia) encode() []byte {
 	if e.Reply_to_msg_id > 0 {
 		flags |= (1 << 0)
 	}
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	x.Int(flags)
@@ -12990,13 +15790,13 @@ const crc_messages_forwardMessages = 0x708e0195
 
 type TL_messages_forwardMessages struct {
 	Flags         int32
-	Silent        bool    // silent:flags.5?true
-	Background    bool    // background:flags.6?true
-	With_my_score bool    // with_my_score:flags.8?true
-	From_peer     TL      // from_peer:InputPeer
-	Id            []int32 // Id:Vector<int>
-	Random_id     []int64 // random_id:Vector<long>
-	To_peer       TL      // to_peer:InputPeer
+	Silent        bool      // silent:flags.5?true
+	Background    bool      // background:flags.6?true
+	With_my_score bool      // with_my_score:flags.8?true
+	From_peer     InputPeer // from_peer:InputPeer
+	Id            []int32   // Id:Vector<int>
+	Random_id     []int64   // random_id:Vector<long>
+	To_peer       InputPeer // to_peer:InputPeer
 }
 
 // Encoding TL_messages_forwardMessages
@@ -13026,7 +15826,7 @@ func (e TL_messages_forwardMessages) encode() []byte {
 const crc_messages_reportSpam = 0xcf1592db
 
 type TL_messages_reportSpam struct {
-	Peer TL // peer:InputPeer
+	Peer InputPeer // peer:InputPeer
 }
 
 // Encoding TL_messages_reportSpam
@@ -13042,7 +15842,7 @@ func (e TL_messages_reportSpam) encode() []byte {
 const crc_messages_hideReportSpam = 0xa8f1709b
 
 type TL_messages_hideReportSpam struct {
-	Peer TL // peer:InputPeer
+	Peer InputPeer // peer:InputPeer
 }
 
 // Encoding TL_messages_hideReportSpam
@@ -13058,7 +15858,7 @@ func (e TL_messages_hideReportSpam) encode() []byte {
 const crc_messages_getPeerSettings = 0x3672e09c
 
 type TL_messages_getPeerSettings struct {
-	Peer TL // peer:InputPeer
+	Peer InputPeer // peer:InputPeer
 }
 
 // Encoding TL_messages_getPeerSettings
@@ -13124,8 +15924,8 @@ func (e TL_messages_editChatTitle) encode() []byte {
 const crc_messages_editChatPhoto = 0xca4c79d8
 
 type TL_messages_editChatPhoto struct {
-	Chat_id int32 // chat_id:int
-	Photo   TL    // photo:InputChatPhoto
+	Chat_id int32          // chat_id:int
+	Photo   InputChatPhoto // photo:InputChatPhoto
 }
 
 // Encoding TL_messages_editChatPhoto
@@ -13142,9 +15942,9 @@ func (e TL_messages_editChatPhoto) encode() []byte {
 const crc_messages_addChatUser = 0xf9a0aa09
 
 type TL_messages_addChatUser struct {
-	Chat_id   int32 // chat_id:int
-	User_id   TL    // user_id:InputUser
-	Fwd_limit int32 // fwd_limit:int
+	Chat_id   int32     // chat_id:int
+	User_id   InputUser // user_id:InputUser
+	Fwd_limit int32     // fwd_limit:int
 }
 
 // Encoding TL_messages_addChatUser
@@ -13162,8 +15962,8 @@ func (e TL_messages_addChatUser) encode() []byte {
 const crc_messages_deleteChatUser = 0xe0611f16
 
 type TL_messages_deleteChatUser struct {
-	Chat_id int32 // chat_id:int
-	User_id TL    // user_id:InputUser
+	Chat_id int32     // chat_id:int
+	User_id InputUser // user_id:InputUser
 }
 
 // Encoding TL_messages_deleteChatUser
@@ -13180,15 +15980,19 @@ func (e TL_messages_deleteChatUser) encode() []byte {
 const crc_messages_createChat = 0x9cb126e
 
 type TL_messages_createChat struct {
-	Users []TL   // users:Vector<InputUser>
-	Title string // title:string
+	Users []InputUser // users:Vector<InputUser>
+	Title string      // title:string
 }
 
 // Encoding TL_messages_createChat
 func (e TL_messages_createChat) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_createChat)
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	x.String(e.Title)
 	return x.buf
 }
@@ -13198,9 +16002,9 @@ func (e TL_messages_createChat) encode() []byte {
 const crc_messages_forwardMessage = 0x33963bf9
 
 type TL_messages_forwardMessage struct {
-	Peer      TL    // peer:InputPeer
-	Id        int32 // Id:int
-	Random_id int64 // random_id:long
+	Peer      InputPeer // peer:InputPeer
+	Id        int32     // Id:int
+	Random_id int64     // random_id:long
 }
 
 // Encoding TL_messages_forwardMessage
@@ -13236,9 +16040,9 @@ func (e TL_messages_getDhConfig) encode() []byte {
 const crc_messages_requestEncryption = 0xf64daf43
 
 type TL_messages_requestEncryption struct {
-	User_id   TL     // user_id:InputUser
-	Random_id int32  // random_id:int
-	G_a       []byte // g_a:bytes
+	User_id   InputUser // user_id:InputUser
+	Random_id int32     // random_id:int
+	G_a       []byte    // g_a:bytes
 }
 
 // Encoding TL_messages_requestEncryption
@@ -13247,7 +16051,7 @@ func (e TL_messages_requestEncryption) encode() []byte {
 	x.UInt(crc_messages_requestEncryption)
 	x.Bytes(e.User_id.encode())
 	x.Int(e.Random_id)
-	x.Bytes(e.G_a)
+	x.StringBytes(e.G_a)
 	return x.buf
 }
 
@@ -13256,9 +16060,9 @@ func (e TL_messages_requestEncryption) encode() []byte {
 const crc_messages_acceptEncryption = 0x3dbc0415
 
 type TL_messages_acceptEncryption struct {
-	Peer            TL     // peer:InputEncryptedChat
-	G_b             []byte // g_b:bytes
-	Key_fingerprint int64  // key_fingerprint:long
+	Peer            InputEncryptedChat // peer:InputEncryptedChat
+	G_b             []byte             // g_b:bytes
+	Key_fingerprint int64              // key_fingerprint:long
 }
 
 // Encoding TL_messages_acceptEncryption
@@ -13266,7 +16070,7 @@ func (e TL_messages_acceptEncryption) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_acceptEncryption)
 	x.Bytes(e.Peer.encode())
-	x.Bytes(e.G_b)
+	x.StringBytes(e.G_b)
 	x.Long(e.Key_fingerprint)
 	return x.buf
 }
@@ -13292,8 +16096,8 @@ func (e TL_messages_discardEncryption) encode() []byte {
 const crc_messages_setEncryptedTyping = 0x791451ed
 
 type TL_messages_setEncryptedTyping struct {
-	Peer   TL // peer:InputEncryptedChat
-	Typing TL // typing:Bool
+	Peer   InputEncryptedChat // peer:InputEncryptedChat
+	Typing Bool               // typing:Bool
 }
 
 // Encoding TL_messages_setEncryptedTyping
@@ -13310,8 +16114,8 @@ func (e TL_messages_setEncryptedTyping) encode() []byte {
 const crc_messages_readEncryptedHistory = 0x7f4b690a
 
 type TL_messages_readEncryptedHistory struct {
-	Peer     TL    // peer:InputEncryptedChat
-	Max_date int32 // max_date:int
+	Peer     InputEncryptedChat // peer:InputEncryptedChat
+	Max_date int32              // max_date:int
 }
 
 // Encoding TL_messages_readEncryptedHistory
@@ -13328,9 +16132,9 @@ func (e TL_messages_readEncryptedHistory) encode() []byte {
 const crc_messages_sendEncrypted = 0xa9776773
 
 type TL_messages_sendEncrypted struct {
-	Peer      TL     // peer:InputEncryptedChat
-	Random_id int64  // random_id:long
-	Data      []byte // data:bytes
+	Peer      InputEncryptedChat // peer:InputEncryptedChat
+	Random_id int64              // random_id:long
+	Data      []byte             // data:bytes
 }
 
 // Encoding TL_messages_sendEncrypted
@@ -13339,7 +16143,7 @@ func (e TL_messages_sendEncrypted) encode() []byte {
 	x.UInt(crc_messages_sendEncrypted)
 	x.Bytes(e.Peer.encode())
 	x.Long(e.Random_id)
-	x.Bytes(e.Data)
+	x.StringBytes(e.Data)
 	return x.buf
 }
 
@@ -13348,10 +16152,10 @@ func (e TL_messages_sendEncrypted) encode() []byte {
 const crc_messages_sendEncryptedFile = 0x9a901b66
 
 type TL_messages_sendEncryptedFile struct {
-	Peer      TL     // peer:InputEncryptedChat
-	Random_id int64  // random_id:long
-	Data      []byte // data:bytes
-	File      TL     // file:InputEncryptedFile
+	Peer      InputEncryptedChat // peer:InputEncryptedChat
+	Random_id int64              // random_id:long
+	Data      []byte             // data:bytes
+	File      InputEncryptedFile // file:InputEncryptedFile
 }
 
 // Encoding TL_messages_sendEncryptedFile
@@ -13360,7 +16164,7 @@ func (e TL_messages_sendEncryptedFile) encode() []byte {
 	x.UInt(crc_messages_sendEncryptedFile)
 	x.Bytes(e.Peer.encode())
 	x.Long(e.Random_id)
-	x.Bytes(e.Data)
+	x.StringBytes(e.Data)
 	x.Bytes(e.File.encode())
 	return x.buf
 }
@@ -13370,9 +16174,9 @@ func (e TL_messages_sendEncryptedFile) encode() []byte {
 const crc_messages_sendEncryptedService = 0x32d439a4
 
 type TL_messages_sendEncryptedService struct {
-	Peer      TL     // peer:InputEncryptedChat
-	Random_id int64  // random_id:long
-	Data      []byte // data:bytes
+	Peer      InputEncryptedChat // peer:InputEncryptedChat
+	Random_id int64              // random_id:long
+	Data      []byte             // data:bytes
 }
 
 // Encoding TL_messages_sendEncryptedService
@@ -13381,7 +16185,7 @@ func (e TL_messages_sendEncryptedService) encode() []byte {
 	x.UInt(crc_messages_sendEncryptedService)
 	x.Bytes(e.Peer.encode())
 	x.Long(e.Random_id)
-	x.Bytes(e.Data)
+	x.StringBytes(e.Data)
 	return x.buf
 }
 
@@ -13406,7 +16210,7 @@ func (e TL_messages_receivedQueue) encode() []byte {
 const crc_messages_reportEncryptedSpam = 0x4b0c8c0f
 
 type TL_messages_reportEncryptedSpam struct {
-	Peer TL // peer:InputEncryptedChat
+	Peer InputEncryptedChat // peer:InputEncryptedChat
 }
 
 // Encoding TL_messages_reportEncryptedSpam
@@ -13518,7 +16322,7 @@ func (e TL_messages_importChatInvite) encode() []byte {
 const crc_messages_getStickerSet = 0x2619a90e
 
 type TL_messages_getStickerSet struct {
-	Stickerset TL // stickerset:InputStickerSet
+	Stickerset InputStickerSet // stickerset:InputStickerSet
 }
 
 // Encoding TL_messages_getStickerSet
@@ -13534,8 +16338,8 @@ func (e TL_messages_getStickerSet) encode() []byte {
 const crc_messages_installStickerSet = 0xc78fe460
 
 type TL_messages_installStickerSet struct {
-	Stickerset TL // stickerset:InputStickerSet
-	Archived   TL // archived:Bool
+	Stickerset InputStickerSet // stickerset:InputStickerSet
+	Archived   Bool            // archived:Bool
 }
 
 // Encoding TL_messages_installStickerSet
@@ -13552,7 +16356,7 @@ func (e TL_messages_installStickerSet) encode() []byte {
 const crc_messages_uninstallStickerSet = 0xf96e55de
 
 type TL_messages_uninstallStickerSet struct {
-	Stickerset TL // stickerset:InputStickerSet
+	Stickerset InputStickerSet // stickerset:InputStickerSet
 }
 
 // Encoding TL_messages_uninstallStickerSet
@@ -13568,10 +16372,10 @@ func (e TL_messages_uninstallStickerSet) encode() []byte {
 const crc_messages_startBot = 0xe6df7378
 
 type TL_messages_startBot struct {
-	Bot         TL     // bot:InputUser
-	Peer        TL     // peer:InputPeer
-	Random_id   int64  // random_id:long
-	Start_param string // start_param:string
+	Bot         InputUser // bot:InputUser
+	Peer        InputPeer // peer:InputPeer
+	Random_id   int64     // random_id:long
+	Start_param string    // start_param:string
 }
 
 // Encoding TL_messages_startBot
@@ -13590,9 +16394,9 @@ func (e TL_messages_startBot) encode() []byte {
 const crc_messages_getMessagesViews = 0xc4c8a55d
 
 type TL_messages_getMessagesViews struct {
-	Peer      TL      // peer:InputPeer
-	Id        []int32 // Id:Vector<int>
-	Increment TL      // increment:Bool
+	Peer      InputPeer // peer:InputPeer
+	Id        []int32   // Id:Vector<int>
+	Increment Bool      // increment:Bool
 }
 
 // Encoding TL_messages_getMessagesViews
@@ -13611,7 +16415,7 @@ const crc_messages_toggleChatAdmins = 0xec8bd9e1
 
 type TL_messages_toggleChatAdmins struct {
 	Chat_id int32 // chat_id:int
-	Enabled TL    // enabled:Bool
+	Enabled Bool  // enabled:Bool
 }
 
 // Encoding TL_messages_toggleChatAdmins
@@ -13628,9 +16432,9 @@ func (e TL_messages_toggleChatAdmins) encode() []byte {
 const crc_messages_editChatAdmin = 0xa9e69f2e
 
 type TL_messages_editChatAdmin struct {
-	Chat_id  int32 // chat_id:int
-	User_id  TL    // user_id:InputUser
-	Is_admin TL    // is_admin:Bool
+	Chat_id  int32     // chat_id:int
+	User_id  InputUser // user_id:InputUser
+	Is_admin Bool      // is_admin:Bool
 }
 
 // Encoding TL_messages_editChatAdmin
@@ -13664,11 +16468,11 @@ func (e TL_messages_migrateChat) encode() []byte {
 const crc_messages_searchGlobal = 0x9e3cacb0
 
 type TL_messages_searchGlobal struct {
-	Q           string // q:string
-	Offset_date int32  // offset_date:int
-	Offset_peer TL     // offset_peer:InputPeer
-	Offset_id   int32  // offset_id:int
-	Limit       int32  // limit:int
+	Q           string    // q:string
+	Offset_date int32     // offset_date:int
+	Offset_peer InputPeer // offset_peer:InputPeer
+	Offset_id   int32     // offset_id:int
+	Limit       int32     // limit:int
 }
 
 // Encoding TL_messages_searchGlobal
@@ -13720,7 +16524,7 @@ type TL_messages_getDocumentByHash struct {
 func (e TL_messages_getDocumentByHash) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_getDocumentByHash)
-	x.Bytes(e.Sha256)
+	x.StringBytes(e.Sha256)
 	x.Int(e.Size)
 	x.String(e.Mime_type)
 	return x.buf
@@ -13765,8 +16569,8 @@ func (e TL_messages_getSavedGifs) encode() []byte {
 const crc_messages_saveGif = 0x327a30cb
 
 type TL_messages_saveGif struct {
-	Id     TL // Id:InputDocument
-	Unsave TL // unsave:Bool
+	Id     InputDocument // Id:InputDocument
+	Unsave Bool          // unsave:Bool
 }
 
 // Encoding TL_messages_saveGif
@@ -13784,11 +16588,11 @@ const crc_messages_getInlineBotResults = 0x514e999d
 
 type TL_messages_getInlineBotResults struct {
 	Flags     int32
-	Bot       TL     // bot:InputUser
-	Peer      TL     // peer:InputPeer
-	Geo_point TL     // geo_point:flags.0?InputGeoPoint
-	Query     string // query:string
-	Offset    string // offset:string
+	Bot       InputUser     // bot:InputUser
+	Peer      InputPeer     // peer:InputPeer
+	Geo_point InputGeoPoint // geo_point:flags.0?InputGeoPoint
+	Query     string        // query:string
+	Offset    string        // offset:string
 }
 
 // Encoding TL_messages_getInlineBotResults
@@ -13796,7 +16600,7 @@ func (e TL_messages_getInlineBotResults) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_getInlineBotResults)
 	var flags int32
-	if _, ok := (e.Geo_point).(TL_null); !ok {
+	if e.Geo_point != nil {
 		flags |= (1 << 0)
 	}
 	x.Int(flags)
@@ -13816,13 +16620,13 @@ const crc_messages_setInlineBotResults = 0xeb5ea206
 
 type TL_messages_setInlineBotResults struct {
 	Flags       int32
-	Gallery     bool   // gallery:flags.0?true
-	Private     bool   // private:flags.1?true
-	Query_id    int64  // query_id:long
-	Results     []TL   // results:Vector<InputBotInlineResult>
-	Cache_time  int32  // cache_time:int
-	Next_offset string // next_offset:flags.2?string
-	Switch_pm   TL     // switch_pm:flags.3?InlineBotSwitchPM
+	Gallery     bool                   // gallery:flags.0?true
+	Private     bool                   // private:flags.1?true
+	Query_id    int64                  // query_id:long
+	Results     []InputBotInlineResult // results:Vector<InputBotInlineResult>
+	Cache_time  int32                  // cache_time:int
+	Next_offset string                 // next_offset:flags.2?string
+	Switch_pm   InlineBotSwitchPM      // switch_pm:flags.3?InlineBotSwitchPM
 }
 
 // Encoding TL_messages_setInlineBotResults
@@ -13839,12 +16643,16 @@ func (e TL_messages_setInlineBotResults) encode() []byte {
 	if e.Next_offset != "" {
 		flags |= (1 << 2)
 	}
-	if _, ok := (e.Switch_pm).(TL_null); !ok {
+	if e.Switch_pm != nil {
 		flags |= (1 << 3)
 	}
 	x.Int(flags)
 	x.Long(e.Query_id)
-	x.Vector(e.Results)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Results)))
+	for _, v := range e.Results {
+		x.Bytes(v.encode())
+	}
 	x.Int(e.Cache_time)
 	if flags&(1<<2) != 0 {
 		x.String(e.Next_offset)
@@ -13861,14 +16669,14 @@ const crc_messages_sendInlineBotResult = 0xb16e06fe
 
 type TL_messages_sendInlineBotResult struct {
 	Flags           int32
-	Silent          bool   // silent:flags.5?true
-	Background      bool   // background:flags.6?true
-	Clear_draft     bool   // clear_draft:flags.7?true
-	Peer            TL     // peer:InputPeer
-	Reply_to_msg_id int32  // reply_to_msg_id:flags.0?int
-	Random_id       int64  // random_id:long
-	Query_id        int64  // query_id:long
-	Id              string // Id:string
+	Silent          bool      // silent:flags.5?true
+	Background      bool      // background:flags.6?true
+	Clear_draft     bool      // clear_draft:flags.7?true
+	Peer            InputPeer // peer:InputPeer
+	Reply_to_msg_id int32     // reply_to_msg_id:flags.0?int
+	Random_id       int64     // random_id:long
+	Query_id        int64     // query_id:long
+	Id              string    // Id:string
 }
 
 // Encoding TL_messages_sendInlineBotResult
@@ -13904,8 +16712,8 @@ func (e TL_messages_sendInlineBotResult) encode() []byte {
 const crc_messages_getMessageEditData = 0xfda68d36
 
 type TL_messages_getMessageEditData struct {
-	Peer TL    // peer:InputPeer
-	Id   int32 // Id:int
+	Peer InputPeer // peer:InputPeer
+	Id   int32     // Id:int
 }
 
 // Encoding TL_messages_getMessageEditData
@@ -13923,12 +16731,12 @@ const crc_messages_editMessage = 0xce91e4ca
 
 type TL_messages_editMessage struct {
 	Flags        int32
-	No_webpage   bool   // no_webpage:flags.1?true
-	Peer         TL     // peer:InputPeer
-	Id           int32  // Id:int
-	Message      string // message:flags.11?string
-	Reply_markup TL     // reply_markup:flags.2?ReplyMarkup
-	Entities     []TL   // entities:flags.3?Vector<MessageEntity>
+	No_webpage   bool            // no_webpage:flags.1?true
+	Peer         InputPeer       // peer:InputPeer
+	Id           int32           // Id:int
+	Message      string          // message:flags.11?string
+	Reply_markup ReplyMarkup     // reply_markup:flags.2?ReplyMarkup
+	Entities     []MessageEntity // entities:flags.3?Vector<MessageEntity>
 }
 
 // Encoding TL_messages_editMessage
@@ -13942,7 +16750,7 @@ func (e TL_messages_editMessage) encode() []byte {
 	if e.Message != "" {
 		flags |= (1 << 11)
 	}
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	if len(e.Entities) != 0 {
@@ -13958,7 +16766,11 @@ func (e TL_messages_editMessage) encode() []byte {
 		x.Bytes(e.Reply_markup.encode())
 	}
 	if flags&(1<<3) != 0 {
-		x.Vector(e.Entities)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Entities)))
+		for _, v := range e.Entities {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
@@ -13969,11 +16781,11 @@ const crc_messages_editInlineBotMessage = 0x130c2c85
 
 type TL_messages_editInlineBotMessage struct {
 	Flags        int32
-	No_webpage   bool   // no_webpage:flags.1?true
-	Id           TL     // Id:InputBotInlineMessageID
-	Message      string // message:flags.11?string
-	Reply_markup TL     // reply_markup:flags.2?ReplyMarkup
-	Entities     []TL   // entities:flags.3?Vector<MessageEntity>
+	No_webpage   bool                    // no_webpage:flags.1?true
+	Id           InputBotInlineMessageID // Id:InputBotInlineMessageID
+	Message      string                  // message:flags.11?string
+	Reply_markup ReplyMarkup             // reply_markup:flags.2?ReplyMarkup
+	Entities     []MessageEntity         // entities:flags.3?Vector<MessageEntity>
 }
 
 // Encoding TL_messages_editInlineBotMessage
@@ -13987,7 +16799,7 @@ func (e TL_messages_editInlineBotMessage) encode() []byte {
 	if e.Message != "" {
 		flags |= (1 << 11)
 	}
-	if _, ok := (e.Reply_markup).(TL_null); !ok {
+	if e.Reply_markup != nil {
 		flags |= (1 << 2)
 	}
 	if len(e.Entities) != 0 {
@@ -14002,7 +16814,11 @@ func (e TL_messages_editInlineBotMessage) encode() []byte {
 		x.Bytes(e.Reply_markup.encode())
 	}
 	if flags&(1<<3) != 0 {
-		x.Vector(e.Entities)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Entities)))
+		for _, v := range e.Entities {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
@@ -14013,10 +16829,10 @@ const crc_messages_getBotCallbackAnswer = 0x810a9fec
 
 type TL_messages_getBotCallbackAnswer struct {
 	Flags  int32
-	Game   bool   // game:flags.1?true
-	Peer   TL     // peer:InputPeer
-	Msg_id int32  // msg_id:int
-	Data   []byte // data:flags.0?bytes
+	Game   bool      // game:flags.1?true
+	Peer   InputPeer // peer:InputPeer
+	Msg_id int32     // msg_id:int
+	Data   []byte    // data:flags.0?bytes
 }
 
 // Encoding TL_messages_getBotCallbackAnswer
@@ -14034,7 +16850,7 @@ func (e TL_messages_getBotCallbackAnswer) encode() []byte {
 	x.Bytes(e.Peer.encode())
 	x.Int(e.Msg_id)
 	if flags&(1<<0) != 0 {
-		x.Bytes(e.Data)
+		x.StringBytes(e.Data)
 	}
 	return x.buf
 }
@@ -14083,14 +16899,18 @@ func (e TL_messages_setBotCallbackAnswer) encode() []byte {
 const crc_messages_getPeerDialogs = 0x2d9776b9
 
 type TL_messages_getPeerDialogs struct {
-	Peers []TL // peers:Vector<InputPeer>
+	Peers []InputPeer // peers:Vector<InputPeer>
 }
 
 // Encoding TL_messages_getPeerDialogs
 func (e TL_messages_getPeerDialogs) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_messages_getPeerDialogs)
-	x.Vector(e.Peers)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Peers)))
+	for _, v := range e.Peers {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -14100,11 +16920,11 @@ const crc_messages_saveDraft = 0xbc39e14b
 
 type TL_messages_saveDraft struct {
 	Flags           int32
-	No_webpage      bool   // no_webpage:flags.1?true
-	Reply_to_msg_id int32  // reply_to_msg_id:flags.0?int
-	Peer            TL     // peer:InputPeer
-	Message         string // message:string
-	Entities        []TL   // entities:flags.3?Vector<MessageEntity>
+	No_webpage      bool            // no_webpage:flags.1?true
+	Reply_to_msg_id int32           // reply_to_msg_id:flags.0?int
+	Peer            InputPeer       // peer:InputPeer
+	Message         string          // message:string
+	Entities        []MessageEntity // entities:flags.3?Vector<MessageEntity>
 }
 
 // Encoding TL_messages_saveDraft
@@ -14128,7 +16948,11 @@ func (e TL_messages_saveDraft) encode() []byte {
 	x.Bytes(e.Peer.encode())
 	x.String(e.Message)
 	if flags&(1<<3) != 0 {
-		x.Vector(e.Entities)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Entities)))
+		for _, v := range e.Entities {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
@@ -14208,9 +17032,9 @@ const crc_messages_saveRecentSticker = 0x392718f8
 
 type TL_messages_saveRecentSticker struct {
 	Flags    int32
-	Attached bool // attached:flags.0?true
-	Id       TL   // Id:InputDocument
-	Unsave   TL   // unsave:Bool
+	Attached bool          // attached:flags.0?true
+	Id       InputDocument // Id:InputDocument
+	Unsave   Bool          // unsave:Bool
 }
 
 // Encoding TL_messages_saveRecentSticker
@@ -14294,7 +17118,7 @@ func (e TL_messages_getMaskStickers) encode() []byte {
 const crc_messages_getAttachedStickers = 0xcc5b67cc
 
 type TL_messages_getAttachedStickers struct {
-	Media TL // media:InputStickeredMedia
+	Media InputStickeredMedia // media:InputStickeredMedia
 }
 
 // Encoding TL_messages_getAttachedStickers
@@ -14311,12 +17135,12 @@ const crc_messages_setGameScore = 0x8ef8ecc0
 
 type TL_messages_setGameScore struct {
 	Flags        int32
-	Edit_message bool  // edit_message:flags.0?true
-	Force        bool  // force:flags.1?true
-	Peer         TL    // peer:InputPeer
-	Id           int32 // Id:int
-	User_id      TL    // user_id:InputUser
-	Score        int32 // score:int
+	Edit_message bool      // edit_message:flags.0?true
+	Force        bool      // force:flags.1?true
+	Peer         InputPeer // peer:InputPeer
+	Id           int32     // Id:int
+	User_id      InputUser // user_id:InputUser
+	Score        int32     // score:int
 }
 
 // Encoding TL_messages_setGameScore
@@ -14344,11 +17168,11 @@ const crc_messages_setInlineGameScore = 0x15ad9f64
 
 type TL_messages_setInlineGameScore struct {
 	Flags        int32
-	Edit_message bool  // edit_message:flags.0?true
-	Force        bool  // force:flags.1?true
-	Id           TL    // Id:InputBotInlineMessageID
-	User_id      TL    // user_id:InputUser
-	Score        int32 // score:int
+	Edit_message bool                    // edit_message:flags.0?true
+	Force        bool                    // force:flags.1?true
+	Id           InputBotInlineMessageID // Id:InputBotInlineMessageID
+	User_id      InputUser               // user_id:InputUser
+	Score        int32                   // score:int
 }
 
 // Encoding TL_messages_setInlineGameScore
@@ -14374,9 +17198,9 @@ func (e TL_messages_setInlineGameScore) encode() []byte {
 const crc_messages_getGameHighScores = 0xe822649d
 
 type TL_messages_getGameHighScores struct {
-	Peer    TL    // peer:InputPeer
-	Id      int32 // Id:int
-	User_id TL    // user_id:InputUser
+	Peer    InputPeer // peer:InputPeer
+	Id      int32     // Id:int
+	User_id InputUser // user_id:InputUser
 }
 
 // Encoding TL_messages_getGameHighScores
@@ -14394,8 +17218,8 @@ func (e TL_messages_getGameHighScores) encode() []byte {
 const crc_messages_getInlineGameHighScores = 0xf635e1b
 
 type TL_messages_getInlineGameHighScores struct {
-	Id      TL // Id:InputBotInlineMessageID
-	User_id TL // user_id:InputUser
+	Id      InputBotInlineMessageID // Id:InputBotInlineMessageID
+	User_id InputUser               // user_id:InputUser
 }
 
 // Encoding TL_messages_getInlineGameHighScores
@@ -14412,9 +17236,9 @@ func (e TL_messages_getInlineGameHighScores) encode() []byte {
 const crc_messages_getCommonChats = 0xd0a48c4
 
 type TL_messages_getCommonChats struct {
-	User_id TL    // user_id:InputUser
-	Max_id  int32 // max_id:int
-	Limit   int32 // limit:int
+	User_id InputUser // user_id:InputUser
+	Max_id  int32     // max_id:int
+	Limit   int32     // limit:int
 }
 
 // Encoding TL_messages_getCommonChats
@@ -14467,8 +17291,8 @@ const crc_messages_toggleDialogPin = 0x3289be6a
 
 type TL_messages_toggleDialogPin struct {
 	Flags  int32
-	Pinned bool // pinned:flags.0?true
-	Peer   TL   // peer:InputPeer
+	Pinned bool      // pinned:flags.0?true
+	Peer   InputPeer // peer:InputPeer
 }
 
 // Encoding TL_messages_toggleDialogPin
@@ -14490,8 +17314,8 @@ const crc_messages_reorderPinnedDialogs = 0x959ff644
 
 type TL_messages_reorderPinnedDialogs struct {
 	Flags int32
-	Force bool // force:flags.0?true
-	Order []TL // order:Vector<InputPeer>
+	Force bool        // force:flags.0?true
+	Order []InputPeer // order:Vector<InputPeer>
 }
 
 // Encoding TL_messages_reorderPinnedDialogs
@@ -14503,7 +17327,11 @@ func (e TL_messages_reorderPinnedDialogs) encode() []byte {
 		flags |= (1 << 0)
 	}
 	x.Int(flags)
-	x.Vector(e.Order)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Order)))
+	for _, v := range e.Order {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -14527,9 +17355,9 @@ const crc_messages_setBotShippingResults = 0xe5f672fa
 
 type TL_messages_setBotShippingResults struct {
 	Flags            int32
-	Query_id         int64  // query_id:long
-	Error            string // error:flags.0?string
-	Shipping_options []TL   // shipping_options:flags.1?Vector<ShippingOption>
+	Query_id         int64            // query_id:long
+	Error            string           // error:flags.0?string
+	Shipping_options []ShippingOption // shipping_options:flags.1?Vector<ShippingOption>
 }
 
 // Encoding TL_messages_setBotShippingResults
@@ -14549,7 +17377,11 @@ func (e TL_messages_setBotShippingResults) encode() []byte {
 		x.String(e.Error)
 	}
 	if flags&(1<<1) != 0 {
-		x.Vector(e.Shipping_options)
+		x.UInt(crc_vector)
+		x.Int(int32(len(e.Shipping_options)))
+		for _, v := range e.Shipping_options {
+			x.Bytes(v.encode())
+		}
 	}
 	return x.buf
 }
@@ -14634,11 +17466,11 @@ const crc_updates_getChannelDifference = 0x3173d78
 
 type TL_updates_getChannelDifference struct {
 	Flags   int32
-	Force   bool  // force:flags.0?true
-	Channel TL    // channel:InputChannel
-	Filter  TL    // filter:ChannelMessagesFilter
-	Pts     int32 // pts:int
-	Limit   int32 // limit:int
+	Force   bool                  // force:flags.0?true
+	Channel InputChannel          // channel:InputChannel
+	Filter  ChannelMessagesFilter // filter:ChannelMessagesFilter
+	Pts     int32                 // pts:int
+	Limit   int32                 // limit:int
 }
 
 // Encoding TL_updates_getChannelDifference
@@ -14662,7 +17494,7 @@ func (e TL_updates_getChannelDifference) encode() []byte {
 const crc_photos_updateProfilePhoto = 0xf0bb5152
 
 type TL_photos_updateProfilePhoto struct {
-	Id TL // Id:InputPhoto
+	Id InputPhoto // Id:InputPhoto
 }
 
 // Encoding TL_photos_updateProfilePhoto
@@ -14678,7 +17510,7 @@ func (e TL_photos_updateProfilePhoto) encode() []byte {
 const crc_photos_uploadProfilePhoto = 0x4f32c098
 
 type TL_photos_uploadProfilePhoto struct {
-	File TL // file:InputFile
+	File InputFile // file:InputFile
 }
 
 // Encoding TL_photos_uploadProfilePhoto
@@ -14694,14 +17526,18 @@ func (e TL_photos_uploadProfilePhoto) encode() []byte {
 const crc_photos_deletePhotos = 0x87cf7f2f
 
 type TL_photos_deletePhotos struct {
-	Id []TL // Id:Vector<InputPhoto>
+	Id []InputPhoto // Id:Vector<InputPhoto>
 }
 
 // Encoding TL_photos_deletePhotos
 func (e TL_photos_deletePhotos) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_photos_deletePhotos)
-	x.Vector(e.Id)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Id)))
+	for _, v := range e.Id {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -14710,10 +17546,10 @@ func (e TL_photos_deletePhotos) encode() []byte {
 const crc_photos_getUserPhotos = 0x91cd32a8
 
 type TL_photos_getUserPhotos struct {
-	User_id TL    // user_id:InputUser
-	Offset  int32 // offset:int
-	Max_id  int64 // max_id:long
-	Limit   int32 // limit:int
+	User_id InputUser // user_id:InputUser
+	Offset  int32     // offset:int
+	Max_id  int64     // max_id:long
+	Limit   int32     // limit:int
 }
 
 // Encoding TL_photos_getUserPhotos
@@ -14743,7 +17579,7 @@ func (e TL_upload_saveFilePart) encode() []byte {
 	x.UInt(crc_upload_saveFilePart)
 	x.Long(e.File_id)
 	x.Int(e.File_part)
-	x.Bytes(e.Bytes)
+	x.StringBytes(e.Bytes)
 	return x.buf
 }
 
@@ -14752,9 +17588,9 @@ func (e TL_upload_saveFilePart) encode() []byte {
 const crc_upload_getFile = 0xe3a6cfb5
 
 type TL_upload_getFile struct {
-	Location TL    // location:InputFileLocation
-	Offset   int32 // offset:int
-	Limit    int32 // limit:int
+	Location InputFileLocation // location:InputFileLocation
+	Offset   int32             // offset:int
+	Limit    int32             // limit:int
 }
 
 // Encoding TL_upload_getFile
@@ -14785,7 +17621,7 @@ func (e TL_upload_saveBigFilePart) encode() []byte {
 	x.Long(e.File_id)
 	x.Int(e.File_part)
 	x.Int(e.File_total_parts)
-	x.Bytes(e.Bytes)
+	x.StringBytes(e.Bytes)
 	return x.buf
 }
 
@@ -14794,9 +17630,9 @@ func (e TL_upload_saveBigFilePart) encode() []byte {
 const crc_upload_getWebFile = 0x24e6818d
 
 type TL_upload_getWebFile struct {
-	Location TL    // location:InputWebFileLocation
-	Offset   int32 // offset:int
-	Limit    int32 // limit:int
+	Location InputWebFileLocation // location:InputWebFileLocation
+	Offset   int32                // offset:int
+	Limit    int32                // limit:int
 }
 
 // Encoding TL_upload_getWebFile
@@ -14856,14 +17692,18 @@ func (e TL_help_getAppUpdate) encode() []byte {
 const crc_help_saveAppLog = 0x6f02f748
 
 type TL_help_saveAppLog struct {
-	Events []TL // events:Vector<InputAppEvent>
+	Events []InputAppEvent // events:Vector<InputAppEvent>
 }
 
 // Encoding TL_help_saveAppLog
 func (e TL_help_saveAppLog) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_help_saveAppLog)
-	x.Vector(e.Events)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Events)))
+	for _, v := range e.Events {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -14948,8 +17788,8 @@ func (e TL_help_setBotUpdatesStatus) encode() []byte {
 const crc_channels_readHistory = 0xcc104937
 
 type TL_channels_readHistory struct {
-	Channel TL    // channel:InputChannel
-	Max_id  int32 // max_id:int
+	Channel InputChannel // channel:InputChannel
+	Max_id  int32        // max_id:int
 }
 
 // Encoding TL_channels_readHistory
@@ -14966,8 +17806,8 @@ func (e TL_channels_readHistory) encode() []byte {
 const crc_channels_deleteMessages = 0x84c1fd4e
 
 type TL_channels_deleteMessages struct {
-	Channel TL      // channel:InputChannel
-	Id      []int32 // Id:Vector<int>
+	Channel InputChannel // channel:InputChannel
+	Id      []int32      // Id:Vector<int>
 }
 
 // Encoding TL_channels_deleteMessages
@@ -14984,8 +17824,8 @@ func (e TL_channels_deleteMessages) encode() []byte {
 const crc_channels_deleteUserHistory = 0xd10dd71b
 
 type TL_channels_deleteUserHistory struct {
-	Channel TL // channel:InputChannel
-	User_id TL // user_id:InputUser
+	Channel InputChannel // channel:InputChannel
+	User_id InputUser    // user_id:InputUser
 }
 
 // Encoding TL_channels_deleteUserHistory
@@ -15002,9 +17842,9 @@ func (e TL_channels_deleteUserHistory) encode() []byte {
 const crc_channels_reportSpam = 0xfe087810
 
 type TL_channels_reportSpam struct {
-	Channel TL      // channel:InputChannel
-	User_id TL      // user_id:InputUser
-	Id      []int32 // Id:Vector<int>
+	Channel InputChannel // channel:InputChannel
+	User_id InputUser    // user_id:InputUser
+	Id      []int32      // Id:Vector<int>
 }
 
 // Encoding TL_channels_reportSpam
@@ -15022,8 +17862,8 @@ func (e TL_channels_reportSpam) encode() []byte {
 const crc_channels_getMessages = 0x93d7b347
 
 type TL_channels_getMessages struct {
-	Channel TL      // channel:InputChannel
-	Id      []int32 // Id:Vector<int>
+	Channel InputChannel // channel:InputChannel
+	Id      []int32      // Id:Vector<int>
 }
 
 // Encoding TL_channels_getMessages
@@ -15040,10 +17880,10 @@ func (e TL_channels_getMessages) encode() []byte {
 const crc_channels_getParticipants = 0x24d98f92
 
 type TL_channels_getParticipants struct {
-	Channel TL    // channel:InputChannel
-	Filter  TL    // filter:ChannelParticipantsFilter
-	Offset  int32 // offset:int
-	Limit   int32 // limit:int
+	Channel InputChannel              // channel:InputChannel
+	Filter  ChannelParticipantsFilter // filter:ChannelParticipantsFilter
+	Offset  int32                     // offset:int
+	Limit   int32                     // limit:int
 }
 
 // Encoding TL_channels_getParticipants
@@ -15062,8 +17902,8 @@ func (e TL_channels_getParticipants) encode() []byte {
 const crc_channels_getParticipant = 0x546dd7a6
 
 type TL_channels_getParticipant struct {
-	Channel TL // channel:InputChannel
-	User_id TL // user_id:InputUser
+	Channel InputChannel // channel:InputChannel
+	User_id InputUser    // user_id:InputUser
 }
 
 // Encoding TL_channels_getParticipant
@@ -15080,14 +17920,18 @@ func (e TL_channels_getParticipant) encode() []byte {
 const crc_channels_getChannels = 0xa7f6bbb
 
 type TL_channels_getChannels struct {
-	Id []TL // Id:Vector<InputChannel>
+	Id []InputChannel // Id:Vector<InputChannel>
 }
 
 // Encoding TL_channels_getChannels
 func (e TL_channels_getChannels) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_channels_getChannels)
-	x.Vector(e.Id)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Id)))
+	for _, v := range e.Id {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -15096,7 +17940,7 @@ func (e TL_channels_getChannels) encode() []byte {
 const crc_channels_getFullChannel = 0x8736a09
 
 type TL_channels_getFullChannel struct {
-	Channel TL // channel:InputChannel
+	Channel InputChannel // channel:InputChannel
 }
 
 // Encoding TL_channels_getFullChannel
@@ -15141,8 +17985,8 @@ func (e TL_channels_createChannel) encode() []byte {
 const crc_channels_editAbout = 0x13e27f1e
 
 type TL_channels_editAbout struct {
-	Channel TL     // channel:InputChannel
-	About   string // about:string
+	Channel InputChannel // channel:InputChannel
+	About   string       // about:string
 }
 
 // Encoding TL_channels_editAbout
@@ -15159,9 +18003,9 @@ func (e TL_channels_editAbout) encode() []byte {
 const crc_channels_editAdmin = 0xeb7611d0
 
 type TL_channels_editAdmin struct {
-	Channel TL // channel:InputChannel
-	User_id TL // user_id:InputUser
-	Role    TL // role:ChannelParticipantRole
+	Channel InputChannel           // channel:InputChannel
+	User_id InputUser              // user_id:InputUser
+	Role    ChannelParticipantRole // role:ChannelParticipantRole
 }
 
 // Encoding TL_channels_editAdmin
@@ -15179,8 +18023,8 @@ func (e TL_channels_editAdmin) encode() []byte {
 const crc_channels_editTitle = 0x566decd0
 
 type TL_channels_editTitle struct {
-	Channel TL     // channel:InputChannel
-	Title   string // title:string
+	Channel InputChannel // channel:InputChannel
+	Title   string       // title:string
 }
 
 // Encoding TL_channels_editTitle
@@ -15197,8 +18041,8 @@ func (e TL_channels_editTitle) encode() []byte {
 const crc_channels_editPhoto = 0xf12e57c9
 
 type TL_channels_editPhoto struct {
-	Channel TL // channel:InputChannel
-	Photo   TL // photo:InputChatPhoto
+	Channel InputChannel   // channel:InputChannel
+	Photo   InputChatPhoto // photo:InputChatPhoto
 }
 
 // Encoding TL_channels_editPhoto
@@ -15215,8 +18059,8 @@ func (e TL_channels_editPhoto) encode() []byte {
 const crc_channels_checkUsername = 0x10e6bd2c
 
 type TL_channels_checkUsername struct {
-	Channel  TL     // channel:InputChannel
-	Username string // username:string
+	Channel  InputChannel // channel:InputChannel
+	Username string       // username:string
 }
 
 // Encoding TL_channels_checkUsername
@@ -15233,8 +18077,8 @@ func (e TL_channels_checkUsername) encode() []byte {
 const crc_channels_updateUsername = 0x3514b3de
 
 type TL_channels_updateUsername struct {
-	Channel  TL     // channel:InputChannel
-	Username string // username:string
+	Channel  InputChannel // channel:InputChannel
+	Username string       // username:string
 }
 
 // Encoding TL_channels_updateUsername
@@ -15251,7 +18095,7 @@ func (e TL_channels_updateUsername) encode() []byte {
 const crc_channels_joinChannel = 0x24b524c5
 
 type TL_channels_joinChannel struct {
-	Channel TL // channel:InputChannel
+	Channel InputChannel // channel:InputChannel
 }
 
 // Encoding TL_channels_joinChannel
@@ -15267,7 +18111,7 @@ func (e TL_channels_joinChannel) encode() []byte {
 const crc_channels_leaveChannel = 0xf836aa95
 
 type TL_channels_leaveChannel struct {
-	Channel TL // channel:InputChannel
+	Channel InputChannel // channel:InputChannel
 }
 
 // Encoding TL_channels_leaveChannel
@@ -15283,8 +18127,8 @@ func (e TL_channels_leaveChannel) encode() []byte {
 const crc_channels_inviteToChannel = 0x199f3a6c
 
 type TL_channels_inviteToChannel struct {
-	Channel TL   // channel:InputChannel
-	Users   []TL // users:Vector<InputUser>
+	Channel InputChannel // channel:InputChannel
+	Users   []InputUser  // users:Vector<InputUser>
 }
 
 // Encoding TL_channels_inviteToChannel
@@ -15292,7 +18136,11 @@ func (e TL_channels_inviteToChannel) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_channels_inviteToChannel)
 	x.Bytes(e.Channel.encode())
-	x.Vector(e.Users)
+	x.UInt(crc_vector)
+	x.Int(int32(len(e.Users)))
+	for _, v := range e.Users {
+		x.Bytes(v.encode())
+	}
 	return x.buf
 }
 
@@ -15301,9 +18149,9 @@ func (e TL_channels_inviteToChannel) encode() []byte {
 const crc_channels_kickFromChannel = 0xa672de14
 
 type TL_channels_kickFromChannel struct {
-	Channel TL // channel:InputChannel
-	User_id TL // user_id:InputUser
-	Kicked  TL // kicked:Bool
+	Channel InputChannel // channel:InputChannel
+	User_id InputUser    // user_id:InputUser
+	Kicked  Bool         // kicked:Bool
 }
 
 // Encoding TL_channels_kickFromChannel
@@ -15321,7 +18169,7 @@ func (e TL_channels_kickFromChannel) encode() []byte {
 const crc_channels_exportInvite = 0xc7560885
 
 type TL_channels_exportInvite struct {
-	Channel TL // channel:InputChannel
+	Channel InputChannel // channel:InputChannel
 }
 
 // Encoding TL_channels_exportInvite
@@ -15337,7 +18185,7 @@ func (e TL_channels_exportInvite) encode() []byte {
 const crc_channels_deleteChannel = 0xc0111fe3
 
 type TL_channels_deleteChannel struct {
-	Channel TL // channel:InputChannel
+	Channel InputChannel // channel:InputChannel
 }
 
 // Encoding TL_channels_deleteChannel
@@ -15353,8 +18201,8 @@ func (e TL_channels_deleteChannel) encode() []byte {
 const crc_channels_toggleInvites = 0x49609307
 
 type TL_channels_toggleInvites struct {
-	Channel TL // channel:InputChannel
-	Enabled TL // enabled:Bool
+	Channel InputChannel // channel:InputChannel
+	Enabled Bool         // enabled:Bool
 }
 
 // Encoding TL_channels_toggleInvites
@@ -15371,8 +18219,8 @@ func (e TL_channels_toggleInvites) encode() []byte {
 const crc_channels_exportMessageLink = 0xc846d22d
 
 type TL_channels_exportMessageLink struct {
-	Channel TL    // channel:InputChannel
-	Id      int32 // Id:int
+	Channel InputChannel // channel:InputChannel
+	Id      int32        // Id:int
 }
 
 // Encoding TL_channels_exportMessageLink
@@ -15389,8 +18237,8 @@ func (e TL_channels_exportMessageLink) encode() []byte {
 const crc_channels_toggleSignatures = 0x1f69b606
 
 type TL_channels_toggleSignatures struct {
-	Channel TL // channel:InputChannel
-	Enabled TL // enabled:Bool
+	Channel InputChannel // channel:InputChannel
+	Enabled Bool         // enabled:Bool
 }
 
 // Encoding TL_channels_toggleSignatures
@@ -15408,9 +18256,9 @@ const crc_channels_updatePinnedMessage = 0xa72ded52
 
 type TL_channels_updatePinnedMessage struct {
 	Flags   int32
-	Silent  bool  // silent:flags.0?true
-	Channel TL    // channel:InputChannel
-	Id      int32 // Id:int
+	Silent  bool         // silent:flags.0?true
+	Channel InputChannel // channel:InputChannel
+	Id      int32        // Id:int
 }
 
 // Encoding TL_channels_updatePinnedMessage
@@ -15446,8 +18294,8 @@ func (e TL_channels_getAdminedPublicChannels) encode() []byte {
 const crc_bots_sendCustomRequest = 0xaa2769ed
 
 type TL_bots_sendCustomRequest struct {
-	Custom_method string // custom_method:string
-	Params        TL     // params:DataJSON
+	Custom_method string   // custom_method:string
+	Params        DataJSON // params:DataJSON
 }
 
 // Encoding TL_bots_sendCustomRequest
@@ -15464,8 +18312,8 @@ func (e TL_bots_sendCustomRequest) encode() []byte {
 const crc_bots_answerWebhookJSONQuery = 0xe6213f4d
 
 type TL_bots_answerWebhookJSONQuery struct {
-	Query_id int64 // query_id:long
-	Data     TL    // data:DataJSON
+	Query_id int64    // query_id:long
+	Data     DataJSON // data:DataJSON
 }
 
 // Encoding TL_bots_answerWebhookJSONQuery
@@ -15515,9 +18363,9 @@ const crc_payments_validateRequestedInfo = 0x770a8e74
 
 type TL_payments_validateRequestedInfo struct {
 	Flags  int32
-	Save   bool  // save:flags.0?true
-	Msg_id int32 // msg_id:int
-	Info   TL    // info:PaymentRequestedInfo
+	Save   bool                 // save:flags.0?true
+	Msg_id int32                // msg_id:int
+	Info   PaymentRequestedInfo // info:PaymentRequestedInfo
 }
 
 // Encoding TL_payments_validateRequestedInfo
@@ -15540,10 +18388,10 @@ const crc_payments_sendPaymentForm = 0x2b8879b3
 
 type TL_payments_sendPaymentForm struct {
 	Flags              int32
-	Msg_id             int32  // msg_id:int
-	Requested_info_id  string // requested_info_id:flags.0?string
-	Shipping_option_id string // shipping_option_id:flags.1?string
-	Credentials        TL     // credentials:InputPaymentCredentials
+	Msg_id             int32                   // msg_id:int
+	Requested_info_id  string                  // requested_info_id:flags.0?string
+	Shipping_option_id string                  // shipping_option_id:flags.1?string
+	Credentials        InputPaymentCredentials // credentials:InputPaymentCredentials
 }
 
 // Encoding TL_payments_sendPaymentForm
@@ -15627,10 +18475,10 @@ func (e TL_phone_getCallConfig) encode() []byte {
 const crc_phone_requestCall = 0x5b95b3d4
 
 type TL_phone_requestCall struct {
-	User_id   TL     // user_id:InputUser
-	Random_id int32  // random_id:int
-	G_a_hash  []byte // g_a_hash:bytes
-	Protocol  TL     // protocol:PhoneCallProtocol
+	User_id   InputUser         // user_id:InputUser
+	Random_id int32             // random_id:int
+	G_a_hash  []byte            // g_a_hash:bytes
+	Protocol  PhoneCallProtocol // protocol:PhoneCallProtocol
 }
 
 // Encoding TL_phone_requestCall
@@ -15639,7 +18487,7 @@ func (e TL_phone_requestCall) encode() []byte {
 	x.UInt(crc_phone_requestCall)
 	x.Bytes(e.User_id.encode())
 	x.Int(e.Random_id)
-	x.Bytes(e.G_a_hash)
+	x.StringBytes(e.G_a_hash)
 	x.Bytes(e.Protocol.encode())
 	return x.buf
 }
@@ -15649,9 +18497,9 @@ func (e TL_phone_requestCall) encode() []byte {
 const crc_phone_acceptCall = 0x3bd2b4a0
 
 type TL_phone_acceptCall struct {
-	Peer     TL     // peer:InputPhoneCall
-	G_b      []byte // g_b:bytes
-	Protocol TL     // protocol:PhoneCallProtocol
+	Peer     InputPhoneCall    // peer:InputPhoneCall
+	G_b      []byte            // g_b:bytes
+	Protocol PhoneCallProtocol // protocol:PhoneCallProtocol
 }
 
 // Encoding TL_phone_acceptCall
@@ -15659,7 +18507,7 @@ func (e TL_phone_acceptCall) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_phone_acceptCall)
 	x.Bytes(e.Peer.encode())
-	x.Bytes(e.G_b)
+	x.StringBytes(e.G_b)
 	x.Bytes(e.Protocol.encode())
 	return x.buf
 }
@@ -15669,10 +18517,10 @@ func (e TL_phone_acceptCall) encode() []byte {
 const crc_phone_confirmCall = 0x2efe1722
 
 type TL_phone_confirmCall struct {
-	Peer            TL     // peer:InputPhoneCall
-	G_a             []byte // g_a:bytes
-	Key_fingerprint int64  // key_fingerprint:long
-	Protocol        TL     // protocol:PhoneCallProtocol
+	Peer            InputPhoneCall    // peer:InputPhoneCall
+	G_a             []byte            // g_a:bytes
+	Key_fingerprint int64             // key_fingerprint:long
+	Protocol        PhoneCallProtocol // protocol:PhoneCallProtocol
 }
 
 // Encoding TL_phone_confirmCall
@@ -15680,7 +18528,7 @@ func (e TL_phone_confirmCall) encode() []byte {
 	x := NewEncodeBuf(512)
 	x.UInt(crc_phone_confirmCall)
 	x.Bytes(e.Peer.encode())
-	x.Bytes(e.G_a)
+	x.StringBytes(e.G_a)
 	x.Long(e.Key_fingerprint)
 	x.Bytes(e.Protocol.encode())
 	return x.buf
@@ -15691,7 +18539,7 @@ func (e TL_phone_confirmCall) encode() []byte {
 const crc_phone_receivedCall = 0x17d54f61
 
 type TL_phone_receivedCall struct {
-	Peer TL // peer:InputPhoneCall
+	Peer InputPhoneCall // peer:InputPhoneCall
 }
 
 // Encoding TL_phone_receivedCall
@@ -15707,10 +18555,10 @@ func (e TL_phone_receivedCall) encode() []byte {
 const crc_phone_discardCall = 0x78d413a6
 
 type TL_phone_discardCall struct {
-	Peer          TL    // peer:InputPhoneCall
-	Duration      int32 // duration:int
-	Reason        TL    // reason:PhoneCallDiscardReason
-	Connection_id int64 // connection_id:long
+	Peer          InputPhoneCall         // peer:InputPhoneCall
+	Duration      int32                  // duration:int
+	Reason        PhoneCallDiscardReason // reason:PhoneCallDiscardReason
+	Connection_id int64                  // connection_id:long
 }
 
 // Encoding TL_phone_discardCall
@@ -15729,9 +18577,9 @@ func (e TL_phone_discardCall) encode() []byte {
 const crc_phone_setCallRating = 0x1c536a34
 
 type TL_phone_setCallRating struct {
-	Peer    TL     // peer:InputPhoneCall
-	Rating  int32  // rating:int
-	Comment string // comment:string
+	Peer    InputPhoneCall // peer:InputPhoneCall
+	Rating  int32          // rating:int
+	Comment string         // comment:string
 }
 
 // Encoding TL_phone_setCallRating
@@ -15749,8 +18597,8 @@ func (e TL_phone_setCallRating) encode() []byte {
 const crc_phone_saveCallDebug = 0x277add7e
 
 type TL_phone_saveCallDebug struct {
-	Peer  TL // peer:InputPhoneCall
-	Debug TL // debug:DataJSON
+	Peer  InputPhoneCall // peer:InputPhoneCall
+	Debug DataJSON       // debug:DataJSON
 }
 
 // Encoding TL_phone_saveCallDebug
@@ -15761,6 +18609,7 @@ func (e TL_phone_saveCallDebug) encode() []byte {
 	x.Bytes(e.Debug.encode())
 	return x.buf
 }
+
 func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	switch constructor {
 	case crc_boolFalse:
@@ -15827,11 +18676,11 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_inputMediaEmpty{}
 	case crc_inputMediaUploadedPhoto:
 		flags := m.Int()
-		file := m.Object()
+		file := m.objectInputFile()
 		caption := m.String()
-		var stickers []TL
+		var stickers []InputDocument
 		if flags&(1<<0) != 0 {
-			stickers = m.Vector()
+			stickers = m.vectorInputDocument()
 		}
 		r = TL_inputMediaUploadedPhoto{
 			Flags:    flags,
@@ -15841,12 +18690,12 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_inputMediaPhoto:
 		r = TL_inputMediaPhoto{
-			Id:      m.Object(),
+			Id:      m.objectInputPhoto(),
 			Caption: m.String(),
 		}
 	case crc_inputMediaGeoPoint:
 		r = TL_inputMediaGeoPoint{
-			Geo_point: m.Object(),
+			Geo_point: m.objectInputGeoPoint(),
 		}
 	case crc_inputMediaContact:
 		r = TL_inputMediaContact{
@@ -15856,13 +18705,13 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_inputMediaUploadedDocument:
 		flags := m.Int()
-		file := m.Object()
+		file := m.objectInputFile()
 		mime_type := m.String()
-		attributes := m.Vector()
+		attributes := m.vectorDocumentAttribute()
 		caption := m.String()
-		var stickers []TL
+		var stickers []InputDocument
 		if flags&(1<<0) != 0 {
-			stickers = m.Vector()
+			stickers = m.vectorInputDocument()
 		}
 		r = TL_inputMediaUploadedDocument{
 			Flags:      flags,
@@ -15874,14 +18723,14 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_inputMediaUploadedThumbDocument:
 		flags := m.Int()
-		file := m.Object()
-		thumb := m.Object()
+		file := m.objectInputFile()
+		thumb := m.objectInputFile()
 		mime_type := m.String()
-		attributes := m.Vector()
+		attributes := m.vectorDocumentAttribute()
 		caption := m.String()
-		var stickers []TL
+		var stickers []InputDocument
 		if flags&(1<<0) != 0 {
-			stickers = m.Vector()
+			stickers = m.vectorInputDocument()
 		}
 		r = TL_inputMediaUploadedThumbDocument{
 			Flags:      flags,
@@ -15894,12 +18743,12 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_inputMediaDocument:
 		r = TL_inputMediaDocument{
-			Id:      m.Object(),
+			Id:      m.objectInputDocument(),
 			Caption: m.String(),
 		}
 	case crc_inputMediaVenue:
 		r = TL_inputMediaVenue{
-			Geo_point: m.Object(),
+			Geo_point: m.objectInputGeoPoint(),
 			Title:     m.String(),
 			Address:   m.String(),
 			Provider:  m.String(),
@@ -15922,17 +18771,17 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_inputMediaGame:
 		r = TL_inputMediaGame{
-			Id: m.Object(),
+			Id: m.objectInputGame(),
 		}
 	case crc_inputMediaInvoice:
 		flags := m.Int()
 		title := m.String()
 		description := m.String()
-		var photo TL
+		var photo InputWebDocument
 		if flags&(1<<0) != 0 {
-			photo = m.Object()
+			photo = m.objectInputWebDocument()
 		}
-		invoice := m.Object()
+		invoice := m.objectInvoice()
 		payload := m.StringBytes()
 		provider := m.String()
 		start_param := m.String()
@@ -15950,11 +18799,11 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_inputChatPhotoEmpty{}
 	case crc_inputChatUploadedPhoto:
 		r = TL_inputChatUploadedPhoto{
-			File: m.Object(),
+			File: m.objectInputFile(),
 		}
 	case crc_inputChatPhoto:
 		r = TL_inputChatPhoto{
-			Id: m.Object(),
+			Id: m.objectInputPhoto(),
 		}
 	case crc_inputGeoPointEmpty:
 		r = TL_inputGeoPointEmpty{}
@@ -16077,13 +18926,13 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<4) != 0 {
 			phone = m.String()
 		}
-		var photo TL
+		var photo UserProfilePhoto
 		if flags&(1<<5) != 0 {
-			photo = m.Object()
+			photo = m.objectUserProfilePhoto()
 		}
-		var status TL
+		var status UserStatus
 		if flags&(1<<6) != 0 {
-			status = m.Object()
+			status = m.objectUserStatus()
 		}
 		var bot_info_version int32
 		if flags&(1<<14) != 0 {
@@ -16127,8 +18976,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_userProfilePhoto:
 		r = TL_userProfilePhoto{
 			Photo_id:    m.Long(),
-			Photo_small: m.Object(),
-			Photo_big:   m.Object(),
+			Photo_small: m.objectFileLocation(),
+			Photo_big:   m.objectFileLocation(),
 		}
 	case crc_userStatusEmpty:
 		r = TL_userStatusEmpty{}
@@ -16160,13 +19009,13 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		deactivated := flags&(1<<5) != 0
 		id := m.Int()
 		title := m.String()
-		photo := m.Object()
+		photo := m.objectChatPhoto()
 		participants_count := m.Int()
 		date := m.Int()
 		version := m.Int()
-		var migrated_to TL
+		var migrated_to InputChannel
 		if flags&(1<<6) != 0 {
-			migrated_to = m.Object()
+			migrated_to = m.objectInputChannel()
 		}
 		r = TL_chat{
 			Flags:              flags,
@@ -16213,7 +19062,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<6) != 0 {
 			username = m.String()
 		}
-		photo := m.Object()
+		photo := m.objectChatPhoto()
 		date := m.Int()
 		version := m.Int()
 		var restriction_reason string
@@ -16261,11 +19110,11 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_chatFull:
 		r = TL_chatFull{
 			Id:              m.Int(),
-			Participants:    m.Object(),
-			Chat_photo:      m.Object(),
-			Notify_settings: m.Object(),
-			Exported_invite: m.Object(),
-			Bot_info:        m.Vector(),
+			Participants:    m.objectChatParticipants(),
+			Chat_photo:      m.objectPhoto(),
+			Notify_settings: m.objectPeerNotifySettings(),
+			Exported_invite: m.objectExportedChatInvite(),
+			Bot_info:        m.vectorBotInfo(),
 		}
 	case crc_channelFull:
 		flags := m.Int()
@@ -16288,10 +19137,10 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		read_inbox_max_id := m.Int()
 		read_outbox_max_id := m.Int()
 		unread_count := m.Int()
-		chat_photo := m.Object()
-		notify_settings := m.Object()
-		exported_invite := m.Object()
-		bot_info := m.Vector()
+		chat_photo := m.objectPhoto()
+		notify_settings := m.objectPeerNotifySettings()
+		exported_invite := m.objectExportedChatInvite()
+		bot_info := m.vectorBotInfo()
 		var migrated_from_chat_id int32
 		if flags&(1<<4) != 0 {
 			migrated_from_chat_id = m.Int()
@@ -16305,7 +19154,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 			pinned_msg_id = m.Int()
 		}
 		r = TL_channelFull{
-			Flags: flags,
+			Flags:                 flags,
 			Can_view_participants: can_view_participants,
 			Can_set_username:      can_set_username,
 			Id:                    id,
@@ -16343,9 +19192,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_chatParticipantsForbidden:
 		flags := m.Int()
 		chat_id := m.Int()
-		var self_participant TL
+		var self_participant ChatParticipant
 		if flags&(1<<0) != 0 {
-			self_participant = m.Object()
+			self_participant = m.objectChatParticipant()
 		}
 		r = TL_chatParticipantsForbidden{
 			Flags:            flags,
@@ -16355,15 +19204,15 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_chatParticipants:
 		r = TL_chatParticipants{
 			Chat_id:      m.Int(),
-			Participants: m.Vector(),
+			Participants: m.vectorChatParticipant(),
 			Version:      m.Int(),
 		}
 	case crc_chatPhotoEmpty:
 		r = TL_chatPhotoEmpty{}
 	case crc_chatPhoto:
 		r = TL_chatPhoto{
-			Photo_small: m.Object(),
-			Photo_big:   m.Object(),
+			Photo_small: m.objectFileLocation(),
+			Photo_big:   m.objectFileLocation(),
 		}
 	case crc_messageEmpty:
 		r = TL_messageEmpty{
@@ -16381,10 +19230,10 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<8) != 0 {
 			from_id = m.Int()
 		}
-		to_id := m.Object()
-		var fwd_from TL
+		to_id := m.objectPeer()
+		var fwd_from MessageFwdHeader
 		if flags&(1<<2) != 0 {
-			fwd_from = m.Object()
+			fwd_from = m.objectMessageFwdHeader()
 		}
 		var via_bot_id int32
 		if flags&(1<<11) != 0 {
@@ -16396,17 +19245,17 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 		date := m.Int()
 		message := m.String()
-		var media TL
+		var media MessageMedia
 		if flags&(1<<9) != 0 {
-			media = m.Object()
+			media = m.objectMessageMedia()
 		}
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<6) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
-		var entities []TL
+		var entities []MessageEntity
 		if flags&(1<<7) != 0 {
-			entities = m.Vector()
+			entities = m.vectorMessageEntity()
 		}
 		var views int32
 		if flags&(1<<10) != 0 {
@@ -16449,13 +19298,13 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<8) != 0 {
 			from_id = m.Int()
 		}
-		to_id := m.Object()
+		to_id := m.objectPeer()
 		var reply_to_msg_id int32
 		if flags&(1<<3) != 0 {
 			reply_to_msg_id = m.Int()
 		}
 		date := m.Int()
-		action := m.Object()
+		action := m.objectMessageAction()
 		r = TL_messageService{
 			Flags:           flags,
 			Out:             out,
@@ -16474,12 +19323,12 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_messageMediaEmpty{}
 	case crc_messageMediaPhoto:
 		r = TL_messageMediaPhoto{
-			Photo:   m.Object(),
+			Photo:   m.objectPhoto(),
 			Caption: m.String(),
 		}
 	case crc_messageMediaGeo:
 		r = TL_messageMediaGeo{
-			Geo: m.Object(),
+			Geo: m.objectGeoPoint(),
 		}
 	case crc_messageMediaContact:
 		r = TL_messageMediaContact{
@@ -16492,16 +19341,16 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_messageMediaUnsupported{}
 	case crc_messageMediaDocument:
 		r = TL_messageMediaDocument{
-			Document: m.Object(),
+			Document: m.objectDocument(),
 			Caption:  m.String(),
 		}
 	case crc_messageMediaWebPage:
 		r = TL_messageMediaWebPage{
-			Webpage: m.Object(),
+			Webpage: m.objectWebPage(),
 		}
 	case crc_messageMediaVenue:
 		r = TL_messageMediaVenue{
-			Geo:      m.Object(),
+			Geo:      m.objectGeoPoint(),
 			Title:    m.String(),
 			Address:  m.String(),
 			Provider: m.String(),
@@ -16509,7 +19358,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messageMediaGame:
 		r = TL_messageMediaGame{
-			Game: m.Object(),
+			Game: m.objectGame(),
 		}
 	case crc_messageMediaInvoice:
 		flags := m.Int()
@@ -16517,9 +19366,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		test := flags&(1<<3) != 0
 		title := m.String()
 		description := m.String()
-		var photo TL
+		var photo WebDocument
 		if flags&(1<<0) != 0 {
-			photo = m.Object()
+			photo = m.objectWebDocument()
 		}
 		var receipt_msg_id int32
 		if flags&(1<<2) != 0 {
@@ -16529,16 +19378,16 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		total_amount := m.Long()
 		start_param := m.String()
 		r = TL_messageMediaInvoice{
-			Flags: flags,
+			Flags:                      flags,
 			Shipping_address_requested: shipping_address_requested,
-			Test:           test,
-			Title:          title,
-			Description:    description,
-			Photo:          photo,
-			Receipt_msg_id: receipt_msg_id,
-			Currency:       currency,
-			Total_amount:   total_amount,
-			Start_param:    start_param,
+			Test:                       test,
+			Title:                      title,
+			Description:                description,
+			Photo:                      photo,
+			Receipt_msg_id:             receipt_msg_id,
+			Currency:                   currency,
+			Total_amount:               total_amount,
+			Start_param:                start_param,
 		}
 	case crc_messageActionEmpty:
 		r = TL_messageActionEmpty{}
@@ -16553,7 +19402,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messageActionChatEditPhoto:
 		r = TL_messageActionChatEditPhoto{
-			Photo: m.Object(),
+			Photo: m.objectPhoto(),
 		}
 	case crc_messageActionChatDeletePhoto:
 		r = TL_messageActionChatDeletePhoto{}
@@ -16596,15 +19445,15 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		currency := m.String()
 		total_amount := m.Long()
 		payload := m.StringBytes()
-		var info TL
+		var info PaymentRequestedInfo
 		if flags&(1<<0) != 0 {
-			info = m.Object()
+			info = m.objectPaymentRequestedInfo()
 		}
 		var shipping_option_id string
 		if flags&(1<<1) != 0 {
 			shipping_option_id = m.String()
 		}
-		charge := m.Object()
+		charge := m.objectPaymentCharge()
 		r = TL_messageActionPaymentSentMe{
 			Flags:              flags,
 			Currency:           currency,
@@ -16622,9 +19471,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_messageActionPhoneCall:
 		flags := m.Int()
 		call_id := m.Long()
-		var reason TL
+		var reason PhoneCallDiscardReason
 		if flags&(1<<0) != 0 {
-			reason = m.Object()
+			reason = m.objectPhoneCallDiscardReason()
 		}
 		var duration int32
 		if flags&(1<<1) != 0 {
@@ -16639,19 +19488,19 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_dialog:
 		flags := m.Int()
 		pinned := flags&(1<<2) != 0
-		peer := m.Object()
+		peer := m.objectPeer()
 		top_message := m.Int()
 		read_inbox_max_id := m.Int()
 		read_outbox_max_id := m.Int()
 		unread_count := m.Int()
-		notify_settings := m.Object()
+		notify_settings := m.objectPeerNotifySettings()
 		var pts int32
 		if flags&(1<<0) != 0 {
 			pts = m.Int()
 		}
-		var draft TL
+		var draft DraftMessage
 		if flags&(1<<1) != 0 {
-			draft = m.Object()
+			draft = m.objectDraftMessage()
 		}
 		r = TL_dialog{
 			Flags:              flags,
@@ -16675,7 +19524,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		id := m.Long()
 		access_hash := m.Long()
 		date := m.Int()
-		sizes := m.Vector()
+		sizes := m.vectorPhotoSize()
 		r = TL_photo{
 			Flags:        flags,
 			Has_stickers: has_stickers,
@@ -16691,7 +19540,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_photoSize:
 		r = TL_photoSize{
 			Code_type: m.String(),
-			Location:  m.Object(),
+			Location:  m.objectFileLocation(),
 			W:         m.Int(),
 			H:         m.Int(),
 			Size:      m.Int(),
@@ -16699,7 +19548,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_photoCachedSize:
 		r = TL_photoCachedSize{
 			Code_type: m.String(),
-			Location:  m.Object(),
+			Location:  m.objectFileLocation(),
 			W:         m.Int(),
 			H:         m.Int(),
 			Bytes:     m.StringBytes(),
@@ -16713,16 +19562,16 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_auth_checkedPhone:
 		r = TL_auth_checkedPhone{
-			Phone_registered: m.Object(),
+			Phone_registered: m.objectBool(),
 		}
 	case crc_auth_sentCode:
 		flags := m.Int()
 		phone_registered := flags&(1<<0) != 0
-		code_type := m.Object()
+		code_type := m.objectAuth_SentCodeType()
 		phone_code_hash := m.String()
-		var next_type TL
+		var next_type Auth_CodeType
 		if flags&(1<<1) != 0 {
-			next_type = m.Object()
+			next_type = m.objectAuth_CodeType()
 		}
 		var timeout int32
 		if flags&(1<<2) != 0 {
@@ -16742,7 +19591,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<0) != 0 {
 			tmp_sessions = m.Int()
 		}
-		user := m.Object()
+		user := m.objectUser()
 		r = TL_auth_authorization{
 			Flags:        flags,
 			Tmp_sessions: tmp_sessions,
@@ -16755,7 +19604,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_inputNotifyPeer:
 		r = TL_inputNotifyPeer{
-			Peer: m.Object(),
+			Peer: m.objectInputPeer(),
 		}
 	case crc_inputNotifyUsers:
 		r = TL_inputNotifyUsers{}
@@ -16810,7 +19659,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_wallPaper{
 			Id:    m.Int(),
 			Title: m.String(),
-			Sizes: m.Vector(),
+			Sizes: m.vectorPhotoSize(),
 			Color: m.Int(),
 		}
 	case crc_wallPaperSolid:
@@ -16835,20 +19684,20 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		blocked := flags&(1<<0) != 0
 		phone_calls_available := flags&(1<<4) != 0
 		phone_calls_private := flags&(1<<5) != 0
-		user := m.Object()
+		user := m.objectUser()
 		var about string
 		if flags&(1<<1) != 0 {
 			about = m.String()
 		}
-		link := m.Object()
-		var profile_photo TL
+		link := m.objectContacts_Link()
+		var profile_photo Photo
 		if flags&(1<<2) != 0 {
-			profile_photo = m.Object()
+			profile_photo = m.objectPhoto()
 		}
-		notify_settings := m.Object()
-		var bot_info TL
+		notify_settings := m.objectPeerNotifySettings()
+		var bot_info BotInfo
 		if flags&(1<<3) != 0 {
-			bot_info = m.Object()
+			bot_info = m.objectBotInfo()
 		}
 		common_chats_count := m.Int()
 		r = TL_userFull{
@@ -16867,7 +19716,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_contact:
 		r = TL_contact{
 			User_id: m.Int(),
-			Mutual:  m.Object(),
+			Mutual:  m.objectBool(),
 		}
 	case crc_importedContact:
 		r = TL_importedContact{
@@ -16882,73 +19731,73 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_contactStatus:
 		r = TL_contactStatus{
 			User_id: m.Int(),
-			Status:  m.Object(),
+			Status:  m.objectUserStatus(),
 		}
 	case crc_contacts_link:
 		r = TL_contacts_link{
-			My_link:      m.Object(),
-			Foreign_link: m.Object(),
-			User:         m.Object(),
+			My_link:      m.objectContactLink(),
+			Foreign_link: m.objectContactLink(),
+			User:         m.objectUser(),
 		}
 	case crc_contacts_contactsNotModified:
 		r = TL_contacts_contactsNotModified{}
 	case crc_contacts_contacts:
 		r = TL_contacts_contacts{
-			Contacts: m.Vector(),
-			Users:    m.Vector(),
+			Contacts: m.vectorContact(),
+			Users:    m.vectorUser(),
 		}
 	case crc_contacts_importedContacts:
 		r = TL_contacts_importedContacts{
-			Imported:       m.Vector(),
+			Imported:       m.vectorImportedContact(),
 			Retry_contacts: m.VectorLong(),
-			Users:          m.Vector(),
+			Users:          m.vectorUser(),
 		}
 	case crc_contacts_blocked:
 		r = TL_contacts_blocked{
-			Blocked: m.Vector(),
-			Users:   m.Vector(),
+			Blocked: m.vectorContactBlocked(),
+			Users:   m.vectorUser(),
 		}
 	case crc_contacts_blockedSlice:
 		r = TL_contacts_blockedSlice{
 			Count:   m.Int(),
-			Blocked: m.Vector(),
-			Users:   m.Vector(),
+			Blocked: m.vectorContactBlocked(),
+			Users:   m.vectorUser(),
 		}
 	case crc_messages_dialogs:
 		r = TL_messages_dialogs{
-			Dialogs:  m.Vector(),
-			Messages: m.Vector(),
-			Chats:    m.Vector(),
-			Users:    m.Vector(),
+			Dialogs:  m.vectorDialog(),
+			Messages: m.vectorMessage(),
+			Chats:    m.vectorChat(),
+			Users:    m.vectorUser(),
 		}
 	case crc_messages_dialogsSlice:
 		r = TL_messages_dialogsSlice{
 			Count:    m.Int(),
-			Dialogs:  m.Vector(),
-			Messages: m.Vector(),
-			Chats:    m.Vector(),
-			Users:    m.Vector(),
+			Dialogs:  m.vectorDialog(),
+			Messages: m.vectorMessage(),
+			Chats:    m.vectorChat(),
+			Users:    m.vectorUser(),
 		}
 	case crc_messages_messages:
 		r = TL_messages_messages{
-			Messages: m.Vector(),
-			Chats:    m.Vector(),
-			Users:    m.Vector(),
+			Messages: m.vectorMessage(),
+			Chats:    m.vectorChat(),
+			Users:    m.vectorUser(),
 		}
 	case crc_messages_messagesSlice:
 		r = TL_messages_messagesSlice{
 			Count:    m.Int(),
-			Messages: m.Vector(),
-			Chats:    m.Vector(),
-			Users:    m.Vector(),
+			Messages: m.vectorMessage(),
+			Chats:    m.vectorChat(),
+			Users:    m.vectorUser(),
 		}
 	case crc_messages_channelMessages:
 		flags := m.Int()
 		pts := m.Int()
 		count := m.Int()
-		messages := m.Vector()
-		chats := m.Vector()
-		users := m.Vector()
+		messages := m.vectorMessage()
+		chats := m.vectorChat()
+		users := m.vectorUser()
 		r = TL_messages_channelMessages{
 			Flags:    flags,
 			Pts:      pts,
@@ -16959,18 +19808,18 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_chats:
 		r = TL_messages_chats{
-			Chats: m.Vector(),
+			Chats: m.vectorChat(),
 		}
 	case crc_messages_chatsSlice:
 		r = TL_messages_chatsSlice{
 			Count: m.Int(),
-			Chats: m.Vector(),
+			Chats: m.vectorChat(),
 		}
 	case crc_messages_chatFull:
 		r = TL_messages_chatFull{
-			Full_chat: m.Object(),
-			Chats:     m.Vector(),
-			Users:     m.Vector(),
+			Full_chat: m.objectChatFull(),
+			Chats:     m.vectorChat(),
+			Users:     m.vectorUser(),
 		}
 	case crc_messages_affectedHistory:
 		r = TL_messages_affectedHistory{
@@ -17009,7 +19858,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updateNewMessage:
 		r = TL_updateNewMessage{
-			Message:   m.Object(),
+			Message:   m.objectMessage(),
 			Pts:       m.Int(),
 			Pts_count: m.Int(),
 		}
@@ -17027,22 +19876,22 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_updateUserTyping:
 		r = TL_updateUserTyping{
 			User_id: m.Int(),
-			Action:  m.Object(),
+			Action:  m.objectSendMessageAction(),
 		}
 	case crc_updateChatUserTyping:
 		r = TL_updateChatUserTyping{
 			Chat_id: m.Int(),
 			User_id: m.Int(),
-			Action:  m.Object(),
+			Action:  m.objectSendMessageAction(),
 		}
 	case crc_updateChatParticipants:
 		r = TL_updateChatParticipants{
-			Participants: m.Object(),
+			Participants: m.objectChatParticipants(),
 		}
 	case crc_updateUserStatus:
 		r = TL_updateUserStatus{
 			User_id: m.Int(),
-			Status:  m.Object(),
+			Status:  m.objectUserStatus(),
 		}
 	case crc_updateUserName:
 		r = TL_updateUserName{
@@ -17055,8 +19904,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_updateUserPhoto{
 			User_id:  m.Int(),
 			Date:     m.Int(),
-			Photo:    m.Object(),
-			Previous: m.Object(),
+			Photo:    m.objectUserProfilePhoto(),
+			Previous: m.objectBool(),
 		}
 	case crc_updateContactRegistered:
 		r = TL_updateContactRegistered{
@@ -17066,12 +19915,12 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_updateContactLink:
 		r = TL_updateContactLink{
 			User_id:      m.Int(),
-			My_link:      m.Object(),
-			Foreign_link: m.Object(),
+			My_link:      m.objectContactLink(),
+			Foreign_link: m.objectContactLink(),
 		}
 	case crc_updateNewEncryptedMessage:
 		r = TL_updateNewEncryptedMessage{
-			Message: m.Object(),
+			Message: m.objectEncryptedMessage(),
 			Qts:     m.Int(),
 		}
 	case crc_updateEncryptedChatTyping:
@@ -17080,7 +19929,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updateEncryption:
 		r = TL_updateEncryption{
-			Chat: m.Object(),
+			Chat: m.objectEncryptedChat(),
 			Date: m.Int(),
 		}
 	case crc_updateEncryptedMessagesRead:
@@ -17105,17 +19954,17 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updateDcOptions:
 		r = TL_updateDcOptions{
-			Dc_options: m.Vector(),
+			Dc_options: m.vectorDcOption(),
 		}
 	case crc_updateUserBlocked:
 		r = TL_updateUserBlocked{
 			User_id: m.Int(),
-			Blocked: m.Object(),
+			Blocked: m.objectBool(),
 		}
 	case crc_updateNotifySettings:
 		r = TL_updateNotifySettings{
-			Peer:            m.Object(),
-			Notify_settings: m.Object(),
+			Peer:            m.objectNotifyPeer(),
+			Notify_settings: m.objectPeerNotifySettings(),
 		}
 	case crc_updateServiceNotification:
 		flags := m.Int()
@@ -17126,8 +19975,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 		code_type := m.String()
 		message := m.String()
-		media := m.Object()
-		entities := m.Vector()
+		media := m.objectMessageMedia()
+		entities := m.vectorMessageEntity()
 		r = TL_updateServiceNotification{
 			Flags:      flags,
 			Popup:      popup,
@@ -17139,8 +19988,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updatePrivacy:
 		r = TL_updatePrivacy{
-			Key:   m.Object(),
-			Rules: m.Vector(),
+			Key:   m.objectPrivacyKey(),
+			Rules: m.vectorPrivacyRule(),
 		}
 	case crc_updateUserPhone:
 		r = TL_updateUserPhone{
@@ -17149,21 +19998,21 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updateReadHistoryInbox:
 		r = TL_updateReadHistoryInbox{
-			Peer:      m.Object(),
+			Peer:      m.objectPeer(),
 			Max_id:    m.Int(),
 			Pts:       m.Int(),
 			Pts_count: m.Int(),
 		}
 	case crc_updateReadHistoryOutbox:
 		r = TL_updateReadHistoryOutbox{
-			Peer:      m.Object(),
+			Peer:      m.objectPeer(),
 			Max_id:    m.Int(),
 			Pts:       m.Int(),
 			Pts_count: m.Int(),
 		}
 	case crc_updateWebPage:
 		r = TL_updateWebPage{
-			Webpage:   m.Object(),
+			Webpage:   m.objectWebPage(),
 			Pts:       m.Int(),
 			Pts_count: m.Int(),
 		}
@@ -17191,7 +20040,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updateNewChannelMessage:
 		r = TL_updateNewChannelMessage{
-			Message:   m.Object(),
+			Message:   m.objectMessage(),
 			Pts:       m.Int(),
 			Pts_count: m.Int(),
 		}
@@ -17216,19 +20065,19 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_updateChatAdmins:
 		r = TL_updateChatAdmins{
 			Chat_id: m.Int(),
-			Enabled: m.Object(),
+			Enabled: m.objectBool(),
 			Version: m.Int(),
 		}
 	case crc_updateChatParticipantAdmin:
 		r = TL_updateChatParticipantAdmin{
 			Chat_id:  m.Int(),
 			User_id:  m.Int(),
-			Is_admin: m.Object(),
+			Is_admin: m.objectBool(),
 			Version:  m.Int(),
 		}
 	case crc_updateNewStickerSet:
 		r = TL_updateNewStickerSet{
-			Stickerset: m.Object(),
+			Stickerset: m.objectMessages_StickerSet(),
 		}
 	case crc_updateStickerSetsOrder:
 		flags := m.Int()
@@ -17248,9 +20097,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		query_id := m.Long()
 		user_id := m.Int()
 		query := m.String()
-		var geo TL
+		var geo GeoPoint
 		if flags&(1<<0) != 0 {
-			geo = m.Object()
+			geo = m.objectGeoPoint()
 		}
 		offset := m.String()
 		r = TL_updateBotInlineQuery{
@@ -17265,14 +20114,14 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		flags := m.Int()
 		user_id := m.Int()
 		query := m.String()
-		var geo TL
+		var geo GeoPoint
 		if flags&(1<<0) != 0 {
-			geo = m.Object()
+			geo = m.objectGeoPoint()
 		}
 		id := m.String()
-		var msg_id TL
+		var msg_id InputBotInlineMessageID
 		if flags&(1<<1) != 0 {
-			msg_id = m.Object()
+			msg_id = m.objectInputBotInlineMessageID()
 		}
 		r = TL_updateBotInlineSend{
 			Flags:   flags,
@@ -17284,7 +20133,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updateEditChannelMessage:
 		r = TL_updateEditChannelMessage{
-			Message:   m.Object(),
+			Message:   m.objectMessage(),
 			Pts:       m.Int(),
 			Pts_count: m.Int(),
 		}
@@ -17297,7 +20146,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		flags := m.Int()
 		query_id := m.Long()
 		user_id := m.Int()
-		peer := m.Object()
+		peer := m.objectPeer()
 		msg_id := m.Int()
 		chat_instance := m.Long()
 		var data []byte
@@ -17320,7 +20169,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updateEditMessage:
 		r = TL_updateEditMessage{
-			Message:   m.Object(),
+			Message:   m.objectMessage(),
 			Pts:       m.Int(),
 			Pts_count: m.Int(),
 		}
@@ -17328,7 +20177,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		flags := m.Int()
 		query_id := m.Long()
 		user_id := m.Int()
-		msg_id := m.Object()
+		msg_id := m.objectInputBotInlineMessageID()
 		chat_instance := m.Long()
 		var data []byte
 		if flags&(1<<0) != 0 {
@@ -17354,8 +20203,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updateDraftMessage:
 		r = TL_updateDraftMessage{
-			Peer:  m.Object(),
-			Draft: m.Object(),
+			Peer:  m.objectPeer(),
+			Draft: m.objectDraftMessage(),
 		}
 	case crc_updateReadFeaturedStickers:
 		r = TL_updateReadFeaturedStickers{}
@@ -17368,14 +20217,14 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_updateChannelWebPage:
 		r = TL_updateChannelWebPage{
 			Channel_id: m.Int(),
-			Webpage:    m.Object(),
+			Webpage:    m.objectWebPage(),
 			Pts:        m.Int(),
 			Pts_count:  m.Int(),
 		}
 	case crc_updateDialogPinned:
 		flags := m.Int()
 		pinned := flags&(1<<0) != 0
-		peer := m.Object()
+		peer := m.objectPeer()
 		r = TL_updateDialogPinned{
 			Flags:  flags,
 			Pinned: pinned,
@@ -17383,9 +20232,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updatePinnedDialogs:
 		flags := m.Int()
-		var order []TL
+		var order []Peer
 		if flags&(1<<0) != 0 {
-			order = m.Vector()
+			order = m.vectorPeer()
 		}
 		r = TL_updatePinnedDialogs{
 			Flags: flags,
@@ -17393,12 +20242,12 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updateBotWebhookJSON:
 		r = TL_updateBotWebhookJSON{
-			Data: m.Object(),
+			Data: m.objectDataJSON(),
 		}
 	case crc_updateBotWebhookJSONQuery:
 		r = TL_updateBotWebhookJSONQuery{
 			Query_id: m.Long(),
-			Data:     m.Object(),
+			Data:     m.objectDataJSON(),
 			Timeout:  m.Int(),
 		}
 	case crc_updateBotShippingQuery:
@@ -17406,16 +20255,16 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 			Query_id:         m.Long(),
 			User_id:          m.Int(),
 			Payload:          m.StringBytes(),
-			Shipping_address: m.Object(),
+			Shipping_address: m.objectPostAddress(),
 		}
 	case crc_updateBotPrecheckoutQuery:
 		flags := m.Int()
 		query_id := m.Long()
 		user_id := m.Int()
 		payload := m.StringBytes()
-		var info TL
+		var info PaymentRequestedInfo
 		if flags&(1<<0) != 0 {
-			info = m.Object()
+			info = m.objectPaymentRequestedInfo()
 		}
 		var shipping_option_id string
 		if flags&(1<<1) != 0 {
@@ -17435,7 +20284,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updatePhoneCall:
 		r = TL_updatePhoneCall{
-			Phone_call: m.Object(),
+			Phone_call: m.objectPhoneCall(),
 		}
 	case crc_updates_state:
 		r = TL_updates_state{
@@ -17452,21 +20301,21 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updates_difference:
 		r = TL_updates_difference{
-			New_messages:           m.Vector(),
-			New_encrypted_messages: m.Vector(),
-			Other_updates:          m.Vector(),
-			Chats:                  m.Vector(),
-			Users:                  m.Vector(),
-			State:                  m.Object(),
+			New_messages:           m.vectorMessage(),
+			New_encrypted_messages: m.vectorEncryptedMessage(),
+			Other_updates:          m.vectorUpdate(),
+			Chats:                  m.vectorChat(),
+			Users:                  m.vectorUser(),
+			State:                  m.objectUpdates_State(),
 		}
 	case crc_updates_differenceSlice:
 		r = TL_updates_differenceSlice{
-			New_messages:           m.Vector(),
-			New_encrypted_messages: m.Vector(),
-			Other_updates:          m.Vector(),
-			Chats:                  m.Vector(),
-			Users:                  m.Vector(),
-			Intermediate_state:     m.Object(),
+			New_messages:           m.vectorMessage(),
+			New_encrypted_messages: m.vectorEncryptedMessage(),
+			Other_updates:          m.vectorUpdate(),
+			Chats:                  m.vectorChat(),
+			Users:                  m.vectorUser(),
+			Intermediate_state:     m.objectUpdates_State(),
 		}
 	case crc_updates_differenceTooLong:
 		r = TL_updates_differenceTooLong{
@@ -17486,9 +20335,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		pts := m.Int()
 		pts_count := m.Int()
 		date := m.Int()
-		var fwd_from TL
+		var fwd_from MessageFwdHeader
 		if flags&(1<<2) != 0 {
-			fwd_from = m.Object()
+			fwd_from = m.objectMessageFwdHeader()
 		}
 		var via_bot_id int32
 		if flags&(1<<11) != 0 {
@@ -17498,9 +20347,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<3) != 0 {
 			reply_to_msg_id = m.Int()
 		}
-		var entities []TL
+		var entities []MessageEntity
 		if flags&(1<<7) != 0 {
-			entities = m.Vector()
+			entities = m.vectorMessageEntity()
 		}
 		r = TL_updateShortMessage{
 			Flags:           flags,
@@ -17532,9 +20381,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		pts := m.Int()
 		pts_count := m.Int()
 		date := m.Int()
-		var fwd_from TL
+		var fwd_from MessageFwdHeader
 		if flags&(1<<2) != 0 {
-			fwd_from = m.Object()
+			fwd_from = m.objectMessageFwdHeader()
 		}
 		var via_bot_id int32
 		if flags&(1<<11) != 0 {
@@ -17544,9 +20393,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<3) != 0 {
 			reply_to_msg_id = m.Int()
 		}
-		var entities []TL
+		var entities []MessageEntity
 		if flags&(1<<7) != 0 {
-			entities = m.Vector()
+			entities = m.vectorMessageEntity()
 		}
 		r = TL_updateShortChatMessage{
 			Flags:           flags,
@@ -17568,23 +20417,23 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_updateShort:
 		r = TL_updateShort{
-			Update: m.Object(),
+			Update: m.objectUpdate(),
 			Date:   m.Int(),
 		}
 	case crc_updatesCombined:
 		r = TL_updatesCombined{
-			Updates:   m.Vector(),
-			Users:     m.Vector(),
-			Chats:     m.Vector(),
+			Updates:   m.vectorUpdate(),
+			Users:     m.vectorUser(),
+			Chats:     m.vectorChat(),
 			Date:      m.Int(),
 			Seq_start: m.Int(),
 			Seq:       m.Int(),
 		}
 	case crc_updates:
 		r = TL_updates{
-			Updates: m.Vector(),
-			Users:   m.Vector(),
-			Chats:   m.Vector(),
+			Updates: m.vectorUpdate(),
+			Users:   m.vectorUser(),
+			Chats:   m.vectorChat(),
 			Date:    m.Int(),
 			Seq:     m.Int(),
 		}
@@ -17595,13 +20444,13 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		pts := m.Int()
 		pts_count := m.Int()
 		date := m.Int()
-		var media TL
+		var media MessageMedia
 		if flags&(1<<9) != 0 {
-			media = m.Object()
+			media = m.objectMessageMedia()
 		}
-		var entities []TL
+		var entities []MessageEntity
 		if flags&(1<<7) != 0 {
-			entities = m.Vector()
+			entities = m.vectorMessageEntity()
 		}
 		r = TL_updateShortSentMessage{
 			Flags:     flags,
@@ -17615,23 +20464,23 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_photos_photos:
 		r = TL_photos_photos{
-			Photos: m.Vector(),
-			Users:  m.Vector(),
+			Photos: m.vectorPhoto(),
+			Users:  m.vectorUser(),
 		}
 	case crc_photos_photosSlice:
 		r = TL_photos_photosSlice{
 			Count:  m.Int(),
-			Photos: m.Vector(),
-			Users:  m.Vector(),
+			Photos: m.vectorPhoto(),
+			Users:  m.vectorUser(),
 		}
 	case crc_photos_photo:
 		r = TL_photos_photo{
-			Photo: m.Object(),
-			Users: m.Vector(),
+			Photo: m.objectPhoto(),
+			Users: m.vectorUser(),
 		}
 	case crc_upload_file:
 		r = TL_upload_file{
-			Code_type: m.Object(),
+			Code_type: m.objectStorage_FileType(),
 			Mtime:     m.Int(),
 			Bytes:     m.StringBytes(),
 		}
@@ -17657,9 +20506,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		phonecalls_enabled := flags&(1<<1) != 0
 		date := m.Int()
 		expires := m.Int()
-		test_mode := m.Object()
+		test_mode := m.objectBool()
 		this_dc := m.Int()
-		dc_options := m.Vector()
+		dc_options := m.vectorDcOption()
 		chat_size_max := m.Int()
 		megagroup_size_max := m.Int()
 		forwarded_count_max := m.Int()
@@ -17686,7 +20535,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		call_connect_timeout_ms := m.Int()
 		call_packet_timeout_ms := m.Int()
 		me_url_prefix := m.String()
-		disabled_features := m.Vector()
+		disabled_features := m.vectorDisabledFeature()
 		r = TL_config{
 			Flags:                    flags,
 			Phonecalls_enabled:       phonecalls_enabled,
@@ -17729,7 +20578,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_help_appUpdate:
 		r = TL_help_appUpdate{
 			Id:       m.Int(),
-			Critical: m.Object(),
+			Critical: m.objectBool(),
 			Url:      m.String(),
 			Text:     m.String(),
 		}
@@ -17815,7 +20664,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 			Chat_id:   m.Int(),
 			Date:      m.Int(),
 			Bytes:     m.StringBytes(),
-			File:      m.Object(),
+			File:      m.objectEncryptedFile(),
 		}
 	case crc_encryptedMessageService:
 		r = TL_encryptedMessageService{
@@ -17842,7 +20691,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_messages_sentEncryptedFile:
 		r = TL_messages_sentEncryptedFile{
 			Date: m.Int(),
-			File: m.Object(),
+			File: m.objectEncryptedFile(),
 		}
 	case crc_inputDocumentEmpty:
 		r = TL_inputDocumentEmpty{}
@@ -17862,19 +20711,19 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 			Date:        m.Int(),
 			Mime_type:   m.String(),
 			Size:        m.Int(),
-			Thumb:       m.Object(),
+			Thumb:       m.objectPhotoSize(),
 			Dc_id:       m.Int(),
 			Version:     m.Int(),
-			Attributes:  m.Vector(),
+			Attributes:  m.vectorDocumentAttribute(),
 		}
 	case crc_help_support:
 		r = TL_help_support{
 			Phone_number: m.String(),
-			User:         m.Object(),
+			User:         m.objectUser(),
 		}
 	case crc_notifyPeer:
 		r = TL_notifyPeer{
-			Peer: m.Object(),
+			Peer: m.objectPeer(),
 		}
 	case crc_notifyUsers:
 		r = TL_notifyUsers{}
@@ -17914,9 +20763,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_sendMessageGamePlayAction{}
 	case crc_contacts_found:
 		r = TL_contacts_found{
-			Results: m.Vector(),
-			Chats:   m.Vector(),
-			Users:   m.Vector(),
+			Results: m.vectorPeer(),
+			Chats:   m.vectorChat(),
+			Users:   m.vectorUser(),
 		}
 	case crc_inputPrivacyKeyStatusTimestamp:
 		r = TL_inputPrivacyKeyStatusTimestamp{}
@@ -17936,7 +20785,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_inputPrivacyValueAllowAll{}
 	case crc_inputPrivacyValueAllowUsers:
 		r = TL_inputPrivacyValueAllowUsers{
-			Users: m.Vector(),
+			Users: m.vectorInputUser(),
 		}
 	case crc_inputPrivacyValueDisallowContacts:
 		r = TL_inputPrivacyValueDisallowContacts{}
@@ -17944,7 +20793,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_inputPrivacyValueDisallowAll{}
 	case crc_inputPrivacyValueDisallowUsers:
 		r = TL_inputPrivacyValueDisallowUsers{
-			Users: m.Vector(),
+			Users: m.vectorInputUser(),
 		}
 	case crc_privacyValueAllowContacts:
 		r = TL_privacyValueAllowContacts{}
@@ -17964,8 +20813,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_account_privacyRules:
 		r = TL_account_privacyRules{
-			Rules: m.Vector(),
-			Users: m.Vector(),
+			Rules: m.vectorPrivacyRule(),
+			Users: m.vectorUser(),
 		}
 	case crc_accountDaysTTL:
 		r = TL_accountDaysTTL{
@@ -17982,10 +20831,10 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		flags := m.Int()
 		mask := flags&(1<<1) != 0
 		alt := m.String()
-		stickerset := m.Object()
-		var mask_coords TL
+		stickerset := m.objectInputStickerSet()
+		var mask_coords MaskCoords
 		if flags&(1<<0) != 0 {
-			mask_coords = m.Object()
+			mask_coords = m.objectMaskCoords()
 		}
 		r = TL_documentAttributeSticker{
 			Flags:       flags,
@@ -18035,7 +20884,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_messages_stickers:
 		r = TL_messages_stickers{
 			Hash:     m.String(),
-			Stickers: m.Vector(),
+			Stickers: m.vectorDocument(),
 		}
 	case crc_stickerPack:
 		r = TL_stickerPack{
@@ -18047,7 +20896,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_messages_allStickers:
 		r = TL_messages_allStickers{
 			Hash: m.Int(),
-			Sets: m.Vector(),
+			Sets: m.vectorStickerSet(),
 		}
 	case crc_disabledFeature:
 		r = TL_disabledFeature{
@@ -18098,9 +20947,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<3) != 0 {
 			description = m.String()
 		}
-		var photo TL
+		var photo Photo
 		if flags&(1<<4) != 0 {
-			photo = m.Object()
+			photo = m.objectPhoto()
 		}
 		var embed_url string
 		if flags&(1<<5) != 0 {
@@ -18126,13 +20975,13 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<8) != 0 {
 			author = m.String()
 		}
-		var document TL
+		var document Document
 		if flags&(1<<9) != 0 {
-			document = m.Object()
+			document = m.objectDocument()
 		}
-		var cached_page TL
+		var cached_page Page
 		if flags&(1<<10) != 0 {
-			cached_page = m.Object()
+			cached_page = m.objectPage()
 		}
 		r = TL_webPage{
 			Flags:        flags,
@@ -18174,7 +21023,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_account_authorizations:
 		r = TL_account_authorizations{
-			Authorizations: m.Vector(),
+			Authorizations: m.vectorAuthorization(),
 		}
 	case crc_account_noPassword:
 		r = TL_account_noPassword{
@@ -18186,7 +21035,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 			Current_salt:              m.StringBytes(),
 			New_salt:                  m.StringBytes(),
 			Hint:                      m.String(),
-			Has_recovery:              m.Object(),
+			Has_recovery:              m.objectBool(),
 			Email_unconfirmed_pattern: m.String(),
 		}
 	case crc_account_passwordSettings:
@@ -18235,7 +21084,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_chatInviteAlready:
 		r = TL_chatInviteAlready{
-			Chat: m.Object(),
+			Chat: m.objectChat(),
 		}
 	case crc_chatInvite:
 		flags := m.Int()
@@ -18244,11 +21093,11 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		public := flags&(1<<2) != 0
 		megagroup := flags&(1<<3) != 0
 		title := m.String()
-		photo := m.Object()
+		photo := m.objectChatPhoto()
 		participants_count := m.Int()
-		var participants []TL
+		var participants []User
 		if flags&(1<<4) != 0 {
-			participants = m.Vector()
+			participants = m.vectorUser()
 		}
 		r = TL_chatInvite{
 			Flags:              flags,
@@ -18299,9 +21148,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_stickerSet:
 		r = TL_messages_stickerSet{
-			Set:       m.Object(),
-			Packs:     m.Vector(),
-			Documents: m.Vector(),
+			Set:       m.objectStickerSet(),
+			Packs:     m.vectorStickerPack(),
+			Documents: m.vectorDocument(),
 		}
 	case crc_botCommand:
 		r = TL_botCommand{
@@ -18312,7 +21161,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_botInfo{
 			User_id:     m.Int(),
 			Description: m.String(),
-			Commands:    m.Vector(),
+			Commands:    m.vectorBotCommand(),
 		}
 	case crc_keyboardButton:
 		r = TL_keyboardButton{
@@ -18357,7 +21206,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_keyboardButtonRow:
 		r = TL_keyboardButtonRow{
-			Buttons: m.Vector(),
+			Buttons: m.vectorKeyboardButton(),
 		}
 	case crc_replyKeyboardHide:
 		flags := m.Int()
@@ -18380,7 +21229,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		resize := flags&(1<<0) != 0
 		single_use := flags&(1<<1) != 0
 		selective := flags&(1<<2) != 0
-		rows := m.Vector()
+		rows := m.vectorKeyboardButtonRow()
 		r = TL_replyKeyboardMarkup{
 			Flags:      flags,
 			Resize:     resize,
@@ -18390,7 +21239,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_replyInlineMarkup:
 		r = TL_replyInlineMarkup{
-			Rows: m.Vector(),
+			Rows: m.vectorKeyboardButtonRow(),
 		}
 	case crc_messageEntityUnknown:
 		r = TL_messageEntityUnknown{
@@ -18459,7 +21308,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_inputMessageEntityMentionName{
 			Offset:  m.Int(),
 			Length:  m.Int(),
-			User_id: m.Object(),
+			User_id: m.objectInputUser(),
 		}
 	case crc_inputChannelEmpty:
 		r = TL_inputChannelEmpty{}
@@ -18470,9 +21319,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_contacts_resolvedPeer:
 		r = TL_contacts_resolvedPeer{
-			Peer:  m.Object(),
-			Chats: m.Vector(),
-			Users: m.Vector(),
+			Peer:  m.objectPeer(),
+			Chats: m.vectorChat(),
+			Users: m.vectorUser(),
 		}
 	case crc_messageRange:
 		r = TL_messageRange{
@@ -18505,9 +21354,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		read_inbox_max_id := m.Int()
 		read_outbox_max_id := m.Int()
 		unread_count := m.Int()
-		messages := m.Vector()
-		chats := m.Vector()
-		users := m.Vector()
+		messages := m.vectorMessage()
+		chats := m.vectorChat()
+		users := m.vectorUser()
 		r = TL_updates_channelDifferenceTooLong{
 			Flags:              flags,
 			Final:              final,
@@ -18529,10 +21378,10 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<1) != 0 {
 			timeout = m.Int()
 		}
-		new_messages := m.Vector()
-		other_updates := m.Vector()
-		chats := m.Vector()
-		users := m.Vector()
+		new_messages := m.vectorMessage()
+		other_updates := m.vectorUpdate()
+		chats := m.vectorChat()
+		users := m.vectorUser()
 		r = TL_updates_channelDifference{
 			Flags:         flags,
 			Final:         final,
@@ -18548,7 +21397,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_channelMessagesFilter:
 		flags := m.Int()
 		exclude_new_messages := flags&(1<<1) != 0
-		ranges := m.Vector()
+		ranges := m.vectorMessageRange()
 		r = TL_channelMessagesFilter{
 			Flags:                flags,
 			Exclude_new_messages: exclude_new_messages,
@@ -18604,13 +21453,13 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_channels_channelParticipants:
 		r = TL_channels_channelParticipants{
 			Count:        m.Int(),
-			Participants: m.Vector(),
-			Users:        m.Vector(),
+			Participants: m.vectorChannelParticipant(),
+			Users:        m.vectorUser(),
 		}
 	case crc_channels_channelParticipant:
 		r = TL_channels_channelParticipant{
-			Participant: m.Object(),
-			Users:       m.Vector(),
+			Participant: m.objectChannelParticipant(),
+			Users:       m.vectorUser(),
 		}
 	case crc_help_termsOfService:
 		r = TL_help_termsOfService{
@@ -18628,27 +21477,27 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_foundGifCached:
 		r = TL_foundGifCached{
 			Url:      m.String(),
-			Photo:    m.Object(),
-			Document: m.Object(),
+			Photo:    m.objectPhoto(),
+			Document: m.objectDocument(),
 		}
 	case crc_messages_foundGifs:
 		r = TL_messages_foundGifs{
 			Next_offset: m.Int(),
-			Results:     m.Vector(),
+			Results:     m.vectorFoundGif(),
 		}
 	case crc_messages_savedGifsNotModified:
 		r = TL_messages_savedGifsNotModified{}
 	case crc_messages_savedGifs:
 		r = TL_messages_savedGifs{
 			Hash: m.Int(),
-			Gifs: m.Vector(),
+			Gifs: m.vectorDocument(),
 		}
 	case crc_inputBotInlineMessageMediaAuto:
 		flags := m.Int()
 		caption := m.String()
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
 		r = TL_inputBotInlineMessageMediaAuto{
 			Flags:        flags,
@@ -18659,13 +21508,13 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		flags := m.Int()
 		no_webpage := flags&(1<<0) != 0
 		message := m.String()
-		var entities []TL
+		var entities []MessageEntity
 		if flags&(1<<1) != 0 {
-			entities = m.Vector()
+			entities = m.vectorMessageEntity()
 		}
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
 		r = TL_inputBotInlineMessageText{
 			Flags:        flags,
@@ -18676,10 +21525,10 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_inputBotInlineMessageMediaGeo:
 		flags := m.Int()
-		geo_point := m.Object()
-		var reply_markup TL
+		geo_point := m.objectInputGeoPoint()
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
 		r = TL_inputBotInlineMessageMediaGeo{
 			Flags:        flags,
@@ -18688,14 +21537,14 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_inputBotInlineMessageMediaVenue:
 		flags := m.Int()
-		geo_point := m.Object()
+		geo_point := m.objectInputGeoPoint()
 		title := m.String()
 		address := m.String()
 		provider := m.String()
 		venue_id := m.String()
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
 		r = TL_inputBotInlineMessageMediaVenue{
 			Flags:        flags,
@@ -18711,9 +21560,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		phone_number := m.String()
 		first_name := m.String()
 		last_name := m.String()
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
 		r = TL_inputBotInlineMessageMediaContact{
 			Flags:        flags,
@@ -18724,9 +21573,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_inputBotInlineMessageGame:
 		flags := m.Int()
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
 		r = TL_inputBotInlineMessageGame{
 			Flags:        flags,
@@ -18772,7 +21621,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<7) != 0 {
 			duration = m.Int()
 		}
-		send_message := m.Object()
+		send_message := m.objectInputBotInlineMessage()
 		r = TL_inputBotInlineResult{
 			Flags:        flags,
 			Id:           id,
@@ -18792,8 +21641,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_inputBotInlineResultPhoto{
 			Id:           m.String(),
 			Code_type:    m.String(),
-			Photo:        m.Object(),
-			Send_message: m.Object(),
+			Photo:        m.objectInputPhoto(),
+			Send_message: m.objectInputBotInlineMessage(),
 		}
 	case crc_inputBotInlineResultDocument:
 		flags := m.Int()
@@ -18807,8 +21656,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<2) != 0 {
 			description = m.String()
 		}
-		document := m.Object()
-		send_message := m.Object()
+		document := m.objectInputDocument()
+		send_message := m.objectInputBotInlineMessage()
 		r = TL_inputBotInlineResultDocument{
 			Flags:        flags,
 			Id:           id,
@@ -18822,14 +21671,14 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_inputBotInlineResultGame{
 			Id:           m.String(),
 			Short_name:   m.String(),
-			Send_message: m.Object(),
+			Send_message: m.objectInputBotInlineMessage(),
 		}
 	case crc_botInlineMessageMediaAuto:
 		flags := m.Int()
 		caption := m.String()
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
 		r = TL_botInlineMessageMediaAuto{
 			Flags:        flags,
@@ -18840,13 +21689,13 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		flags := m.Int()
 		no_webpage := flags&(1<<0) != 0
 		message := m.String()
-		var entities []TL
+		var entities []MessageEntity
 		if flags&(1<<1) != 0 {
-			entities = m.Vector()
+			entities = m.vectorMessageEntity()
 		}
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
 		r = TL_botInlineMessageText{
 			Flags:        flags,
@@ -18857,10 +21706,10 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_botInlineMessageMediaGeo:
 		flags := m.Int()
-		geo := m.Object()
-		var reply_markup TL
+		geo := m.objectGeoPoint()
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
 		r = TL_botInlineMessageMediaGeo{
 			Flags:        flags,
@@ -18869,14 +21718,14 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_botInlineMessageMediaVenue:
 		flags := m.Int()
-		geo := m.Object()
+		geo := m.objectGeoPoint()
 		title := m.String()
 		address := m.String()
 		provider := m.String()
 		venue_id := m.String()
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
 		r = TL_botInlineMessageMediaVenue{
 			Flags:        flags,
@@ -18892,9 +21741,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		phone_number := m.String()
 		first_name := m.String()
 		last_name := m.String()
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
 		r = TL_botInlineMessageMediaContact{
 			Flags:        flags,
@@ -18943,7 +21792,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<7) != 0 {
 			duration = m.Int()
 		}
-		send_message := m.Object()
+		send_message := m.objectBotInlineMessage()
 		r = TL_botInlineResult{
 			Flags:        flags,
 			Id:           id,
@@ -18963,13 +21812,13 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		flags := m.Int()
 		id := m.String()
 		code_type := m.String()
-		var photo TL
+		var photo Photo
 		if flags&(1<<0) != 0 {
-			photo = m.Object()
+			photo = m.objectPhoto()
 		}
-		var document TL
+		var document Document
 		if flags&(1<<1) != 0 {
-			document = m.Object()
+			document = m.objectDocument()
 		}
 		var title string
 		if flags&(1<<2) != 0 {
@@ -18979,7 +21828,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<3) != 0 {
 			description = m.String()
 		}
-		send_message := m.Object()
+		send_message := m.objectBotInlineMessage()
 		r = TL_botInlineMediaResult{
 			Flags:        flags,
 			Id:           id,
@@ -18998,11 +21847,11 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<1) != 0 {
 			next_offset = m.String()
 		}
-		var switch_pm TL
+		var switch_pm InlineBotSwitchPM
 		if flags&(1<<2) != 0 {
-			switch_pm = m.Object()
+			switch_pm = m.objectInlineBotSwitchPM()
 		}
-		results := m.Vector()
+		results := m.vectorBotInlineResult()
 		cache_time := m.Int()
 		r = TL_messages_botResults{
 			Flags:       flags,
@@ -19102,15 +21951,15 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_peerDialogs:
 		r = TL_messages_peerDialogs{
-			Dialogs:  m.Vector(),
-			Messages: m.Vector(),
-			Chats:    m.Vector(),
-			Users:    m.Vector(),
-			State:    m.Object(),
+			Dialogs:  m.vectorDialog(),
+			Messages: m.vectorMessage(),
+			Chats:    m.vectorChat(),
+			Users:    m.vectorUser(),
+			State:    m.objectUpdates_State(),
 		}
 	case crc_topPeer:
 		r = TL_topPeer{
-			Peer:   m.Object(),
+			Peer:   m.objectPeer(),
 			Rating: m.Double(),
 		}
 	case crc_topPeerCategoryBotsPM:
@@ -19125,17 +21974,17 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_topPeerCategoryChannels{}
 	case crc_topPeerCategoryPeers:
 		r = TL_topPeerCategoryPeers{
-			Category: m.Object(),
+			Category: m.objectTopPeerCategory(),
 			Count:    m.Int(),
-			Peers:    m.Vector(),
+			Peers:    m.vectorTopPeer(),
 		}
 	case crc_contacts_topPeersNotModified:
 		r = TL_contacts_topPeersNotModified{}
 	case crc_contacts_topPeers:
 		r = TL_contacts_topPeers{
-			Categories: m.Vector(),
-			Chats:      m.Vector(),
-			Users:      m.Vector(),
+			Categories: m.vectorTopPeerCategoryPeers(),
+			Chats:      m.vectorChat(),
+			Users:      m.vectorUser(),
 		}
 	case crc_draftMessageEmpty:
 		r = TL_draftMessageEmpty{}
@@ -19147,9 +21996,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 			reply_to_msg_id = m.Int()
 		}
 		message := m.String()
-		var entities []TL
+		var entities []MessageEntity
 		if flags&(1<<3) != 0 {
-			entities = m.Vector()
+			entities = m.vectorMessageEntity()
 		}
 		date := m.Int()
 		r = TL_draftMessage{
@@ -19165,7 +22014,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_messages_featuredStickers:
 		r = TL_messages_featuredStickers{
 			Hash:   m.Int(),
-			Sets:   m.Vector(),
+			Sets:   m.vectorStickerSetCovered(),
 			Unread: m.VectorLong(),
 		}
 	case crc_messages_recentStickersNotModified:
@@ -19173,28 +22022,28 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_messages_recentStickers:
 		r = TL_messages_recentStickers{
 			Hash:     m.Int(),
-			Stickers: m.Vector(),
+			Stickers: m.vectorDocument(),
 		}
 	case crc_messages_archivedStickers:
 		r = TL_messages_archivedStickers{
 			Count: m.Int(),
-			Sets:  m.Vector(),
+			Sets:  m.vectorStickerSetCovered(),
 		}
 	case crc_messages_stickerSetInstallResultSuccess:
 		r = TL_messages_stickerSetInstallResultSuccess{}
 	case crc_messages_stickerSetInstallResultArchive:
 		r = TL_messages_stickerSetInstallResultArchive{
-			Sets: m.Vector(),
+			Sets: m.vectorStickerSetCovered(),
 		}
 	case crc_stickerSetCovered:
 		r = TL_stickerSetCovered{
-			Set:   m.Object(),
-			Cover: m.Object(),
+			Set:   m.objectStickerSet(),
+			Cover: m.objectDocument(),
 		}
 	case crc_stickerSetMultiCovered:
 		r = TL_stickerSetMultiCovered{
-			Set:    m.Object(),
-			Covers: m.Vector(),
+			Set:    m.objectStickerSet(),
+			Covers: m.vectorDocument(),
 		}
 	case crc_maskCoords:
 		r = TL_maskCoords{
@@ -19205,11 +22054,11 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_inputStickeredMediaPhoto:
 		r = TL_inputStickeredMediaPhoto{
-			Id: m.Object(),
+			Id: m.objectInputPhoto(),
 		}
 	case crc_inputStickeredMediaDocument:
 		r = TL_inputStickeredMediaDocument{
-			Id: m.Object(),
+			Id: m.objectInputDocument(),
 		}
 	case crc_game:
 		flags := m.Int()
@@ -19218,10 +22067,10 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		short_name := m.String()
 		title := m.String()
 		description := m.String()
-		photo := m.Object()
-		var document TL
+		photo := m.objectPhoto()
+		var document Document
 		if flags&(1<<0) != 0 {
-			document = m.Object()
+			document = m.objectDocument()
 		}
 		r = TL_game{
 			Flags:       flags,
@@ -19240,7 +22089,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_inputGameShortName:
 		r = TL_inputGameShortName{
-			Bot_id:     m.Object(),
+			Bot_id:     m.objectInputUser(),
 			Short_name: m.String(),
 		}
 	case crc_highScore:
@@ -19251,8 +22100,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_highScores:
 		r = TL_messages_highScores{
-			Scores: m.Vector(),
-			Users:  m.Vector(),
+			Scores: m.vectorHighScore(),
+			Users:  m.vectorUser(),
 		}
 	case crc_textEmpty:
 		r = TL_textEmpty{}
@@ -19262,74 +22111,74 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_textBold:
 		r = TL_textBold{
-			Text: m.Object(),
+			Text: m.objectRichText(),
 		}
 	case crc_textItalic:
 		r = TL_textItalic{
-			Text: m.Object(),
+			Text: m.objectRichText(),
 		}
 	case crc_textUnderline:
 		r = TL_textUnderline{
-			Text: m.Object(),
+			Text: m.objectRichText(),
 		}
 	case crc_textStrike:
 		r = TL_textStrike{
-			Text: m.Object(),
+			Text: m.objectRichText(),
 		}
 	case crc_textFixed:
 		r = TL_textFixed{
-			Text: m.Object(),
+			Text: m.objectRichText(),
 		}
 	case crc_textUrl:
 		r = TL_textUrl{
-			Text:       m.Object(),
+			Text:       m.objectRichText(),
 			Url:        m.String(),
 			Webpage_id: m.Long(),
 		}
 	case crc_textEmail:
 		r = TL_textEmail{
-			Text:  m.Object(),
+			Text:  m.objectRichText(),
 			Email: m.String(),
 		}
 	case crc_textConcat:
 		r = TL_textConcat{
-			Texts: m.Vector(),
+			Texts: m.vectorRichText(),
 		}
 	case crc_pageBlockUnsupported:
 		r = TL_pageBlockUnsupported{}
 	case crc_pageBlockTitle:
 		r = TL_pageBlockTitle{
-			Text: m.Object(),
+			Text: m.objectRichText(),
 		}
 	case crc_pageBlockSubtitle:
 		r = TL_pageBlockSubtitle{
-			Text: m.Object(),
+			Text: m.objectRichText(),
 		}
 	case crc_pageBlockAuthorDate:
 		r = TL_pageBlockAuthorDate{
-			Author:         m.Object(),
+			Author:         m.objectRichText(),
 			Published_date: m.Int(),
 		}
 	case crc_pageBlockHeader:
 		r = TL_pageBlockHeader{
-			Text: m.Object(),
+			Text: m.objectRichText(),
 		}
 	case crc_pageBlockSubheader:
 		r = TL_pageBlockSubheader{
-			Text: m.Object(),
+			Text: m.objectRichText(),
 		}
 	case crc_pageBlockParagraph:
 		r = TL_pageBlockParagraph{
-			Text: m.Object(),
+			Text: m.objectRichText(),
 		}
 	case crc_pageBlockPreformatted:
 		r = TL_pageBlockPreformatted{
-			Text:     m.Object(),
+			Text:     m.objectRichText(),
 			Language: m.String(),
 		}
 	case crc_pageBlockFooter:
 		r = TL_pageBlockFooter{
-			Text: m.Object(),
+			Text: m.objectRichText(),
 		}
 	case crc_pageBlockDivider:
 		r = TL_pageBlockDivider{}
@@ -19339,30 +22188,30 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_pageBlockList:
 		r = TL_pageBlockList{
-			Ordered: m.Object(),
-			Items:   m.Vector(),
+			Ordered: m.objectBool(),
+			Items:   m.vectorRichText(),
 		}
 	case crc_pageBlockBlockquote:
 		r = TL_pageBlockBlockquote{
-			Text:    m.Object(),
-			Caption: m.Object(),
+			Text:    m.objectRichText(),
+			Caption: m.objectRichText(),
 		}
 	case crc_pageBlockPullquote:
 		r = TL_pageBlockPullquote{
-			Text:    m.Object(),
-			Caption: m.Object(),
+			Text:    m.objectRichText(),
+			Caption: m.objectRichText(),
 		}
 	case crc_pageBlockPhoto:
 		r = TL_pageBlockPhoto{
 			Photo_id: m.Long(),
-			Caption:  m.Object(),
+			Caption:  m.objectRichText(),
 		}
 	case crc_pageBlockVideo:
 		flags := m.Int()
 		autoplay := flags&(1<<0) != 0
 		loop := flags&(1<<1) != 0
 		video_id := m.Long()
-		caption := m.Object()
+		caption := m.objectRichText()
 		r = TL_pageBlockVideo{
 			Flags:    flags,
 			Autoplay: autoplay,
@@ -19372,7 +22221,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_pageBlockCover:
 		r = TL_pageBlockCover{
-			Cover: m.Object(),
+			Cover: m.objectPageBlock(),
 		}
 	case crc_pageBlockEmbed:
 		flags := m.Int()
@@ -19392,7 +22241,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 		w := m.Int()
 		h := m.Int()
-		caption := m.Object()
+		caption := m.objectRichText()
 		r = TL_pageBlockEmbed{
 			Flags:           flags,
 			Full_width:      full_width,
@@ -19411,30 +22260,30 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 			Author_photo_id: m.Long(),
 			Author:          m.String(),
 			Date:            m.Int(),
-			Blocks:          m.Vector(),
-			Caption:         m.Object(),
+			Blocks:          m.vectorPageBlock(),
+			Caption:         m.objectRichText(),
 		}
 	case crc_pageBlockCollage:
 		r = TL_pageBlockCollage{
-			Items:   m.Vector(),
-			Caption: m.Object(),
+			Items:   m.vectorPageBlock(),
+			Caption: m.objectRichText(),
 		}
 	case crc_pageBlockSlideshow:
 		r = TL_pageBlockSlideshow{
-			Items:   m.Vector(),
-			Caption: m.Object(),
+			Items:   m.vectorPageBlock(),
+			Caption: m.objectRichText(),
 		}
 	case crc_pagePart:
 		r = TL_pagePart{
-			Blocks: m.Vector(),
-			Photos: m.Vector(),
-			Videos: m.Vector(),
+			Blocks: m.vectorPageBlock(),
+			Photos: m.vectorPhoto(),
+			Videos: m.vectorDocument(),
 		}
 	case crc_pageFull:
 		r = TL_pageFull{
-			Blocks: m.Vector(),
-			Photos: m.Vector(),
-			Videos: m.Vector(),
+			Blocks: m.vectorPageBlock(),
+			Photos: m.vectorPhoto(),
+			Videos: m.vectorDocument(),
 		}
 	case crc_phoneCallDiscardReasonMissed:
 		r = TL_phoneCallDiscardReasonMissed{}
@@ -19462,7 +22311,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		shipping_address_requested := flags&(1<<4) != 0
 		flexible := flags&(1<<5) != 0
 		currency := m.String()
-		prices := m.Vector()
+		prices := m.vectorLabeledPrice()
 		r = TL_invoice{
 			Flags:                      flags,
 			Test:                       test,
@@ -19502,9 +22351,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<2) != 0 {
 			email = m.String()
 		}
-		var shipping_address TL
+		var shipping_address PostAddress
 		if flags&(1<<3) != 0 {
-			shipping_address = m.Object()
+			shipping_address = m.objectPostAddress()
 		}
 		r = TL_paymentRequestedInfo{
 			Flags:            flags,
@@ -19524,7 +22373,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 			Access_hash: m.Long(),
 			Size:        m.Int(),
 			Mime_type:   m.String(),
-			Attributes:  m.Vector(),
+			Attributes:  m.vectorDocumentAttribute(),
 			Dc_id:       m.Int(),
 		}
 	case crc_inputWebDocument:
@@ -19532,7 +22381,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 			Url:        m.String(),
 			Size:       m.Int(),
 			Mime_type:  m.String(),
-			Attributes: m.Vector(),
+			Attributes: m.vectorDocumentAttribute(),
 		}
 	case crc_inputWebFileLocation:
 		r = TL_inputWebFileLocation{
@@ -19543,7 +22392,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_upload_webFile{
 			Size:      m.Int(),
 			Mime_type: m.String(),
-			File_type: m.Object(),
+			File_type: m.objectStorage_FileType(),
 			Mtime:     m.Int(),
 			Bytes:     m.StringBytes(),
 		}
@@ -19552,26 +22401,26 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		can_save_credentials := flags&(1<<2) != 0
 		password_missing := flags&(1<<3) != 0
 		bot_id := m.Int()
-		invoice := m.Object()
+		invoice := m.objectInvoice()
 		provider_id := m.Int()
 		url := m.String()
 		var native_provider string
 		if flags&(1<<4) != 0 {
 			native_provider = m.String()
 		}
-		var native_params TL
+		var native_params DataJSON
 		if flags&(1<<4) != 0 {
-			native_params = m.Object()
+			native_params = m.objectDataJSON()
 		}
-		var saved_info TL
+		var saved_info PaymentRequestedInfo
 		if flags&(1<<0) != 0 {
-			saved_info = m.Object()
+			saved_info = m.objectPaymentRequestedInfo()
 		}
-		var saved_credentials TL
+		var saved_credentials PaymentSavedCredentials
 		if flags&(1<<1) != 0 {
-			saved_credentials = m.Object()
+			saved_credentials = m.objectPaymentSavedCredentials()
 		}
-		users := m.Vector()
+		users := m.vectorUser()
 		r = TL_payments_paymentForm{
 			Flags:                flags,
 			Can_save_credentials: can_save_credentials,
@@ -19592,9 +22441,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<0) != 0 {
 			id = m.String()
 		}
-		var shipping_options []TL
+		var shipping_options []ShippingOption
 		if flags&(1<<1) != 0 {
-			shipping_options = m.Vector()
+			shipping_options = m.vectorShippingOption()
 		}
 		r = TL_payments_validatedRequestedInfo{
 			Flags:            flags,
@@ -19603,7 +22452,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_payments_paymentResult:
 		r = TL_payments_paymentResult{
-			Updates: m.Object(),
+			Updates: m.objectUpdates(),
 		}
 	case crc_payments_paymentVerficationNeeded:
 		r = TL_payments_paymentVerficationNeeded{
@@ -19613,20 +22462,20 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		flags := m.Int()
 		date := m.Int()
 		bot_id := m.Int()
-		invoice := m.Object()
+		invoice := m.objectInvoice()
 		provider_id := m.Int()
-		var info TL
+		var info PaymentRequestedInfo
 		if flags&(1<<0) != 0 {
-			info = m.Object()
+			info = m.objectPaymentRequestedInfo()
 		}
-		var shipping TL
+		var shipping ShippingOption
 		if flags&(1<<1) != 0 {
-			shipping = m.Object()
+			shipping = m.objectShippingOption()
 		}
 		currency := m.String()
 		total_amount := m.Long()
 		credentials_title := m.String()
-		users := m.Vector()
+		users := m.vectorUser()
 		r = TL_payments_paymentReceipt{
 			Flags:             flags,
 			Date:              date,
@@ -19643,12 +22492,12 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_payments_savedInfo:
 		flags := m.Int()
 		has_saved_credentials := flags&(1<<1) != 0
-		var saved_info TL
+		var saved_info PaymentRequestedInfo
 		if flags&(1<<0) != 0 {
-			saved_info = m.Object()
+			saved_info = m.objectPaymentRequestedInfo()
 		}
 		r = TL_payments_savedInfo{
-			Flags: flags,
+			Flags:                 flags,
 			Has_saved_credentials: has_saved_credentials,
 			Saved_info:            saved_info,
 		}
@@ -19660,7 +22509,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_inputPaymentCredentials:
 		flags := m.Int()
 		save := flags&(1<<0) != 0
-		data := m.Object()
+		data := m.objectDataJSON()
 		r = TL_inputPaymentCredentials{
 			Flags: flags,
 			Save:  save,
@@ -19675,7 +22524,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_shippingOption{
 			Id:     m.String(),
 			Title:  m.String(),
-			Prices: m.Vector(),
+			Prices: m.vectorLabeledPrice(),
 		}
 	case crc_inputPhoneCall:
 		r = TL_inputPhoneCall{
@@ -19693,7 +22542,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		date := m.Int()
 		admin_id := m.Int()
 		participant_id := m.Int()
-		protocol := m.Object()
+		protocol := m.objectPhoneCallProtocol()
 		var receive_date int32
 		if flags&(1<<0) != 0 {
 			receive_date = m.Int()
@@ -19716,7 +22565,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 			Admin_id:       m.Int(),
 			Participant_id: m.Int(),
 			G_a_hash:       m.StringBytes(),
-			Protocol:       m.Object(),
+			Protocol:       m.objectPhoneCallProtocol(),
 		}
 	case crc_phoneCallAccepted:
 		r = TL_phoneCallAccepted{
@@ -19726,7 +22575,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 			Admin_id:       m.Int(),
 			Participant_id: m.Int(),
 			G_b:            m.StringBytes(),
-			Protocol:       m.Object(),
+			Protocol:       m.objectPhoneCallProtocol(),
 		}
 	case crc_phoneCall:
 		r = TL_phoneCall{
@@ -19737,9 +22586,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 			Participant_id:          m.Int(),
 			G_a_or_b:                m.StringBytes(),
 			Key_fingerprint:         m.Long(),
-			Protocol:                m.Object(),
-			Connection:              m.Object(),
-			Alternative_connections: m.Vector(),
+			Protocol:                m.objectPhoneCallProtocol(),
+			Connection:              m.objectPhoneConnection(),
+			Alternative_connections: m.vectorPhoneConnection(),
 			Start_date:              m.Int(),
 		}
 	case crc_phoneCallDiscarded:
@@ -19747,9 +22596,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		need_rating := flags&(1<<2) != 0
 		need_debug := flags&(1<<3) != 0
 		id := m.Long()
-		var reason TL
+		var reason PhoneCallDiscardReason
 		if flags&(1<<0) != 0 {
-			reason = m.Object()
+			reason = m.objectPhoneCallDiscardReason()
 		}
 		var duration int32
 		if flags&(1<<1) != 0 {
@@ -19786,8 +22635,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_phone_phoneCall:
 		r = TL_phone_phoneCall{
-			Phone_call: m.Object(),
-			Users:      m.Vector(),
+			Phone_call: m.objectPhoneCall(),
+			Users:      m.vectorUser(),
 		}
 	case crc_invokeAfterMsg:
 		r = TL_invokeAfterMsg{
@@ -19825,9 +22674,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		flags := m.Int()
 		allow_flashcall := flags&(1<<0) != 0
 		phone_number := m.String()
-		var current_number TL
+		var current_number Bool
 		if flags&(1<<0) != 0 {
-			current_number = m.Object()
+			current_number = m.objectBool()
 		}
 		api_id := m.Int()
 		api_hash := m.String()
@@ -19921,12 +22770,12 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_account_updateNotifySettings:
 		r = TL_account_updateNotifySettings{
-			Peer:     m.Object(),
-			Settings: m.Object(),
+			Peer:     m.objectInputNotifyPeer(),
+			Settings: m.objectInputPeerNotifySettings(),
 		}
 	case crc_account_getNotifySettings:
 		r = TL_account_getNotifySettings{
-			Peer: m.Object(),
+			Peer: m.objectInputNotifyPeer(),
 		}
 	case crc_account_resetNotifySettings:
 		r = TL_account_resetNotifySettings{}
@@ -19952,14 +22801,14 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_account_updateStatus:
 		r = TL_account_updateStatus{
-			Offline: m.Object(),
+			Offline: m.objectBool(),
 		}
 	case crc_account_getWallPapers:
 		r = TL_account_getWallPapers{}
 	case crc_account_reportPeer:
 		r = TL_account_reportPeer{
-			Peer:   m.Object(),
-			Reason: m.Object(),
+			Peer:   m.objectInputPeer(),
+			Reason: m.objectReportReason(),
 		}
 	case crc_account_checkUsername:
 		r = TL_account_checkUsername{
@@ -19971,12 +22820,12 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_account_getPrivacy:
 		r = TL_account_getPrivacy{
-			Key: m.Object(),
+			Key: m.objectInputPrivacyKey(),
 		}
 	case crc_account_setPrivacy:
 		r = TL_account_setPrivacy{
-			Key:   m.Object(),
-			Rules: m.Vector(),
+			Key:   m.objectInputPrivacyKey(),
+			Rules: m.vectorInputPrivacyRule(),
 		}
 	case crc_account_deleteAccount:
 		r = TL_account_deleteAccount{
@@ -19986,15 +22835,15 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_account_getAccountTTL{}
 	case crc_account_setAccountTTL:
 		r = TL_account_setAccountTTL{
-			Ttl: m.Object(),
+			Ttl: m.objectAccountDaysTTL(),
 		}
 	case crc_account_sendChangePhoneCode:
 		flags := m.Int()
 		allow_flashcall := flags&(1<<0) != 0
 		phone_number := m.String()
-		var current_number TL
+		var current_number Bool
 		if flags&(1<<0) != 0 {
-			current_number = m.Object()
+			current_number = m.objectBool()
 		}
 		r = TL_account_sendChangePhoneCode{
 			Flags:           flags,
@@ -20027,15 +22876,15 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_account_updatePasswordSettings:
 		r = TL_account_updatePasswordSettings{
 			Current_password_hash: m.StringBytes(),
-			New_settings:          m.Object(),
+			New_settings:          m.objectAccount_PasswordInputSettings(),
 		}
 	case crc_account_sendConfirmPhoneCode:
 		flags := m.Int()
 		allow_flashcall := flags&(1<<0) != 0
 		hash := m.String()
-		var current_number TL
+		var current_number Bool
 		if flags&(1<<0) != 0 {
-			current_number = m.Object()
+			current_number = m.objectBool()
 		}
 		r = TL_account_sendConfirmPhoneCode{
 			Flags:           flags,
@@ -20055,11 +22904,11 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_users_getUsers:
 		r = TL_users_getUsers{
-			Id: m.Vector(),
+			Id: m.vectorInputUser(),
 		}
 	case crc_users_getFullUser:
 		r = TL_users_getFullUser{
-			Id: m.Object(),
+			Id: m.objectInputUser(),
 		}
 	case crc_contacts_getStatuses:
 		r = TL_contacts_getStatuses{}
@@ -20069,24 +22918,24 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_contacts_importContacts:
 		r = TL_contacts_importContacts{
-			Contacts: m.Vector(),
-			Replace:  m.Object(),
+			Contacts: m.vectorInputContact(),
+			Replace:  m.objectBool(),
 		}
 	case crc_contacts_deleteContact:
 		r = TL_contacts_deleteContact{
-			Id: m.Object(),
+			Id: m.objectInputUser(),
 		}
 	case crc_contacts_deleteContacts:
 		r = TL_contacts_deleteContacts{
-			Id: m.Vector(),
+			Id: m.vectorInputUser(),
 		}
 	case crc_contacts_block:
 		r = TL_contacts_block{
-			Id: m.Object(),
+			Id: m.objectInputUser(),
 		}
 	case crc_contacts_unblock:
 		r = TL_contacts_unblock{
-			Id: m.Object(),
+			Id: m.objectInputUser(),
 		}
 	case crc_contacts_getBlocked:
 		r = TL_contacts_getBlocked{
@@ -20131,8 +22980,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_contacts_resetTopPeerRating:
 		r = TL_contacts_resetTopPeerRating{
-			Category: m.Object(),
-			Peer:     m.Object(),
+			Category: m.objectTopPeerCategory(),
+			Peer:     m.objectInputPeer(),
 		}
 	case crc_messages_getMessages:
 		r = TL_messages_getMessages{
@@ -20143,7 +22992,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		exclude_pinned := flags&(1<<0) != 0
 		offset_date := m.Int()
 		offset_id := m.Int()
-		offset_peer := m.Object()
+		offset_peer := m.objectInputPeer()
 		limit := m.Int()
 		r = TL_messages_getDialogs{
 			Flags:          flags,
@@ -20155,7 +23004,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_getHistory:
 		r = TL_messages_getHistory{
-			Peer:        m.Object(),
+			Peer:        m.objectInputPeer(),
 			Offset_id:   m.Int(),
 			Offset_date: m.Int(),
 			Add_offset:  m.Int(),
@@ -20165,9 +23014,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_search:
 		flags := m.Int()
-		peer := m.Object()
+		peer := m.objectInputPeer()
 		q := m.String()
-		filter := m.Object()
+		filter := m.objectMessagesFilter()
 		min_date := m.Int()
 		max_date := m.Int()
 		offset := m.Int()
@@ -20186,13 +23035,13 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_readHistory:
 		r = TL_messages_readHistory{
-			Peer:   m.Object(),
+			Peer:   m.objectInputPeer(),
 			Max_id: m.Int(),
 		}
 	case crc_messages_deleteHistory:
 		flags := m.Int()
 		just_clear := flags&(1<<0) != 0
-		peer := m.Object()
+		peer := m.objectInputPeer()
 		max_id := m.Int()
 		r = TL_messages_deleteHistory{
 			Flags:      flags,
@@ -20215,8 +23064,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_setTyping:
 		r = TL_messages_setTyping{
-			Peer:   m.Object(),
-			Action: m.Object(),
+			Peer:   m.objectInputPeer(),
+			Action: m.objectSendMessageAction(),
 		}
 	case crc_messages_sendMessage:
 		flags := m.Int()
@@ -20224,20 +23073,20 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		silent := flags&(1<<5) != 0
 		background := flags&(1<<6) != 0
 		clear_draft := flags&(1<<7) != 0
-		peer := m.Object()
+		peer := m.objectInputPeer()
 		var reply_to_msg_id int32
 		if flags&(1<<0) != 0 {
 			reply_to_msg_id = m.Int()
 		}
 		message := m.String()
 		random_id := m.Long()
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
-		var entities []TL
+		var entities []MessageEntity
 		if flags&(1<<3) != 0 {
-			entities = m.Vector()
+			entities = m.vectorMessageEntity()
 		}
 		r = TL_messages_sendMessage{
 			Flags:           flags,
@@ -20257,16 +23106,16 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		silent := flags&(1<<5) != 0
 		background := flags&(1<<6) != 0
 		clear_draft := flags&(1<<7) != 0
-		peer := m.Object()
+		peer := m.objectInputPeer()
 		var reply_to_msg_id int32
 		if flags&(1<<0) != 0 {
 			reply_to_msg_id = m.Int()
 		}
-		media := m.Object()
+		media := m.objectInputMedia()
 		random_id := m.Long()
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
 		r = TL_messages_sendMedia{
 			Flags:           flags,
@@ -20284,10 +23133,10 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		silent := flags&(1<<5) != 0
 		background := flags&(1<<6) != 0
 		with_my_score := flags&(1<<8) != 0
-		from_peer := m.Object()
+		from_peer := m.objectInputPeer()
 		id := m.VectorInt()
 		random_id := m.VectorLong()
-		to_peer := m.Object()
+		to_peer := m.objectInputPeer()
 		r = TL_messages_forwardMessages{
 			Flags:         flags,
 			Silent:        silent,
@@ -20300,15 +23149,15 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_reportSpam:
 		r = TL_messages_reportSpam{
-			Peer: m.Object(),
+			Peer: m.objectInputPeer(),
 		}
 	case crc_messages_hideReportSpam:
 		r = TL_messages_hideReportSpam{
-			Peer: m.Object(),
+			Peer: m.objectInputPeer(),
 		}
 	case crc_messages_getPeerSettings:
 		r = TL_messages_getPeerSettings{
-			Peer: m.Object(),
+			Peer: m.objectInputPeer(),
 		}
 	case crc_messages_getChats:
 		r = TL_messages_getChats{
@@ -20326,27 +23175,27 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_messages_editChatPhoto:
 		r = TL_messages_editChatPhoto{
 			Chat_id: m.Int(),
-			Photo:   m.Object(),
+			Photo:   m.objectInputChatPhoto(),
 		}
 	case crc_messages_addChatUser:
 		r = TL_messages_addChatUser{
 			Chat_id:   m.Int(),
-			User_id:   m.Object(),
+			User_id:   m.objectInputUser(),
 			Fwd_limit: m.Int(),
 		}
 	case crc_messages_deleteChatUser:
 		r = TL_messages_deleteChatUser{
 			Chat_id: m.Int(),
-			User_id: m.Object(),
+			User_id: m.objectInputUser(),
 		}
 	case crc_messages_createChat:
 		r = TL_messages_createChat{
-			Users: m.Vector(),
+			Users: m.vectorInputUser(),
 			Title: m.String(),
 		}
 	case crc_messages_forwardMessage:
 		r = TL_messages_forwardMessage{
-			Peer:      m.Object(),
+			Peer:      m.objectInputPeer(),
 			Id:        m.Int(),
 			Random_id: m.Long(),
 		}
@@ -20357,13 +23206,13 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_requestEncryption:
 		r = TL_messages_requestEncryption{
-			User_id:   m.Object(),
+			User_id:   m.objectInputUser(),
 			Random_id: m.Int(),
 			G_a:       m.StringBytes(),
 		}
 	case crc_messages_acceptEncryption:
 		r = TL_messages_acceptEncryption{
-			Peer:            m.Object(),
+			Peer:            m.objectInputEncryptedChat(),
 			G_b:             m.StringBytes(),
 			Key_fingerprint: m.Long(),
 		}
@@ -20373,30 +23222,30 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_setEncryptedTyping:
 		r = TL_messages_setEncryptedTyping{
-			Peer:   m.Object(),
-			Typing: m.Object(),
+			Peer:   m.objectInputEncryptedChat(),
+			Typing: m.objectBool(),
 		}
 	case crc_messages_readEncryptedHistory:
 		r = TL_messages_readEncryptedHistory{
-			Peer:     m.Object(),
+			Peer:     m.objectInputEncryptedChat(),
 			Max_date: m.Int(),
 		}
 	case crc_messages_sendEncrypted:
 		r = TL_messages_sendEncrypted{
-			Peer:      m.Object(),
+			Peer:      m.objectInputEncryptedChat(),
 			Random_id: m.Long(),
 			Data:      m.StringBytes(),
 		}
 	case crc_messages_sendEncryptedFile:
 		r = TL_messages_sendEncryptedFile{
-			Peer:      m.Object(),
+			Peer:      m.objectInputEncryptedChat(),
 			Random_id: m.Long(),
 			Data:      m.StringBytes(),
-			File:      m.Object(),
+			File:      m.objectInputEncryptedFile(),
 		}
 	case crc_messages_sendEncryptedService:
 		r = TL_messages_sendEncryptedService{
-			Peer:      m.Object(),
+			Peer:      m.objectInputEncryptedChat(),
 			Random_id: m.Long(),
 			Data:      m.StringBytes(),
 		}
@@ -20406,7 +23255,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_reportEncryptedSpam:
 		r = TL_messages_reportEncryptedSpam{
-			Peer: m.Object(),
+			Peer: m.objectInputEncryptedChat(),
 		}
 	case crc_messages_readMessageContents:
 		r = TL_messages_readMessageContents{
@@ -20434,40 +23283,40 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_getStickerSet:
 		r = TL_messages_getStickerSet{
-			Stickerset: m.Object(),
+			Stickerset: m.objectInputStickerSet(),
 		}
 	case crc_messages_installStickerSet:
 		r = TL_messages_installStickerSet{
-			Stickerset: m.Object(),
-			Archived:   m.Object(),
+			Stickerset: m.objectInputStickerSet(),
+			Archived:   m.objectBool(),
 		}
 	case crc_messages_uninstallStickerSet:
 		r = TL_messages_uninstallStickerSet{
-			Stickerset: m.Object(),
+			Stickerset: m.objectInputStickerSet(),
 		}
 	case crc_messages_startBot:
 		r = TL_messages_startBot{
-			Bot:         m.Object(),
-			Peer:        m.Object(),
+			Bot:         m.objectInputUser(),
+			Peer:        m.objectInputPeer(),
 			Random_id:   m.Long(),
 			Start_param: m.String(),
 		}
 	case crc_messages_getMessagesViews:
 		r = TL_messages_getMessagesViews{
-			Peer:      m.Object(),
+			Peer:      m.objectInputPeer(),
 			Id:        m.VectorInt(),
-			Increment: m.Object(),
+			Increment: m.objectBool(),
 		}
 	case crc_messages_toggleChatAdmins:
 		r = TL_messages_toggleChatAdmins{
 			Chat_id: m.Int(),
-			Enabled: m.Object(),
+			Enabled: m.objectBool(),
 		}
 	case crc_messages_editChatAdmin:
 		r = TL_messages_editChatAdmin{
 			Chat_id:  m.Int(),
-			User_id:  m.Object(),
-			Is_admin: m.Object(),
+			User_id:  m.objectInputUser(),
+			Is_admin: m.objectBool(),
 		}
 	case crc_messages_migrateChat:
 		r = TL_messages_migrateChat{
@@ -20477,7 +23326,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_messages_searchGlobal{
 			Q:           m.String(),
 			Offset_date: m.Int(),
-			Offset_peer: m.Object(),
+			Offset_peer: m.objectInputPeer(),
 			Offset_id:   m.Int(),
 			Limit:       m.Int(),
 		}
@@ -20507,16 +23356,16 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_saveGif:
 		r = TL_messages_saveGif{
-			Id:     m.Object(),
-			Unsave: m.Object(),
+			Id:     m.objectInputDocument(),
+			Unsave: m.objectBool(),
 		}
 	case crc_messages_getInlineBotResults:
 		flags := m.Int()
-		bot := m.Object()
-		peer := m.Object()
-		var geo_point TL
+		bot := m.objectInputUser()
+		peer := m.objectInputPeer()
+		var geo_point InputGeoPoint
 		if flags&(1<<0) != 0 {
-			geo_point = m.Object()
+			geo_point = m.objectInputGeoPoint()
 		}
 		query := m.String()
 		offset := m.String()
@@ -20533,15 +23382,15 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		gallery := flags&(1<<0) != 0
 		private := flags&(1<<1) != 0
 		query_id := m.Long()
-		results := m.Vector()
+		results := m.vectorInputBotInlineResult()
 		cache_time := m.Int()
 		var next_offset string
 		if flags&(1<<2) != 0 {
 			next_offset = m.String()
 		}
-		var switch_pm TL
+		var switch_pm InlineBotSwitchPM
 		if flags&(1<<3) != 0 {
-			switch_pm = m.Object()
+			switch_pm = m.objectInlineBotSwitchPM()
 		}
 		r = TL_messages_setInlineBotResults{
 			Flags:       flags,
@@ -20558,7 +23407,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		silent := flags&(1<<5) != 0
 		background := flags&(1<<6) != 0
 		clear_draft := flags&(1<<7) != 0
-		peer := m.Object()
+		peer := m.objectInputPeer()
 		var reply_to_msg_id int32
 		if flags&(1<<0) != 0 {
 			reply_to_msg_id = m.Int()
@@ -20579,25 +23428,25 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_getMessageEditData:
 		r = TL_messages_getMessageEditData{
-			Peer: m.Object(),
+			Peer: m.objectInputPeer(),
 			Id:   m.Int(),
 		}
 	case crc_messages_editMessage:
 		flags := m.Int()
 		no_webpage := flags&(1<<1) != 0
-		peer := m.Object()
+		peer := m.objectInputPeer()
 		id := m.Int()
 		var message string
 		if flags&(1<<11) != 0 {
 			message = m.String()
 		}
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
-		var entities []TL
+		var entities []MessageEntity
 		if flags&(1<<3) != 0 {
-			entities = m.Vector()
+			entities = m.vectorMessageEntity()
 		}
 		r = TL_messages_editMessage{
 			Flags:        flags,
@@ -20611,18 +23460,18 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_messages_editInlineBotMessage:
 		flags := m.Int()
 		no_webpage := flags&(1<<1) != 0
-		id := m.Object()
+		id := m.objectInputBotInlineMessageID()
 		var message string
 		if flags&(1<<11) != 0 {
 			message = m.String()
 		}
-		var reply_markup TL
+		var reply_markup ReplyMarkup
 		if flags&(1<<2) != 0 {
-			reply_markup = m.Object()
+			reply_markup = m.objectReplyMarkup()
 		}
-		var entities []TL
+		var entities []MessageEntity
 		if flags&(1<<3) != 0 {
-			entities = m.Vector()
+			entities = m.vectorMessageEntity()
 		}
 		r = TL_messages_editInlineBotMessage{
 			Flags:        flags,
@@ -20635,7 +23484,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_messages_getBotCallbackAnswer:
 		flags := m.Int()
 		game := flags&(1<<1) != 0
-		peer := m.Object()
+		peer := m.objectInputPeer()
 		msg_id := m.Int()
 		var data []byte
 		if flags&(1<<0) != 0 {
@@ -20671,7 +23520,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_getPeerDialogs:
 		r = TL_messages_getPeerDialogs{
-			Peers: m.Vector(),
+			Peers: m.vectorInputPeer(),
 		}
 	case crc_messages_saveDraft:
 		flags := m.Int()
@@ -20680,11 +23529,11 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<0) != 0 {
 			reply_to_msg_id = m.Int()
 		}
-		peer := m.Object()
+		peer := m.objectInputPeer()
 		message := m.String()
-		var entities []TL
+		var entities []MessageEntity
 		if flags&(1<<3) != 0 {
-			entities = m.Vector()
+			entities = m.vectorMessageEntity()
 		}
 		r = TL_messages_saveDraft{
 			Flags:           flags,
@@ -20716,8 +23565,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_messages_saveRecentSticker:
 		flags := m.Int()
 		attached := flags&(1<<0) != 0
-		id := m.Object()
-		unsave := m.Object()
+		id := m.objectInputDocument()
+		unsave := m.objectBool()
 		r = TL_messages_saveRecentSticker{
 			Flags:    flags,
 			Attached: attached,
@@ -20748,15 +23597,15 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_getAttachedStickers:
 		r = TL_messages_getAttachedStickers{
-			Media: m.Object(),
+			Media: m.objectInputStickeredMedia(),
 		}
 	case crc_messages_setGameScore:
 		flags := m.Int()
 		edit_message := flags&(1<<0) != 0
 		force := flags&(1<<1) != 0
-		peer := m.Object()
+		peer := m.objectInputPeer()
 		id := m.Int()
-		user_id := m.Object()
+		user_id := m.objectInputUser()
 		score := m.Int()
 		r = TL_messages_setGameScore{
 			Flags:        flags,
@@ -20771,8 +23620,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		flags := m.Int()
 		edit_message := flags&(1<<0) != 0
 		force := flags&(1<<1) != 0
-		id := m.Object()
-		user_id := m.Object()
+		id := m.objectInputBotInlineMessageID()
+		user_id := m.objectInputUser()
 		score := m.Int()
 		r = TL_messages_setInlineGameScore{
 			Flags:        flags,
@@ -20784,18 +23633,18 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_messages_getGameHighScores:
 		r = TL_messages_getGameHighScores{
-			Peer:    m.Object(),
+			Peer:    m.objectInputPeer(),
 			Id:      m.Int(),
-			User_id: m.Object(),
+			User_id: m.objectInputUser(),
 		}
 	case crc_messages_getInlineGameHighScores:
 		r = TL_messages_getInlineGameHighScores{
-			Id:      m.Object(),
-			User_id: m.Object(),
+			Id:      m.objectInputBotInlineMessageID(),
+			User_id: m.objectInputUser(),
 		}
 	case crc_messages_getCommonChats:
 		r = TL_messages_getCommonChats{
-			User_id: m.Object(),
+			User_id: m.objectInputUser(),
 			Max_id:  m.Int(),
 			Limit:   m.Int(),
 		}
@@ -20811,7 +23660,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_messages_toggleDialogPin:
 		flags := m.Int()
 		pinned := flags&(1<<0) != 0
-		peer := m.Object()
+		peer := m.objectInputPeer()
 		r = TL_messages_toggleDialogPin{
 			Flags:  flags,
 			Pinned: pinned,
@@ -20820,7 +23669,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_messages_reorderPinnedDialogs:
 		flags := m.Int()
 		force := flags&(1<<0) != 0
-		order := m.Vector()
+		order := m.vectorInputPeer()
 		r = TL_messages_reorderPinnedDialogs{
 			Flags: flags,
 			Force: force,
@@ -20835,9 +23684,9 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<0) != 0 {
 			error = m.String()
 		}
-		var shipping_options []TL
+		var shipping_options []ShippingOption
 		if flags&(1<<1) != 0 {
-			shipping_options = m.Vector()
+			shipping_options = m.vectorShippingOption()
 		}
 		r = TL_messages_setBotShippingResults{
 			Flags:            flags,
@@ -20880,8 +23729,8 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_updates_getChannelDifference:
 		flags := m.Int()
 		force := flags&(1<<0) != 0
-		channel := m.Object()
-		filter := m.Object()
+		channel := m.objectInputChannel()
+		filter := m.objectChannelMessagesFilter()
 		pts := m.Int()
 		limit := m.Int()
 		r = TL_updates_getChannelDifference{
@@ -20894,19 +23743,19 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_photos_updateProfilePhoto:
 		r = TL_photos_updateProfilePhoto{
-			Id: m.Object(),
+			Id: m.objectInputPhoto(),
 		}
 	case crc_photos_uploadProfilePhoto:
 		r = TL_photos_uploadProfilePhoto{
-			File: m.Object(),
+			File: m.objectInputFile(),
 		}
 	case crc_photos_deletePhotos:
 		r = TL_photos_deletePhotos{
-			Id: m.Vector(),
+			Id: m.vectorInputPhoto(),
 		}
 	case crc_photos_getUserPhotos:
 		r = TL_photos_getUserPhotos{
-			User_id: m.Object(),
+			User_id: m.objectInputUser(),
 			Offset:  m.Int(),
 			Max_id:  m.Long(),
 			Limit:   m.Int(),
@@ -20919,7 +23768,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_upload_getFile:
 		r = TL_upload_getFile{
-			Location: m.Object(),
+			Location: m.objectInputFileLocation(),
 			Offset:   m.Int(),
 			Limit:    m.Int(),
 		}
@@ -20932,7 +23781,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_upload_getWebFile:
 		r = TL_upload_getWebFile{
-			Location: m.Object(),
+			Location: m.objectInputWebFileLocation(),
 			Offset:   m.Int(),
 			Limit:    m.Int(),
 		}
@@ -20944,7 +23793,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_help_getAppUpdate{}
 	case crc_help_saveAppLog:
 		r = TL_help_saveAppLog{
-			Events: m.Vector(),
+			Events: m.vectorInputAppEvent(),
 		}
 	case crc_help_getInviteText:
 		r = TL_help_getInviteText{}
@@ -20963,49 +23812,49 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_channels_readHistory:
 		r = TL_channels_readHistory{
-			Channel: m.Object(),
+			Channel: m.objectInputChannel(),
 			Max_id:  m.Int(),
 		}
 	case crc_channels_deleteMessages:
 		r = TL_channels_deleteMessages{
-			Channel: m.Object(),
+			Channel: m.objectInputChannel(),
 			Id:      m.VectorInt(),
 		}
 	case crc_channels_deleteUserHistory:
 		r = TL_channels_deleteUserHistory{
-			Channel: m.Object(),
-			User_id: m.Object(),
+			Channel: m.objectInputChannel(),
+			User_id: m.objectInputUser(),
 		}
 	case crc_channels_reportSpam:
 		r = TL_channels_reportSpam{
-			Channel: m.Object(),
-			User_id: m.Object(),
+			Channel: m.objectInputChannel(),
+			User_id: m.objectInputUser(),
 			Id:      m.VectorInt(),
 		}
 	case crc_channels_getMessages:
 		r = TL_channels_getMessages{
-			Channel: m.Object(),
+			Channel: m.objectInputChannel(),
 			Id:      m.VectorInt(),
 		}
 	case crc_channels_getParticipants:
 		r = TL_channels_getParticipants{
-			Channel: m.Object(),
-			Filter:  m.Object(),
+			Channel: m.objectInputChannel(),
+			Filter:  m.objectChannelParticipantsFilter(),
 			Offset:  m.Int(),
 			Limit:   m.Int(),
 		}
 	case crc_channels_getParticipant:
 		r = TL_channels_getParticipant{
-			Channel: m.Object(),
-			User_id: m.Object(),
+			Channel: m.objectInputChannel(),
+			User_id: m.objectInputUser(),
 		}
 	case crc_channels_getChannels:
 		r = TL_channels_getChannels{
-			Id: m.Vector(),
+			Id: m.vectorInputChannel(),
 		}
 	case crc_channels_getFullChannel:
 		r = TL_channels_getFullChannel{
-			Channel: m.Object(),
+			Channel: m.objectInputChannel(),
 		}
 	case crc_channels_createChannel:
 		flags := m.Int()
@@ -21022,81 +23871,81 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		}
 	case crc_channels_editAbout:
 		r = TL_channels_editAbout{
-			Channel: m.Object(),
+			Channel: m.objectInputChannel(),
 			About:   m.String(),
 		}
 	case crc_channels_editAdmin:
 		r = TL_channels_editAdmin{
-			Channel: m.Object(),
-			User_id: m.Object(),
-			Role:    m.Object(),
+			Channel: m.objectInputChannel(),
+			User_id: m.objectInputUser(),
+			Role:    m.objectChannelParticipantRole(),
 		}
 	case crc_channels_editTitle:
 		r = TL_channels_editTitle{
-			Channel: m.Object(),
+			Channel: m.objectInputChannel(),
 			Title:   m.String(),
 		}
 	case crc_channels_editPhoto:
 		r = TL_channels_editPhoto{
-			Channel: m.Object(),
-			Photo:   m.Object(),
+			Channel: m.objectInputChannel(),
+			Photo:   m.objectInputChatPhoto(),
 		}
 	case crc_channels_checkUsername:
 		r = TL_channels_checkUsername{
-			Channel:  m.Object(),
+			Channel:  m.objectInputChannel(),
 			Username: m.String(),
 		}
 	case crc_channels_updateUsername:
 		r = TL_channels_updateUsername{
-			Channel:  m.Object(),
+			Channel:  m.objectInputChannel(),
 			Username: m.String(),
 		}
 	case crc_channels_joinChannel:
 		r = TL_channels_joinChannel{
-			Channel: m.Object(),
+			Channel: m.objectInputChannel(),
 		}
 	case crc_channels_leaveChannel:
 		r = TL_channels_leaveChannel{
-			Channel: m.Object(),
+			Channel: m.objectInputChannel(),
 		}
 	case crc_channels_inviteToChannel:
 		r = TL_channels_inviteToChannel{
-			Channel: m.Object(),
-			Users:   m.Vector(),
+			Channel: m.objectInputChannel(),
+			Users:   m.vectorInputUser(),
 		}
 	case crc_channels_kickFromChannel:
 		r = TL_channels_kickFromChannel{
-			Channel: m.Object(),
-			User_id: m.Object(),
-			Kicked:  m.Object(),
+			Channel: m.objectInputChannel(),
+			User_id: m.objectInputUser(),
+			Kicked:  m.objectBool(),
 		}
 	case crc_channels_exportInvite:
 		r = TL_channels_exportInvite{
-			Channel: m.Object(),
+			Channel: m.objectInputChannel(),
 		}
 	case crc_channels_deleteChannel:
 		r = TL_channels_deleteChannel{
-			Channel: m.Object(),
+			Channel: m.objectInputChannel(),
 		}
 	case crc_channels_toggleInvites:
 		r = TL_channels_toggleInvites{
-			Channel: m.Object(),
-			Enabled: m.Object(),
+			Channel: m.objectInputChannel(),
+			Enabled: m.objectBool(),
 		}
 	case crc_channels_exportMessageLink:
 		r = TL_channels_exportMessageLink{
-			Channel: m.Object(),
+			Channel: m.objectInputChannel(),
 			Id:      m.Int(),
 		}
 	case crc_channels_toggleSignatures:
 		r = TL_channels_toggleSignatures{
-			Channel: m.Object(),
-			Enabled: m.Object(),
+			Channel: m.objectInputChannel(),
+			Enabled: m.objectBool(),
 		}
 	case crc_channels_updatePinnedMessage:
 		flags := m.Int()
 		silent := flags&(1<<0) != 0
-		channel := m.Object()
+		channel := m.objectInputChannel()
 		id := m.Int()
 		r = TL_channels_updatePinnedMessage{
 			Flags:   flags,
@@ -21109,12 +23958,12 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	case crc_bots_sendCustomRequest:
 		r = TL_bots_sendCustomRequest{
 			Custom_method: m.String(),
-			Params:        m.Object(),
+			Params:        m.objectDataJSON(),
 		}
 	case crc_bots_answerWebhookJSONQuery:
 		r = TL_bots_answerWebhookJSONQuery{
 			Query_id: m.Long(),
-			Data:     m.Object(),
+			Data:     m.objectDataJSON(),
 		}
 	case crc_payments_getPaymentForm:
 		r = TL_payments_getPaymentForm{
@@ -21128,7 +23977,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		flags := m.Int()
 		save := flags&(1<<0) != 0
 		msg_id := m.Int()
-		info := m.Object()
+		info := m.objectPaymentRequestedInfo()
 		r = TL_payments_validateRequestedInfo{
 			Flags:  flags,
 			Save:   save,
@@ -21146,7 +23995,7 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		if flags&(1<<1) != 0 {
 			shipping_option_id = m.String()
 		}
-		credentials := m.Object()
+		credentials := m.objectInputPaymentCredentials()
 		r = TL_payments_sendPaymentForm{
 			Flags:              flags,
 			Msg_id:             msg_id,
@@ -21169,45 +24018,45 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 		r = TL_phone_getCallConfig{}
 	case crc_phone_requestCall:
 		r = TL_phone_requestCall{
-			User_id:   m.Object(),
+			User_id:   m.objectInputUser(),
 			Random_id: m.Int(),
 			G_a_hash:  m.StringBytes(),
-			Protocol:  m.Object(),
+			Protocol:  m.objectPhoneCallProtocol(),
 		}
 	case crc_phone_acceptCall:
 		r = TL_phone_acceptCall{
-			Peer:     m.Object(),
+			Peer:     m.objectInputPhoneCall(),
 			G_b:      m.StringBytes(),
-			Protocol: m.Object(),
+			Protocol: m.objectPhoneCallProtocol(),
 		}
 	case crc_phone_confirmCall:
 		r = TL_phone_confirmCall{
-			Peer:            m.Object(),
+			Peer:            m.objectInputPhoneCall(),
 			G_a:             m.StringBytes(),
 			Key_fingerprint: m.Long(),
-			Protocol:        m.Object(),
+			Protocol:        m.objectPhoneCallProtocol(),
 		}
 	case crc_phone_receivedCall:
 		r = TL_phone_receivedCall{
-			Peer: m.Object(),
+			Peer: m.objectInputPhoneCall(),
 		}
 	case crc_phone_discardCall:
 		r = TL_phone_discardCall{
-			Peer:          m.Object(),
+			Peer:          m.objectInputPhoneCall(),
 			Duration:      m.Int(),
-			Reason:        m.Object(),
+			Reason:        m.objectPhoneCallDiscardReason(),
 			Connection_id: m.Long(),
 		}
 	case crc_phone_setCallRating:
 		r = TL_phone_setCallRating{
-			Peer:    m.Object(),
+			Peer:    m.objectInputPhoneCall(),
 			Rating:  m.Int(),
 			Comment: m.String(),
 		}
 	case crc_phone_saveCallDebug:
 		r = TL_phone_saveCallDebug{
-			Peer:  m.Object(),
-			Debug: m.Object(),
+			Peer:  m.objectInputPhoneCall(),
+			Debug: m.objectDataJSON(),
 		}
 	default:
 		m.err = fmt.Errorf("Unknown constructor: %x", constructor)
@@ -21215,3 +24064,4735 @@ func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {
 	}
 	return
 }
+
+// InvokeAfterMsg invokes invokeAfterMsg
+func (c *Client) InvokeAfterMsg(ctx context.Context, request *TL_invokeAfterMsg) (TL, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	return *tl, nil
+}
+
+// InvokeAfterMsgs invokes invokeAfterMsgs
+func (c *Client) InvokeAfterMsgs(ctx context.Context, request *TL_invokeAfterMsgs) (TL, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	return *tl, nil
+}
+
+// InitConnection invokes initConnection
+func (c *Client) InitConnection(ctx context.Context, request *TL_initConnection) (TL, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	return *tl, nil
+}
+
+// InvokeWithLayer invokes invokeWithLayer
+func (c *Client) InvokeWithLayer(ctx context.Context, request *TL_invokeWithLayer) (TL, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	return *tl, nil
+}
+
+// InvokeWithoutUpdates invokes invokeWithoutUpdates
+func (c *Client) InvokeWithoutUpdates(ctx context.Context, request *TL_invokeWithoutUpdates) (TL, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	return *tl, nil
+}
+
+// AuthCheckPhone invokes auth.checkPhone
+func (c *Client) AuthCheckPhone(ctx context.Context, request *TL_auth_checkPhone) (Auth_CheckedPhone, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Auth_CheckedPhone)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthSendCode invokes auth.sendCode
+func (c *Client) AuthSendCode(ctx context.Context, request *TL_auth_sendCode) (Auth_SentCode, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Auth_SentCode)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthSignUp invokes auth.signUp
+func (c *Client) AuthSignUp(ctx context.Context, request *TL_auth_signUp) (Auth_Authorization, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Auth_Authorization)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthSignIn invokes auth.signIn
+func (c *Client) AuthSignIn(ctx context.Context, request *TL_auth_signIn) (Auth_Authorization, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Auth_Authorization)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthLogOut invokes auth.logOut
+func (c *Client) AuthLogOut(ctx context.Context, request *TL_auth_logOut) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthResetAuthorizations invokes auth.resetAuthorizations
+func (c *Client) AuthResetAuthorizations(ctx context.Context, request *TL_auth_resetAuthorizations) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthSendInvites invokes auth.sendInvites
+func (c *Client) AuthSendInvites(ctx context.Context, request *TL_auth_sendInvites) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthExportAuthorization invokes auth.exportAuthorization
+func (c *Client) AuthExportAuthorization(ctx context.Context, request *TL_auth_exportAuthorization) (Auth_ExportedAuthorization, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Auth_ExportedAuthorization)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthImportAuthorization invokes auth.importAuthorization
+func (c *Client) AuthImportAuthorization(ctx context.Context, request *TL_auth_importAuthorization) (Auth_Authorization, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Auth_Authorization)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthBindTempAuthKey invokes auth.bindTempAuthKey
+func (c *Client) AuthBindTempAuthKey(ctx context.Context, request *TL_auth_bindTempAuthKey) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthImportBotAuthorization invokes auth.importBotAuthorization
+func (c *Client) AuthImportBotAuthorization(ctx context.Context, request *TL_auth_importBotAuthorization) (Auth_Authorization, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Auth_Authorization)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthCheckPassword invokes auth.checkPassword
+func (c *Client) AuthCheckPassword(ctx context.Context, request *TL_auth_checkPassword) (Auth_Authorization, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Auth_Authorization)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthRequestPasswordRecovery invokes auth.requestPasswordRecovery
+func (c *Client) AuthRequestPasswordRecovery(ctx context.Context, request *TL_auth_requestPasswordRecovery) (Auth_PasswordRecovery, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Auth_PasswordRecovery)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthRecoverPassword invokes auth.recoverPassword
+func (c *Client) AuthRecoverPassword(ctx context.Context, request *TL_auth_recoverPassword) (Auth_Authorization, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Auth_Authorization)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthResendCode invokes auth.resendCode
+func (c *Client) AuthResendCode(ctx context.Context, request *TL_auth_resendCode) (Auth_SentCode, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Auth_SentCode)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthCancelCode invokes auth.cancelCode
+func (c *Client) AuthCancelCode(ctx context.Context, request *TL_auth_cancelCode) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AuthDropTempAuthKeys invokes auth.dropTempAuthKeys
+func (c *Client) AuthDropTempAuthKeys(ctx context.Context, request *TL_auth_dropTempAuthKeys) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountRegisterDevice invokes account.registerDevice
+func (c *Client) AccountRegisterDevice(ctx context.Context, request *TL_account_registerDevice) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountUnregisterDevice invokes account.unregisterDevice
+func (c *Client) AccountUnregisterDevice(ctx context.Context, request *TL_account_unregisterDevice) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountUpdateNotifySettings invokes account.updateNotifySettings
+func (c *Client) AccountUpdateNotifySettings(ctx context.Context, request *TL_account_updateNotifySettings) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountGetNotifySettings invokes account.getNotifySettings
+func (c *Client) AccountGetNotifySettings(ctx context.Context, request *TL_account_getNotifySettings) (PeerNotifySettings, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(PeerNotifySettings)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountResetNotifySettings invokes account.resetNotifySettings
+func (c *Client) AccountResetNotifySettings(ctx context.Context, request *TL_account_resetNotifySettings) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountUpdateProfile invokes account.updateProfile
+func (c *Client) AccountUpdateProfile(ctx context.Context, request *TL_account_updateProfile) (User, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(User)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountUpdateStatus invokes account.updateStatus
+func (c *Client) AccountUpdateStatus(ctx context.Context, request *TL_account_updateStatus) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountGetWallPapers invokes account.getWallPapers
+func (c *Client) AccountGetWallPapers(ctx context.Context, request *TL_account_getWallPapers) ([]WallPaper, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	d, err := vectorResult(*tl)
+	if err != nil {
+		return nil, err
+	}
+	r := d.vectorWallPaper()
+	if d.err != nil {
+		return nil, d.err
+	}
+	for _, v := range r {
+		c.m.entities.remember(v)
+	}
+	return r, nil
+}
+
+// AccountReportPeer invokes account.reportPeer
+func (c *Client) AccountReportPeer(ctx context.Context, request *TL_account_reportPeer) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountCheckUsername invokes account.checkUsername
+func (c *Client) AccountCheckUsername(ctx context.Context, request *TL_account_checkUsername) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountUpdateUsername invokes account.updateUsername
+func (c *Client) AccountUpdateUsername(ctx context.Context, request *TL_account_updateUsername) (User, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(User)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountGetPrivacy invokes account.getPrivacy
+func (c *Client) AccountGetPrivacy(ctx context.Context, request *TL_account_getPrivacy) (Account_PrivacyRules, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Account_PrivacyRules)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountSetPrivacy invokes account.setPrivacy
+func (c *Client) AccountSetPrivacy(ctx context.Context, request *TL_account_setPrivacy) (Account_PrivacyRules, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Account_PrivacyRules)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountDeleteAccount invokes account.deleteAccount
+func (c *Client) AccountDeleteAccount(ctx context.Context, request *TL_account_deleteAccount) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountGetAccountTTL invokes account.getAccountTTL
+func (c *Client) AccountGetAccountTTL(ctx context.Context, request *TL_account_getAccountTTL) (AccountDaysTTL, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(AccountDaysTTL)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountSetAccountTTL invokes account.setAccountTTL
+func (c *Client) AccountSetAccountTTL(ctx context.Context, request *TL_account_setAccountTTL) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountSendChangePhoneCode invokes account.sendChangePhoneCode
+func (c *Client) AccountSendChangePhoneCode(ctx context.Context, request *TL_account_sendChangePhoneCode) (Auth_SentCode, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Auth_SentCode)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountChangePhone invokes account.changePhone
+func (c *Client) AccountChangePhone(ctx context.Context, request *TL_account_changePhone) (User, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(User)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountUpdateDeviceLocked invokes account.updateDeviceLocked
+func (c *Client) AccountUpdateDeviceLocked(ctx context.Context, request *TL_account_updateDeviceLocked) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountGetAuthorizations invokes account.getAuthorizations
+func (c *Client) AccountGetAuthorizations(ctx context.Context, request *TL_account_getAuthorizations) (Account_Authorizations, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Account_Authorizations)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountResetAuthorization invokes account.resetAuthorization
+func (c *Client) AccountResetAuthorization(ctx context.Context, request *TL_account_resetAuthorization) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountGetPassword invokes account.getPassword
+func (c *Client) AccountGetPassword(ctx context.Context, request *TL_account_getPassword) (Account_Password, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Account_Password)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountGetPasswordSettings invokes account.getPasswordSettings
+func (c *Client) AccountGetPasswordSettings(ctx context.Context, request *TL_account_getPasswordSettings) (Account_PasswordSettings, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Account_PasswordSettings)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountUpdatePasswordSettings invokes account.updatePasswordSettings
+func (c *Client) AccountUpdatePasswordSettings(ctx context.Context, request *TL_account_updatePasswordSettings) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountSendConfirmPhoneCode invokes account.sendConfirmPhoneCode
+func (c *Client) AccountSendConfirmPhoneCode(ctx context.Context, request *TL_account_sendConfirmPhoneCode) (Auth_SentCode, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Auth_SentCode)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountConfirmPhone invokes account.confirmPhone
+func (c *Client) AccountConfirmPhone(ctx context.Context, request *TL_account_confirmPhone) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// AccountGetTmpPassword invokes account.getTmpPassword
+func (c *Client) AccountGetTmpPassword(ctx context.Context, request *TL_account_getTmpPassword) (Account_TmpPassword, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Account_TmpPassword)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// UsersGetUsers invokes users.getUsers
+func (c *Client) UsersGetUsers(ctx context.Context, request *TL_users_getUsers) ([]User, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	d, err := vectorResult(*tl)
+	if err != nil {
+		return nil, err
+	}
+	r := d.vectorUser()
+	if d.err != nil {
+		return nil, d.err
+	}
+	for _, v := range r {
+		c.m.entities.remember(v)
+	}
+	return r, nil
+}
+
+// UsersGetFullUser invokes users.getFullUser
+func (c *Client) UsersGetFullUser(ctx context.Context, request *TL_users_getFullUser) (UserFull, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(UserFull)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ContactsGetStatuses invokes contacts.getStatuses
+func (c *Client) ContactsGetStatuses(ctx context.Context, request *TL_contacts_getStatuses) ([]ContactStatus, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	d, err := vectorResult(*tl)
+	if err != nil {
+		return nil, err
+	}
+	r := d.vectorContactStatus()
+	if d.err != nil {
+		return nil, d.err
+	}
+	for _, v := range r {
+		c.m.entities.remember(v)
+	}
+	return r, nil
+}
+
+// ContactsGetContacts invokes contacts.getContacts
+func (c *Client) ContactsGetContacts(ctx context.Context, request *TL_contacts_getContacts) (Contacts_Contacts, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Contacts_Contacts)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ContactsImportContacts invokes contacts.importContacts
+func (c *Client) ContactsImportContacts(ctx context.Context, request *TL_contacts_importContacts) (Contacts_ImportedContacts, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Contacts_ImportedContacts)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ContactsDeleteContact invokes contacts.deleteContact
+func (c *Client) ContactsDeleteContact(ctx context.Context, request *TL_contacts_deleteContact) (Contacts_Link, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Contacts_Link)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ContactsDeleteContacts invokes contacts.deleteContacts
+func (c *Client) ContactsDeleteContacts(ctx context.Context, request *TL_contacts_deleteContacts) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ContactsBlock invokes contacts.block
+func (c *Client) ContactsBlock(ctx context.Context, request *TL_contacts_block) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ContactsUnblock invokes contacts.unblock
+func (c *Client) ContactsUnblock(ctx context.Context, request *TL_contacts_unblock) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ContactsGetBlocked invokes contacts.getBlocked
+func (c *Client) ContactsGetBlocked(ctx context.Context, request *TL_contacts_getBlocked) (Contacts_Blocked, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Contacts_Blocked)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ContactsExportCard invokes contacts.exportCard
+func (c *Client) ContactsExportCard(ctx context.Context, request *TL_contacts_exportCard) ([]int32, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	d, err := vectorResult(*tl)
+	if err != nil {
+		return nil, err
+	}
+	r := d.VectorInt()
+	if d.err != nil {
+		return nil, d.err
+	}
+	return r, nil
+}
+
+// ContactsImportCard invokes contacts.importCard
+func (c *Client) ContactsImportCard(ctx context.Context, request *TL_contacts_importCard) (User, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(User)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ContactsSearch invokes contacts.search
+func (c *Client) ContactsSearch(ctx context.Context, request *TL_contacts_search) (Contacts_Found, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Contacts_Found)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ContactsResolveUsername invokes contacts.resolveUsername
+func (c *Client) ContactsResolveUsername(ctx context.Context, request *TL_contacts_resolveUsername) (Contacts_ResolvedPeer, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Contacts_ResolvedPeer)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ContactsGetTopPeers invokes contacts.getTopPeers
+func (c *Client) ContactsGetTopPeers(ctx context.Context, request *TL_contacts_getTopPeers) (Contacts_TopPeers, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Contacts_TopPeers)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ContactsResetTopPeerRating invokes contacts.resetTopPeerRating
+func (c *Client) ContactsResetTopPeerRating(ctx context.Context, request *TL_contacts_resetTopPeerRating) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetMessages invokes messages.getMessages
+func (c *Client) MessagesGetMessages(ctx context.Context, request *TL_messages_getMessages) (Messages_Messages, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_Messages)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetDialogs invokes messages.getDialogs
+func (c *Client) MessagesGetDialogs(ctx context.Context, request *TL_messages_getDialogs) (Messages_Dialogs, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_Dialogs)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetHistory invokes messages.getHistory
+func (c *Client) MessagesGetHistory(ctx context.Context, request *TL_messages_getHistory) (Messages_Messages, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_Messages)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSearch invokes messages.search
+func (c *Client) MessagesSearch(ctx context.Context, request *TL_messages_search) (Messages_Messages, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_Messages)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesReadHistory invokes messages.readHistory
+func (c *Client) MessagesReadHistory(ctx context.Context, request *TL_messages_readHistory) (Messages_AffectedMessages, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_AffectedMessages)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesDeleteHistory invokes messages.deleteHistory
+func (c *Client) MessagesDeleteHistory(ctx context.Context, request *TL_messages_deleteHistory) (Messages_AffectedHistory, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_AffectedHistory)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesDeleteMessages invokes messages.deleteMessages
+func (c *Client) MessagesDeleteMessages(ctx context.Context, request *TL_messages_deleteMessages) (Messages_AffectedMessages, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_AffectedMessages)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesReceivedMessages invokes messages.receivedMessages
+func (c *Client) MessagesReceivedMessages(ctx context.Context, request *TL_messages_receivedMessages) ([]ReceivedNotifyMessage, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	d, err := vectorResult(*tl)
+	if err != nil {
+		return nil, err
+	}
+	r := d.vectorReceivedNotifyMessage()
+	if d.err != nil {
+		return nil, d.err
+	}
+	for _, v := range r {
+		c.m.entities.remember(v)
+	}
+	return r, nil
+}
+
+// MessagesSetTyping invokes messages.setTyping
+func (c *Client) MessagesSetTyping(ctx context.Context, request *TL_messages_setTyping) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSendMessage invokes messages.sendMessage
+func (c *Client) MessagesSendMessage(ctx context.Context, request *TL_messages_sendMessage) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSendMedia invokes messages.sendMedia
+func (c *Client) MessagesSendMedia(ctx context.Context, request *TL_messages_sendMedia) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesForwardMessages invokes messages.forwardMessages
+func (c *Client) MessagesForwardMessages(ctx context.Context, request *TL_messages_forwardMessages) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesReportSpam invokes messages.reportSpam
+func (c *Client) MessagesReportSpam(ctx context.Context, request *TL_messages_reportSpam) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesHideReportSpam invokes messages.hideReportSpam
+func (c *Client) MessagesHideReportSpam(ctx context.Context, request *TL_messages_hideReportSpam) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetPeerSettings invokes messages.getPeerSettings
+func (c *Client) MessagesGetPeerSettings(ctx context.Context, request *TL_messages_getPeerSettings) (PeerSettings, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(PeerSettings)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetChats invokes messages.getChats
+func (c *Client) MessagesGetChats(ctx context.Context, request *TL_messages_getChats) (Messages_Chats, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_Chats)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetFullChat invokes messages.getFullChat
+func (c *Client) MessagesGetFullChat(ctx context.Context, request *TL_messages_getFullChat) (Messages_ChatFull, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_ChatFull)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesEditChatTitle invokes messages.editChatTitle
+func (c *Client) MessagesEditChatTitle(ctx context.Context, request *TL_messages_editChatTitle) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesEditChatPhoto invokes messages.editChatPhoto
+func (c *Client) MessagesEditChatPhoto(ctx context.Context, request *TL_messages_editChatPhoto) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesAddChatUser invokes messages.addChatUser
+func (c *Client) MessagesAddChatUser(ctx context.Context, request *TL_messages_addChatUser) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesDeleteChatUser invokes messages.deleteChatUser
+func (c *Client) MessagesDeleteChatUser(ctx context.Context, request *TL_messages_deleteChatUser) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesCreateChat invokes messages.createChat
+func (c *Client) MessagesCreateChat(ctx context.Context, request *TL_messages_createChat) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesForwardMessage invokes messages.forwardMessage
+func (c *Client) MessagesForwardMessage(ctx context.Context, request *TL_messages_forwardMessage) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetDhConfig invokes messages.getDhConfig
+func (c *Client) MessagesGetDhConfig(ctx context.Context, request *TL_messages_getDhConfig) (Messages_DhConfig, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_DhConfig)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesRequestEncryption invokes messages.requestEncryption
+func (c *Client) MessagesRequestEncryption(ctx context.Context, request *TL_messages_requestEncryption) (EncryptedChat, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(EncryptedChat)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesAcceptEncryption invokes messages.acceptEncryption
+func (c *Client) MessagesAcceptEncryption(ctx context.Context, request *TL_messages_acceptEncryption) (EncryptedChat, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(EncryptedChat)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesDiscardEncryption invokes messages.discardEncryption
+func (c *Client) MessagesDiscardEncryption(ctx context.Context, request *TL_messages_discardEncryption) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSetEncryptedTyping invokes messages.setEncryptedTyping
+func (c *Client) MessagesSetEncryptedTyping(ctx context.Context, request *TL_messages_setEncryptedTyping) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesReadEncryptedHistory invokes messages.readEncryptedHistory
+func (c *Client) MessagesReadEncryptedHistory(ctx context.Context, request *TL_messages_readEncryptedHistory) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSendEncrypted invokes messages.sendEncrypted
+func (c *Client) MessagesSendEncrypted(ctx context.Context, request *TL_messages_sendEncrypted) (Messages_SentEncryptedMessage, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_SentEncryptedMessage)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSendEncryptedFile invokes messages.sendEncryptedFile
+func (c *Client) MessagesSendEncryptedFile(ctx context.Context, request *TL_messages_sendEncryptedFile) (Messages_SentEncryptedMessage, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_SentEncryptedMessage)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSendEncryptedService invokes messages.sendEncryptedService
+func (c *Client) MessagesSendEncryptedService(ctx context.Context, request *TL_messages_sendEncryptedService) (Messages_SentEncryptedMessage, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_SentEncryptedMessage)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesReceivedQueue invokes messages.receivedQueue
+func (c *Client) MessagesReceivedQueue(ctx context.Context, request *TL_messages_receivedQueue) ([]int64, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	d, err := vectorResult(*tl)
+	if err != nil {
+		return nil, err
+	}
+	r := d.VectorLong()
+	if d.err != nil {
+		return nil, d.err
+	}
+	return r, nil
+}
+
+// MessagesReportEncryptedSpam invokes messages.reportEncryptedSpam
+func (c *Client) MessagesReportEncryptedSpam(ctx context.Context, request *TL_messages_reportEncryptedSpam) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesReadMessageContents invokes messages.readMessageContents
+func (c *Client) MessagesReadMessageContents(ctx context.Context, request *TL_messages_readMessageContents) (Messages_AffectedMessages, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_AffectedMessages)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetAllStickers invokes messages.getAllStickers
+func (c *Client) MessagesGetAllStickers(ctx context.Context, request *TL_messages_getAllStickers) (Messages_AllStickers, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_AllStickers)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetWebPagePreview invokes messages.getWebPagePreview
+func (c *Client) MessagesGetWebPagePreview(ctx context.Context, request *TL_messages_getWebPagePreview) (MessageMedia, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(MessageMedia)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesExportChatInvite invokes messages.exportChatInvite
+func (c *Client) MessagesExportChatInvite(ctx context.Context, request *TL_messages_exportChatInvite) (ExportedChatInvite, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(ExportedChatInvite)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesCheckChatInvite invokes messages.checkChatInvite
+func (c *Client) MessagesCheckChatInvite(ctx context.Context, request *TL_messages_checkChatInvite) (ChatInvite, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(ChatInvite)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesImportChatInvite invokes messages.importChatInvite
+func (c *Client) MessagesImportChatInvite(ctx context.Context, request *TL_messages_importChatInvite) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetStickerSet invokes messages.getStickerSet
+func (c *Client) MessagesGetStickerSet(ctx context.Context, request *TL_messages_getStickerSet) (Messages_StickerSet, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_StickerSet)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesInstallStickerSet invokes messages.installStickerSet
+func (c *Client) MessagesInstallStickerSet(ctx context.Context, request *TL_messages_installStickerSet) (Messages_StickerSetInstallResult, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_StickerSetInstallResult)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesUninstallStickerSet invokes messages.uninstallStickerSet
+func (c *Client) MessagesUninstallStickerSet(ctx context.Context, request *TL_messages_uninstallStickerSet) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesStartBot invokes messages.startBot
+func (c *Client) MessagesStartBot(ctx context.Context, request *TL_messages_startBot) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetMessagesViews invokes messages.getMessagesViews
+func (c *Client) MessagesGetMessagesViews(ctx context.Context, request *TL_messages_getMessagesViews) ([]int32, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	d, err := vectorResult(*tl)
+	if err != nil {
+		return nil, err
+	}
+	r := d.VectorInt()
+	if d.err != nil {
+		return nil, d.err
+	}
+	return r, nil
+}
+
+// MessagesToggleChatAdmins invokes messages.toggleChatAdmins
+func (c *Client) MessagesToggleChatAdmins(ctx context.Context, request *TL_messages_toggleChatAdmins) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesEditChatAdmin invokes messages.editChatAdmin
+func (c *Client) MessagesEditChatAdmin(ctx context.Context, request *TL_messages_editChatAdmin) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesMigrateChat invokes messages.migrateChat
+func (c *Client) MessagesMigrateChat(ctx context.Context, request *TL_messages_migrateChat) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSearchGlobal invokes messages.searchGlobal
+func (c *Client) MessagesSearchGlobal(ctx context.Context, request *TL_messages_searchGlobal) (Messages_Messages, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_Messages)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesReorderStickerSets invokes messages.reorderStickerSets
+func (c *Client) MessagesReorderStickerSets(ctx context.Context, request *TL_messages_reorderStickerSets) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetDocumentByHash invokes messages.getDocumentByHash
+func (c *Client) MessagesGetDocumentByHash(ctx context.Context, request *TL_messages_getDocumentByHash) (Document, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Document)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSearchGifs invokes messages.searchGifs
+func (c *Client) MessagesSearchGifs(ctx context.Context, request *TL_messages_searchGifs) (Messages_FoundGifs, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_FoundGifs)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetSavedGifs invokes messages.getSavedGifs
+func (c *Client) MessagesGetSavedGifs(ctx context.Context, request *TL_messages_getSavedGifs) (Messages_SavedGifs, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_SavedGifs)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSaveGif invokes messages.saveGif
+func (c *Client) MessagesSaveGif(ctx context.Context, request *TL_messages_saveGif) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetInlineBotResults invokes messages.getInlineBotResults
+func (c *Client) MessagesGetInlineBotResults(ctx context.Context, request *TL_messages_getInlineBotResults) (Messages_BotResults, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_BotResults)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSetInlineBotResults invokes messages.setInlineBotResults
+func (c *Client) MessagesSetInlineBotResults(ctx context.Context, request *TL_messages_setInlineBotResults) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSendInlineBotResult invokes messages.sendInlineBotResult
+func (c *Client) MessagesSendInlineBotResult(ctx context.Context, request *TL_messages_sendInlineBotResult) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetMessageEditData invokes messages.getMessageEditData
+func (c *Client) MessagesGetMessageEditData(ctx context.Context, request *TL_messages_getMessageEditData) (Messages_MessageEditData, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_MessageEditData)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesEditMessage invokes messages.editMessage
+func (c *Client) MessagesEditMessage(ctx context.Context, request *TL_messages_editMessage) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesEditInlineBotMessage invokes messages.editInlineBotMessage
+func (c *Client) MessagesEditInlineBotMessage(ctx context.Context, request *TL_messages_editInlineBotMessage) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetBotCallbackAnswer invokes messages.getBotCallbackAnswer
+func (c *Client) MessagesGetBotCallbackAnswer(ctx context.Context, request *TL_messages_getBotCallbackAnswer) (Messages_BotCallbackAnswer, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_BotCallbackAnswer)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSetBotCallbackAnswer invokes messages.setBotCallbackAnswer
+func (c *Client) MessagesSetBotCallbackAnswer(ctx context.Context, request *TL_messages_setBotCallbackAnswer) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetPeerDialogs invokes messages.getPeerDialogs
+func (c *Client) MessagesGetPeerDialogs(ctx context.Context, request *TL_messages_getPeerDialogs) (Messages_PeerDialogs, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_PeerDialogs)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSaveDraft invokes messages.saveDraft
+func (c *Client) MessagesSaveDraft(ctx context.Context, request *TL_messages_saveDraft) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetAllDrafts invokes messages.getAllDrafts
+func (c *Client) MessagesGetAllDrafts(ctx context.Context, request *TL_messages_getAllDrafts) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetFeaturedStickers invokes messages.getFeaturedStickers
+func (c *Client) MessagesGetFeaturedStickers(ctx context.Context, request *TL_messages_getFeaturedStickers) (Messages_FeaturedStickers, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_FeaturedStickers)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesReadFeaturedStickers invokes messages.readFeaturedStickers
+func (c *Client) MessagesReadFeaturedStickers(ctx context.Context, request *TL_messages_readFeaturedStickers) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetRecentStickers invokes messages.getRecentStickers
+func (c *Client) MessagesGetRecentStickers(ctx context.Context, request *TL_messages_getRecentStickers) (Messages_RecentStickers, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_RecentStickers)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSaveRecentSticker invokes messages.saveRecentSticker
+func (c *Client) MessagesSaveRecentSticker(ctx context.Context, request *TL_messages_saveRecentSticker) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesClearRecentStickers invokes messages.clearRecentStickers
+func (c *Client) MessagesClearRecentStickers(ctx context.Context, request *TL_messages_clearRecentStickers) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetArchivedStickers invokes messages.getArchivedStickers
+func (c *Client) MessagesGetArchivedStickers(ctx context.Context, request *TL_messages_getArchivedStickers) (Messages_ArchivedStickers, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_ArchivedStickers)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetMaskStickers invokes messages.getMaskStickers
+func (c *Client) MessagesGetMaskStickers(ctx context.Context, request *TL_messages_getMaskStickers) (Messages_AllStickers, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_AllStickers)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetAttachedStickers invokes messages.getAttachedStickers
+func (c *Client) MessagesGetAttachedStickers(ctx context.Context, request *TL_messages_getAttachedStickers) ([]StickerSetCovered, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	d, err := vectorResult(*tl)
+	if err != nil {
+		return nil, err
+	}
+	r := d.vectorStickerSetCovered()
+	if d.err != nil {
+		return nil, d.err
+	}
+	for _, v := range r {
+		c.m.entities.remember(v)
+	}
+	return r, nil
+}
+
+// MessagesSetGameScore invokes messages.setGameScore
+func (c *Client) MessagesSetGameScore(ctx context.Context, request *TL_messages_setGameScore) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSetInlineGameScore invokes messages.setInlineGameScore
+func (c *Client) MessagesSetInlineGameScore(ctx context.Context, request *TL_messages_setInlineGameScore) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetGameHighScores invokes messages.getGameHighScores
+func (c *Client) MessagesGetGameHighScores(ctx context.Context, request *TL_messages_getGameHighScores) (Messages_HighScores, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_HighScores)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetInlineGameHighScores invokes messages.getInlineGameHighScores
+func (c *Client) MessagesGetInlineGameHighScores(ctx context.Context, request *TL_messages_getInlineGameHighScores) (Messages_HighScores, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_HighScores)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetCommonChats invokes messages.getCommonChats
+func (c *Client) MessagesGetCommonChats(ctx context.Context, request *TL_messages_getCommonChats) (Messages_Chats, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_Chats)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetAllChats invokes messages.getAllChats
+func (c *Client) MessagesGetAllChats(ctx context.Context, request *TL_messages_getAllChats) (Messages_Chats, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_Chats)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetWebPage invokes messages.getWebPage
+func (c *Client) MessagesGetWebPage(ctx context.Context, request *TL_messages_getWebPage) (WebPage, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(WebPage)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesToggleDialogPin invokes messages.toggleDialogPin
+func (c *Client) MessagesToggleDialogPin(ctx context.Context, request *TL_messages_toggleDialogPin) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesReorderPinnedDialogs invokes messages.reorderPinnedDialogs
+func (c *Client) MessagesReorderPinnedDialogs(ctx context.Context, request *TL_messages_reorderPinnedDialogs) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesGetPinnedDialogs invokes messages.getPinnedDialogs
+func (c *Client) MessagesGetPinnedDialogs(ctx context.Context, request *TL_messages_getPinnedDialogs) (Messages_PeerDialogs, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_PeerDialogs)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSetBotShippingResults invokes messages.setBotShippingResults
+func (c *Client) MessagesSetBotShippingResults(ctx context.Context, request *TL_messages_setBotShippingResults) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// MessagesSetBotPrecheckoutResults invokes messages.setBotPrecheckoutResults
+func (c *Client) MessagesSetBotPrecheckoutResults(ctx context.Context, request *TL_messages_setBotPrecheckoutResults) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// UpdatesGetState invokes updates.getState
+func (c *Client) UpdatesGetState(ctx context.Context, request *TL_updates_getState) (Updates_State, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates_State)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// UpdatesGetDifference invokes updates.getDifference
+func (c *Client) UpdatesGetDifference(ctx context.Context, request *TL_updates_getDifference) (Updates_Difference, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates_Difference)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// UpdatesGetChannelDifference invokes updates.getChannelDifference
+func (c *Client) UpdatesGetChannelDifference(ctx context.Context, request *TL_updates_getChannelDifference) (Updates_ChannelDifference, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates_ChannelDifference)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PhotosUpdateProfilePhoto invokes photos.updateProfilePhoto
+func (c *Client) PhotosUpdateProfilePhoto(ctx context.Context, request *TL_photos_updateProfilePhoto) (UserProfilePhoto, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(UserProfilePhoto)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PhotosUploadProfilePhoto invokes photos.uploadProfilePhoto
+func (c *Client) PhotosUploadProfilePhoto(ctx context.Context, request *TL_photos_uploadProfilePhoto) (Photos_Photo, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Photos_Photo)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PhotosDeletePhotos invokes photos.deletePhotos
+func (c *Client) PhotosDeletePhotos(ctx context.Context, request *TL_photos_deletePhotos) ([]int64, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	d, err := vectorResult(*tl)
+	if err != nil {
+		return nil, err
+	}
+	r := d.VectorLong()
+	if d.err != nil {
+		return nil, d.err
+	}
+	return r, nil
+}
+
+// PhotosGetUserPhotos invokes photos.getUserPhotos
+func (c *Client) PhotosGetUserPhotos(ctx context.Context, request *TL_photos_getUserPhotos) (Photos_Photos, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Photos_Photos)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// UploadSaveFilePart invokes upload.saveFilePart
+func (c *Client) UploadSaveFilePart(ctx context.Context, request *TL_upload_saveFilePart) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// UploadGetFile invokes upload.getFile
+func (c *Client) UploadGetFile(ctx context.Context, request *TL_upload_getFile) (Upload_File, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Upload_File)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// UploadSaveBigFilePart invokes upload.saveBigFilePart
+func (c *Client) UploadSaveBigFilePart(ctx context.Context, request *TL_upload_saveBigFilePart) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// UploadGetWebFile invokes upload.getWebFile
+func (c *Client) UploadGetWebFile(ctx context.Context, request *TL_upload_getWebFile) (Upload_WebFile, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Upload_WebFile)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// HelpGetConfig invokes help.getConfig
+func (c *Client) HelpGetConfig(ctx context.Context, request *TL_help_getConfig) (Config, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Config)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// HelpGetNearestDc invokes help.getNearestDc
+func (c *Client) HelpGetNearestDc(ctx context.Context, request *TL_help_getNearestDc) (NearestDc, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(NearestDc)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// HelpGetAppUpdate invokes help.getAppUpdate
+func (c *Client) HelpGetAppUpdate(ctx context.Context, request *TL_help_getAppUpdate) (Help_AppUpdate, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Help_AppUpdate)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// HelpSaveAppLog invokes help.saveAppLog
+func (c *Client) HelpSaveAppLog(ctx context.Context, request *TL_help_saveAppLog) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// HelpGetInviteText invokes help.getInviteText
+func (c *Client) HelpGetInviteText(ctx context.Context, request *TL_help_getInviteText) (Help_InviteText, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Help_InviteText)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// HelpGetSupport invokes help.getSupport
+func (c *Client) HelpGetSupport(ctx context.Context, request *TL_help_getSupport) (Help_Support, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Help_Support)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// HelpGetAppChangelog invokes help.getAppChangelog
+func (c *Client) HelpGetAppChangelog(ctx context.Context, request *TL_help_getAppChangelog) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// HelpGetTermsOfService invokes help.getTermsOfService
+func (c *Client) HelpGetTermsOfService(ctx context.Context, request *TL_help_getTermsOfService) (Help_TermsOfService, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Help_TermsOfService)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// HelpSetBotUpdatesStatus invokes help.setBotUpdatesStatus
+func (c *Client) HelpSetBotUpdatesStatus(ctx context.Context, request *TL_help_setBotUpdatesStatus) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsReadHistory invokes channels.readHistory
+func (c *Client) ChannelsReadHistory(ctx context.Context, request *TL_channels_readHistory) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsDeleteMessages invokes channels.deleteMessages
+func (c *Client) ChannelsDeleteMessages(ctx context.Context, request *TL_channels_deleteMessages) (Messages_AffectedMessages, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_AffectedMessages)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsDeleteUserHistory invokes channels.deleteUserHistory
+func (c *Client) ChannelsDeleteUserHistory(ctx context.Context, request *TL_channels_deleteUserHistory) (Messages_AffectedHistory, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_AffectedHistory)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsReportSpam invokes channels.reportSpam
+func (c *Client) ChannelsReportSpam(ctx context.Context, request *TL_channels_reportSpam) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsGetMessages invokes channels.getMessages
+func (c *Client) ChannelsGetMessages(ctx context.Context, request *TL_channels_getMessages) (Messages_Messages, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_Messages)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsGetParticipants invokes channels.getParticipants
+func (c *Client) ChannelsGetParticipants(ctx context.Context, request *TL_channels_getParticipants) (Channels_ChannelParticipants, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Channels_ChannelParticipants)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsGetParticipant invokes channels.getParticipant
+func (c *Client) ChannelsGetParticipant(ctx context.Context, request *TL_channels_getParticipant) (Channels_ChannelParticipant, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Channels_ChannelParticipant)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsGetChannels invokes channels.getChannels
+func (c *Client) ChannelsGetChannels(ctx context.Context, request *TL_channels_getChannels) (Messages_Chats, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_Chats)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsGetFullChannel invokes channels.getFullChannel
+func (c *Client) ChannelsGetFullChannel(ctx context.Context, request *TL_channels_getFullChannel) (Messages_ChatFull, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_ChatFull)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsCreateChannel invokes channels.createChannel
+func (c *Client) ChannelsCreateChannel(ctx context.Context, request *TL_channels_createChannel) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsEditAbout invokes channels.editAbout
+func (c *Client) ChannelsEditAbout(ctx context.Context, request *TL_channels_editAbout) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsEditAdmin invokes channels.editAdmin
+func (c *Client) ChannelsEditAdmin(ctx context.Context, request *TL_channels_editAdmin) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsEditTitle invokes channels.editTitle
+func (c *Client) ChannelsEditTitle(ctx context.Context, request *TL_channels_editTitle) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsEditPhoto invokes channels.editPhoto
+func (c *Client) ChannelsEditPhoto(ctx context.Context, request *TL_channels_editPhoto) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsCheckUsername invokes channels.checkUsername
+func (c *Client) ChannelsCheckUsername(ctx context.Context, request *TL_channels_checkUsername) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsUpdateUsername invokes channels.updateUsername
+func (c *Client) ChannelsUpdateUsername(ctx context.Context, request *TL_channels_updateUsername) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsJoinChannel invokes channels.joinChannel
+func (c *Client) ChannelsJoinChannel(ctx context.Context, request *TL_channels_joinChannel) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsLeaveChannel invokes channels.leaveChannel
+func (c *Client) ChannelsLeaveChannel(ctx context.Context, request *TL_channels_leaveChannel) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsInviteToChannel invokes channels.inviteToChannel
+func (c *Client) ChannelsInviteToChannel(ctx context.Context, request *TL_channels_inviteToChannel) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsKickFromChannel invokes channels.kickFromChannel
+func (c *Client) ChannelsKickFromChannel(ctx context.Context, request *TL_channels_kickFromChannel) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsExportInvite invokes channels.exportInvite
+func (c *Client) ChannelsExportInvite(ctx context.Context, request *TL_channels_exportInvite) (ExportedChatInvite, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(ExportedChatInvite)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsDeleteChannel invokes channels.deleteChannel
+func (c *Client) ChannelsDeleteChannel(ctx context.Context, request *TL_channels_deleteChannel) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsToggleInvites invokes channels.toggleInvites
+func (c *Client) ChannelsToggleInvites(ctx context.Context, request *TL_channels_toggleInvites) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsExportMessageLink invokes channels.exportMessageLink
+func (c *Client) ChannelsExportMessageLink(ctx context.Context, request *TL_channels_exportMessageLink) (ExportedMessageLink, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(ExportedMessageLink)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsToggleSignatures invokes channels.toggleSignatures
+func (c *Client) ChannelsToggleSignatures(ctx context.Context, request *TL_channels_toggleSignatures) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsUpdatePinnedMessage invokes channels.updatePinnedMessage
+func (c *Client) ChannelsUpdatePinnedMessage(ctx context.Context, request *TL_channels_updatePinnedMessage) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// ChannelsGetAdminedPublicChannels invokes channels.getAdminedPublicChannels
+func (c *Client) ChannelsGetAdminedPublicChannels(ctx context.Context, request *TL_channels_getAdminedPublicChannels) (Messages_Chats, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Messages_Chats)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// BotsSendCustomRequest invokes bots.sendCustomRequest
+func (c *Client) BotsSendCustomRequest(ctx context.Context, request *TL_bots_sendCustomRequest) (DataJSON, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(DataJSON)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// BotsAnswerWebhookJSONQuery invokes bots.answerWebhookJSONQuery
+func (c *Client) BotsAnswerWebhookJSONQuery(ctx context.Context, request *TL_bots_answerWebhookJSONQuery) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PaymentsGetPaymentForm invokes payments.getPaymentForm
+func (c *Client) PaymentsGetPaymentForm(ctx context.Context, request *TL_payments_getPaymentForm) (Payments_PaymentForm, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Payments_PaymentForm)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PaymentsGetPaymentReceipt invokes payments.getPaymentReceipt
+func (c *Client) PaymentsGetPaymentReceipt(ctx context.Context, request *TL_payments_getPaymentReceipt) (Payments_PaymentReceipt, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Payments_PaymentReceipt)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PaymentsValidateRequestedInfo invokes payments.validateRequestedInfo
+func (c *Client) PaymentsValidateRequestedInfo(ctx context.Context, request *TL_payments_validateRequestedInfo) (Payments_ValidatedRequestedInfo, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Payments_ValidatedRequestedInfo)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PaymentsSendPaymentForm invokes payments.sendPaymentForm
+func (c *Client) PaymentsSendPaymentForm(ctx context.Context, request *TL_payments_sendPaymentForm) (Payments_PaymentResult, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Payments_PaymentResult)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PaymentsGetSavedInfo invokes payments.getSavedInfo
+func (c *Client) PaymentsGetSavedInfo(ctx context.Context, request *TL_payments_getSavedInfo) (Payments_SavedInfo, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Payments_SavedInfo)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PaymentsClearSavedInfo invokes payments.clearSavedInfo
+func (c *Client) PaymentsClearSavedInfo(ctx context.Context, request *TL_payments_clearSavedInfo) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PhoneGetCallConfig invokes phone.getCallConfig
+func (c *Client) PhoneGetCallConfig(ctx context.Context, request *TL_phone_getCallConfig) (DataJSON, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(DataJSON)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PhoneRequestCall invokes phone.requestCall
+func (c *Client) PhoneRequestCall(ctx context.Context, request *TL_phone_requestCall) (Phone_PhoneCall, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Phone_PhoneCall)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PhoneAcceptCall invokes phone.acceptCall
+func (c *Client) PhoneAcceptCall(ctx context.Context, request *TL_phone_acceptCall) (Phone_PhoneCall, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Phone_PhoneCall)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PhoneConfirmCall invokes phone.confirmCall
+func (c *Client) PhoneConfirmCall(ctx context.Context, request *TL_phone_confirmCall) (Phone_PhoneCall, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Phone_PhoneCall)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PhoneReceivedCall invokes phone.receivedCall
+func (c *Client) PhoneReceivedCall(ctx context.Context, request *TL_phone_receivedCall) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PhoneDiscardCall invokes phone.discardCall
+func (c *Client) PhoneDiscardCall(ctx context.Context, request *TL_phone_discardCall) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PhoneSetCallRating invokes phone.setCallRating
+func (c *Client) PhoneSetCallRating(ctx context.Context, request *TL_phone_setCallRating) (Updates, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Updates)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+// PhoneSaveCallDebug invokes phone.saveCallDebug
+func (c *Client) PhoneSaveCallDebug(ctx context.Context, request *TL_phone_saveCallDebug) (Bool, error) {
+	tl, err := c.m.InvokeContext(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := (*tl).(Bool)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+	return r, nil
+}
+
+func (m *DecodeBuf) objectAccountDaysTTL() AccountDaysTTL {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(AccountDaysTTL)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of AccountDaysTTL", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectAccount_PasswordInputSettings() Account_PasswordInputSettings {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Account_PasswordInputSettings)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Account_PasswordInputSettings", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectAuth_CodeType() Auth_CodeType {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Auth_CodeType)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Auth_CodeType", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectAuth_SentCodeType() Auth_SentCodeType {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Auth_SentCodeType)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Auth_SentCodeType", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectBool() Bool {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Bool)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Bool", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectBotInfo() BotInfo {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(BotInfo)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of BotInfo", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectBotInlineMessage() BotInlineMessage {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(BotInlineMessage)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of BotInlineMessage", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectChannelMessagesFilter() ChannelMessagesFilter {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(ChannelMessagesFilter)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of ChannelMessagesFilter", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectChannelParticipant() ChannelParticipant {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(ChannelParticipant)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of ChannelParticipant", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectChannelParticipantRole() ChannelParticipantRole {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(ChannelParticipantRole)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of ChannelParticipantRole", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectChannelParticipantsFilter() ChannelParticipantsFilter {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(ChannelParticipantsFilter)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of ChannelParticipantsFilter", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectChat() Chat {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Chat)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Chat", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectChatFull() ChatFull {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(ChatFull)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of ChatFull", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectChatParticipant() ChatParticipant {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(ChatParticipant)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of ChatParticipant", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectChatParticipants() ChatParticipants {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(ChatParticipants)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of ChatParticipants", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectChatPhoto() ChatPhoto {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(ChatPhoto)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of ChatPhoto", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectContactLink() ContactLink {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(ContactLink)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of ContactLink", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectContacts_Link() Contacts_Link {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Contacts_Link)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Contacts_Link", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectDataJSON() DataJSON {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(DataJSON)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of DataJSON", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectDocument() Document {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Document)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Document", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectDraftMessage() DraftMessage {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(DraftMessage)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of DraftMessage", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectEncryptedChat() EncryptedChat {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(EncryptedChat)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of EncryptedChat", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectEncryptedFile() EncryptedFile {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(EncryptedFile)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of EncryptedFile", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectEncryptedMessage() EncryptedMessage {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(EncryptedMessage)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of EncryptedMessage", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectExportedChatInvite() ExportedChatInvite {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(ExportedChatInvite)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of ExportedChatInvite", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectFileLocation() FileLocation {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(FileLocation)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of FileLocation", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectGame() Game {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Game)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Game", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectGeoPoint() GeoPoint {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(GeoPoint)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of GeoPoint", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInlineBotSwitchPM() InlineBotSwitchPM {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InlineBotSwitchPM)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InlineBotSwitchPM", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputBotInlineMessage() InputBotInlineMessage {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputBotInlineMessage)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputBotInlineMessage", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputBotInlineMessageID() InputBotInlineMessageID {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputBotInlineMessageID)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputBotInlineMessageID", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputChannel() InputChannel {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputChannel)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputChannel", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputChatPhoto() InputChatPhoto {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputChatPhoto)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputChatPhoto", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputDocument() InputDocument {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputDocument)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputDocument", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputEncryptedChat() InputEncryptedChat {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputEncryptedChat)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputEncryptedChat", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputEncryptedFile() InputEncryptedFile {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputEncryptedFile)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputEncryptedFile", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputFile() InputFile {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputFile)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputFile", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputFileLocation() InputFileLocation {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputFileLocation)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputFileLocation", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputGame() InputGame {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputGame)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputGame", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputGeoPoint() InputGeoPoint {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputGeoPoint)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputGeoPoint", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputMedia() InputMedia {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputMedia)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputMedia", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputNotifyPeer() InputNotifyPeer {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputNotifyPeer)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputNotifyPeer", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputPaymentCredentials() InputPaymentCredentials {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputPaymentCredentials)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputPaymentCredentials", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputPeer() InputPeer {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputPeer)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputPeer", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputPeerNotifySettings() InputPeerNotifySettings {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputPeerNotifySettings)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputPeerNotifySettings", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputPhoneCall() InputPhoneCall {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputPhoneCall)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputPhoneCall", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputPhoto() InputPhoto {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputPhoto)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputPhoto", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputPrivacyKey() InputPrivacyKey {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputPrivacyKey)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputPrivacyKey", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputStickerSet() InputStickerSet {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputStickerSet)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputStickerSet", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputStickeredMedia() InputStickeredMedia {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputStickeredMedia)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputStickeredMedia", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputUser() InputUser {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputUser)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputUser", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputWebDocument() InputWebDocument {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputWebDocument)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputWebDocument", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInputWebFileLocation() InputWebFileLocation {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(InputWebFileLocation)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of InputWebFileLocation", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectInvoice() Invoice {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Invoice)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Invoice", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectMaskCoords() MaskCoords {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(MaskCoords)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of MaskCoords", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectMessage() Message {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Message)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Message", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectMessageAction() MessageAction {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(MessageAction)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of MessageAction", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectMessageFwdHeader() MessageFwdHeader {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(MessageFwdHeader)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of MessageFwdHeader", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectMessageMedia() MessageMedia {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(MessageMedia)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of MessageMedia", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectMessagesFilter() MessagesFilter {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(MessagesFilter)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of MessagesFilter", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectMessages_StickerSet() Messages_StickerSet {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Messages_StickerSet)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Messages_StickerSet", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectNotifyPeer() NotifyPeer {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(NotifyPeer)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of NotifyPeer", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPage() Page {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Page)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Page", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPageBlock() PageBlock {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(PageBlock)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of PageBlock", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPaymentCharge() PaymentCharge {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(PaymentCharge)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of PaymentCharge", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPaymentRequestedInfo() PaymentRequestedInfo {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(PaymentRequestedInfo)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of PaymentRequestedInfo", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPaymentSavedCredentials() PaymentSavedCredentials {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(PaymentSavedCredentials)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of PaymentSavedCredentials", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPeer() Peer {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Peer)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Peer", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPeerNotifySettings() PeerNotifySettings {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(PeerNotifySettings)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of PeerNotifySettings", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPhoneCall() PhoneCall {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(PhoneCall)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of PhoneCall", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPhoneCallDiscardReason() PhoneCallDiscardReason {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(PhoneCallDiscardReason)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of PhoneCallDiscardReason", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPhoneCallProtocol() PhoneCallProtocol {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(PhoneCallProtocol)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of PhoneCallProtocol", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPhoneConnection() PhoneConnection {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(PhoneConnection)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of PhoneConnection", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPhoto() Photo {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Photo)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Photo", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPhotoSize() PhotoSize {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(PhotoSize)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of PhotoSize", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPostAddress() PostAddress {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(PostAddress)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of PostAddress", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectPrivacyKey() PrivacyKey {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(PrivacyKey)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of PrivacyKey", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectReplyMarkup() ReplyMarkup {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(ReplyMarkup)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of ReplyMarkup", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectReportReason() ReportReason {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(ReportReason)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of ReportReason", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectRichText() RichText {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(RichText)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of RichText", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectSendMessageAction() SendMessageAction {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(SendMessageAction)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of SendMessageAction", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectShippingOption() ShippingOption {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(ShippingOption)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of ShippingOption", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectStickerSet() StickerSet {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(StickerSet)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of StickerSet", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectStorage_FileType() Storage_FileType {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Storage_FileType)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Storage_FileType", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectTopPeerCategory() TopPeerCategory {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(TopPeerCategory)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of TopPeerCategory", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectUpdate() Update {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Update)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Update", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectUpdates() Updates {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Updates)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Updates", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectUpdates_State() Updates_State {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(Updates_State)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of Updates_State", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectUser() User {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(User)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of User", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectUserProfilePhoto() UserProfilePhoto {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(UserProfilePhoto)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of UserProfilePhoto", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectUserStatus() UserStatus {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(UserStatus)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of UserStatus", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectWebDocument() WebDocument {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(WebDocument)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of WebDocument", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) objectWebPage() WebPage {
+	x := m.Object()
+	if m.err != nil {
+		return nil
+	}
+	r, ok := x.(WebPage)
+	if !ok {
+		m.err = fmt.Errorf("Got %T instead of WebPage", x)
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorAuthorization() []Authorization {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]Authorization, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(Authorization); !ok {
+			m.err = fmt.Errorf("Got %T instead of Authorization", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorBotCommand() []BotCommand {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]BotCommand, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(BotCommand); !ok {
+			m.err = fmt.Errorf("Got %T instead of BotCommand", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorBotInfo() []BotInfo {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]BotInfo, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(BotInfo); !ok {
+			m.err = fmt.Errorf("Got %T instead of BotInfo", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorBotInlineResult() []BotInlineResult {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]BotInlineResult, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(BotInlineResult); !ok {
+			m.err = fmt.Errorf("Got %T instead of BotInlineResult", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorChannelParticipant() []ChannelParticipant {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]ChannelParticipant, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(ChannelParticipant); !ok {
+			m.err = fmt.Errorf("Got %T instead of ChannelParticipant", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorChat() []Chat {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]Chat, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(Chat); !ok {
+			m.err = fmt.Errorf("Got %T instead of Chat", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorChatParticipant() []ChatParticipant {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]ChatParticipant, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(ChatParticipant); !ok {
+			m.err = fmt.Errorf("Got %T instead of ChatParticipant", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorContact() []Contact {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]Contact, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(Contact); !ok {
+			m.err = fmt.Errorf("Got %T instead of Contact", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorContactBlocked() []ContactBlocked {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]ContactBlocked, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(ContactBlocked); !ok {
+			m.err = fmt.Errorf("Got %T instead of ContactBlocked", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorContactStatus() []ContactStatus {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]ContactStatus, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(ContactStatus); !ok {
+			m.err = fmt.Errorf("Got %T instead of ContactStatus", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorDcOption() []DcOption {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]DcOption, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(DcOption); !ok {
+			m.err = fmt.Errorf("Got %T instead of DcOption", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorDialog() []Dialog {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]Dialog, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(Dialog); !ok {
+			m.err = fmt.Errorf("Got %T instead of Dialog", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorDisabledFeature() []DisabledFeature {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]DisabledFeature, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(DisabledFeature); !ok {
+			m.err = fmt.Errorf("Got %T instead of DisabledFeature", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorDocument() []Document {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]Document, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(Document); !ok {
+			m.err = fmt.Errorf("Got %T instead of Document", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorDocumentAttribute() []DocumentAttribute {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]DocumentAttribute, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(DocumentAttribute); !ok {
+			m.err = fmt.Errorf("Got %T instead of DocumentAttribute", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorEncryptedMessage() []EncryptedMessage {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]EncryptedMessage, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(EncryptedMessage); !ok {
+			m.err = fmt.Errorf("Got %T instead of EncryptedMessage", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorFoundGif() []FoundGif {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]FoundGif, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(FoundGif); !ok {
+			m.err = fmt.Errorf("Got %T instead of FoundGif", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorHighScore() []HighScore {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]HighScore, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(HighScore); !ok {
+			m.err = fmt.Errorf("Got %T instead of HighScore", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorImportedContact() []ImportedContact {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]ImportedContact, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(ImportedContact); !ok {
+			m.err = fmt.Errorf("Got %T instead of ImportedContact", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorInputAppEvent() []InputAppEvent {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]InputAppEvent, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(InputAppEvent); !ok {
+			m.err = fmt.Errorf("Got %T instead of InputAppEvent", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorInputBotInlineResult() []InputBotInlineResult {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]InputBotInlineResult, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(InputBotInlineResult); !ok {
+			m.err = fmt.Errorf("Got %T instead of InputBotInlineResult", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorInputChannel() []InputChannel {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]InputChannel, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(InputChannel); !ok {
+			m.err = fmt.Errorf("Got %T instead of InputChannel", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorInputContact() []InputContact {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]InputContact, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(InputContact); !ok {
+			m.err = fmt.Errorf("Got %T instead of InputContact", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorInputDocument() []InputDocument {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]InputDocument, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(InputDocument); !ok {
+			m.err = fmt.Errorf("Got %T instead of InputDocument", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorInputPeer() []InputPeer {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]InputPeer, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(InputPeer); !ok {
+			m.err = fmt.Errorf("Got %T instead of InputPeer", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorInputPhoto() []InputPhoto {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]InputPhoto, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(InputPhoto); !ok {
+			m.err = fmt.Errorf("Got %T instead of InputPhoto", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorInputPrivacyRule() []InputPrivacyRule {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]InputPrivacyRule, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(InputPrivacyRule); !ok {
+			m.err = fmt.Errorf("Got %T instead of InputPrivacyRule", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorInputUser() []InputUser {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]InputUser, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(InputUser); !ok {
+			m.err = fmt.Errorf("Got %T instead of InputUser", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorKeyboardButton() []KeyboardButton {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]KeyboardButton, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(KeyboardButton); !ok {
+			m.err = fmt.Errorf("Got %T instead of KeyboardButton", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorKeyboardButtonRow() []KeyboardButtonRow {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]KeyboardButtonRow, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(KeyboardButtonRow); !ok {
+			m.err = fmt.Errorf("Got %T instead of KeyboardButtonRow", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorLabeledPrice() []LabeledPrice {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]LabeledPrice, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(LabeledPrice); !ok {
+			m.err = fmt.Errorf("Got %T instead of LabeledPrice", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorMessage() []Message {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]Message, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(Message); !ok {
+			m.err = fmt.Errorf("Got %T instead of Message", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorMessageEntity() []MessageEntity {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]MessageEntity, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(MessageEntity); !ok {
+			m.err = fmt.Errorf("Got %T instead of MessageEntity", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorMessageRange() []MessageRange {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]MessageRange, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(MessageRange); !ok {
+			m.err = fmt.Errorf("Got %T instead of MessageRange", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorPageBlock() []PageBlock {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]PageBlock, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(PageBlock); !ok {
+			m.err = fmt.Errorf("Got %T instead of PageBlock", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorPeer() []Peer {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]Peer, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(Peer); !ok {
+			m.err = fmt.Errorf("Got %T instead of Peer", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorPhoneConnection() []PhoneConnection {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]PhoneConnection, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(PhoneConnection); !ok {
+			m.err = fmt.Errorf("Got %T instead of PhoneConnection", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorPhoto() []Photo {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]Photo, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(Photo); !ok {
+			m.err = fmt.Errorf("Got %T instead of Photo", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorPhotoSize() []PhotoSize {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]PhotoSize, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(PhotoSize); !ok {
+			m.err = fmt.Errorf("Got %T instead of PhotoSize", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorPrivacyRule() []PrivacyRule {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]PrivacyRule, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(PrivacyRule); !ok {
+			m.err = fmt.Errorf("Got %T instead of PrivacyRule", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorReceivedNotifyMessage() []ReceivedNotifyMessage {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]ReceivedNotifyMessage, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(ReceivedNotifyMessage); !ok {
+			m.err = fmt.Errorf("Got %T instead of ReceivedNotifyMessage", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorRichText() []RichText {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]RichText, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(RichText); !ok {
+			m.err = fmt.Errorf("Got %T instead of RichText", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorShippingOption() []ShippingOption {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]ShippingOption, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(ShippingOption); !ok {
+			m.err = fmt.Errorf("Got %T instead of ShippingOption", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorStickerPack() []StickerPack {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]StickerPack, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(StickerPack); !ok {
+			m.err = fmt.Errorf("Got %T instead of StickerPack", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorStickerSet() []StickerSet {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]StickerSet, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(StickerSet); !ok {
+			m.err = fmt.Errorf("Got %T instead of StickerSet", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorStickerSetCovered() []StickerSetCovered {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]StickerSetCovered, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(StickerSetCovered); !ok {
+			m.err = fmt.Errorf("Got %T instead of StickerSetCovered", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorTopPeer() []TopPeer {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]TopPeer, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(TopPeer); !ok {
+			m.err = fmt.Errorf("Got %T instead of TopPeer", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorTopPeerCategoryPeers() []TopPeerCategoryPeers {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]TopPeerCategoryPeers, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(TopPeerCategoryPeers); !ok {
+			m.err = fmt.Errorf("Got %T instead of TopPeerCategoryPeers", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorUpdate() []Update {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]Update, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(Update); !ok {
+			m.err = fmt.Errorf("Got %T instead of Update", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorUser() []User {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]User, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(User); !ok {
+			m.err = fmt.Errorf("Got %T instead of User", x)
+			return nil
+		}
+	}
+	return r
+}
+
+func (m *DecodeBuf) vectorWallPaper() []WallPaper {
+	v := m.Vector()
+	if m.err != nil {
+		return nil
+	}
+	r := make([]WallPaper, len(v))
+	for i, x := range v {
+		var ok bool
+		if r[i], ok = x.(WallPaper); !ok {
+			m.err = fmt.Errorf("Got %T instead of WallPaper", x)
+			return nil
+		}
+	}
+	return r
+}
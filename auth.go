@@ -53,6 +53,63 @@ func (m *MTProto) AuthSignIn(phoneNumber, phoneCode, phoneCodeHash string) (*TL_
 	return &auth, nil
 }
 
+// AuthImportBotAuthorization signs in as bot with token issued by @BotFather.
+// Auth key is kept in session storage, so bot stays authorized after restart.
+func (m *MTProto) AuthImportBotAuthorization(token string) (*TL_user, error) {
+	if token == "" {
+		return nil, errors.New("MTProto::AuthImportBotAuthorization token is empty")
+	}
+
+	tl, err := m.InvokeSync(TL_auth_importBotAuthorization{
+		Api_id:         m.id,
+		Api_hash:       m.hash,
+		Bot_auth_token: token,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	auth, ok := (*tl).(TL_auth_authorization)
+	if !ok {
+		return nil, fmt.Errorf("RPC: %#v", *tl)
+	}
+
+	user, ok := auth.User.(TL_user)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", auth.User)
+	}
+
+	return &user, nil
+}
+
+// AuthCheckPassword completes sign in of account with two-step verification,
+// it's needed when AuthSignIn fails with ErrSessionPasswordNeeded
+func (m *MTProto) AuthCheckPassword(password string) (*TL_auth_authorization, error) {
+	tl, err := m.AccountGetPassword()
+	if err != nil {
+		return nil, err
+	}
+
+	accountPassword, ok := (*tl).(TL_account_password)
+	if !ok {
+		return nil, errors.New("MTProto::AuthCheckPassword account has no password")
+	}
+
+	tl, err = m.InvokeSync(TL_auth_checkPassword{
+		Password_hash: passwordHash(accountPassword.Current_salt, password),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	auth, ok := (*tl).(TL_auth_authorization)
+	if !ok {
+		return nil, fmt.Errorf("RPC: %#v", *tl)
+	}
+
+	return &auth, nil
+}
+
 func (m *MTProto) AuthLogOut() (bool, error) {
 	var result bool
 
@@ -0,0 +1,25 @@
+package mtproto
+
+import "fmt"
+
+// Client invokes API functions and returns their results typed, its methods are generated from schema:
+//
+//	history, err := m.Client().MessagesGetHistory(ctx, &TL_messages_getHistory{Peer: peer, Limit: 100})
+type Client struct {
+	m *MTProto
+}
+
+// Client returns typed API of the connection
+func (m *MTProto) Client() *Client {
+	return &Client{m}
+}
+
+// vectorResult returns decoder of Vector<T> result
+func vectorResult(tl TL) (*DecodeBuf, error) {
+	v, ok := tl.(TL_vector)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", tl)
+	}
+
+	return NewDecodeBuf(v.Data), nil
+}
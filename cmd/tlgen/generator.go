@@ -0,0 +1,594 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// Params renamed in generated code, their new names are used in comments too
+var renamedParams = map[string]string{
+	"id":       "Id",
+	"version":  "Version",
+	"hash":     "Hash",
+	"language": "Language",
+}
+
+// Params which are Go keywords, only field names are changed
+var keywordParams = map[string]string{
+	"type": "Code_type",
+}
+
+// Constructors with encoders written by hand
+var manualEncoders = map[string]bool{
+	"boolFalse": true,
+	"boolTrue":  true,
+	"null":      true,
+}
+
+// kind describes how values of TL type are kept in Go and serialized
+type kind struct {
+	goType string
+	encode string // EncodeBuf method
+	decode string // DecodeBuf method
+
+	bare  string // constructor of bare type, it's serialized without constructor number
+	elem  *kind  // element of vector serialized by generated code
+	boxed bool   // vector starts with crc_vector
+	list  bool   // vector is read by generated helper
+}
+
+// primitive returns kind of value which is written and read by methods with the same name
+func primitive(goType, method string) kind {
+	return kind{goType: goType, encode: method, decode: method}
+}
+
+var kinds = map[string]kind{
+	"#":              primitive("int32", "Int"),
+	"true":           primitive("bool", ""),
+	"int":            primitive("int32", "Int"),
+	"long":           primitive("int64", "Long"),
+	"double":         primitive("float64", "Double"),
+	"string":         primitive("string", "String"),
+	"bytes":          primitive("[]byte", "StringBytes"),
+	"Vector<int>":    primitive("[]int32", "VectorInt"),
+	"Vector<long>":   primitive("[]int64", "VectorLong"),
+	"Vector<string>": primitive("[]string", "VectorString"),
+}
+
+// Values of types without constructors in schema, such as !X, are kept as TL
+var objectKind = kind{goType: "TL", decode: "Object"}
+
+// paramName returns name of param used in schema comments
+func paramName(p Param) string {
+	if name, ok := renamedParams[p.Name]; ok {
+		return name
+	}
+
+	return p.Name
+}
+
+// fieldName returns name of struct field
+func fieldName(p Param) string {
+	if name, ok := keywordParams[p.Name]; ok {
+		return name
+	}
+	name := paramName(p)
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// localName returns name of variable used by decoder
+func localName(p Param) string {
+	return strings.ToLower(fieldName(p))
+}
+
+// typeName returns Go name of combinator without TL_ prefix
+func typeName(c Combinator) string {
+	return strings.Replace(c.Name, ".", "_", -1)
+}
+
+// methodName returns name of Client method invoking function, e.g. messages.getHistory is MessagesGetHistory
+func methodName(c Combinator) string {
+	parts := strings.Split(c.Name, ".")
+	for i, part := range parts {
+		parts[i] = strings.ToUpper(part[:1]) + part[1:]
+	}
+
+	return strings.Join(parts, "")
+}
+
+// interfaceName returns Go name of TL type, e.g. messages.Messages is Messages_Messages
+func interfaceName(tlType string) string {
+	parts := strings.Split(tlType, ".")
+	for i, part := range parts {
+		parts[i] = strings.ToUpper(part[:1]) + part[1:]
+	}
+
+	return strings.Join(parts, "_")
+}
+
+// declaration returns schema line of combinator with renamed params
+func declaration(c Combinator) string {
+	parts := []string{c.Name + "#" + c.CrcText}
+	parts = append(parts, c.Generic...)
+	for _, p := range c.Params {
+		t := p.Type
+		if p.Flag >= 0 {
+			t = fmt.Sprintf("flags.%d?%s", p.Flag, t)
+		}
+		parts = append(parts, paramName(p)+":"+t)
+	}
+
+	return strings.Join(parts, " ") + " = " + c.Result + ";"
+}
+
+// listHelper describes generated method which reads vector of values without constructors
+type listHelper struct {
+	goType string // type of element
+	decode string // expression which reads element
+	boxed  bool
+}
+
+type generator struct {
+	b   *bytes.Buffer
+	err error
+
+	// types maps TL types to their Go interfaces
+	types map[string]string
+	// constructors of schema by name and by type, they are used by bare types
+	constructors map[string]Combinator
+	byType       map[string][]string
+
+	// interfaces used in fields, decoding helpers are generated for them
+	objects map[string]bool
+	vectors map[string]bool
+	// bare constructors and vectors used in fields, decoding helpers are generated for them
+	bares map[string]bool
+	lists map[string]listHelper
+}
+
+// Generate returns formatted Go source with layer constant, interfaces of types, structs, encoders and
+// decoder of combinators and typed Client methods of functions. Non-empty tags is a build constraint
+// of generated file.
+func Generate(pkg, schema, tags string, layer int, combinators []Combinator) ([]byte, error) {
+	g := &generator{
+		b:            new(bytes.Buffer),
+		types:        make(map[string]string),
+		constructors: make(map[string]Combinator),
+		byType:       make(map[string][]string),
+		objects:      make(map[string]bool),
+		vectors:      make(map[string]bool),
+		bares:        make(map[string]bool),
+		lists:        make(map[string]listHelper),
+	}
+	for _, c := range combinators {
+		if !c.Function {
+			g.types[c.Result] = interfaceName(c.Result)
+			g.constructors[c.Name] = c
+			g.byType[c.Result] = append(g.byType[c.Result], c.Name)
+		}
+	}
+
+	fmt.Fprintf(g.b, "// Code generated by tlgen from %s. DO NOT EDIT.\n\n", schema)
+	if tags != "" {
+		fmt.Fprintf(g.b, "//go:build %s\n\n", tags)
+	}
+	fmt.Fprintf(g.b, "package %s\n\nimport (\n\"context\"\n\"fmt\"\n)\n\n", pkg)
+	fmt.Fprintf(g.b, "// Current API Layer Version\nconst layer = %d\n\n", layer)
+
+	declared := make(map[string]bool)
+	for _, c := range combinators {
+		if !c.Function && !declared[c.Result] {
+			g.generateInterface(c.Result)
+			declared[c.Result] = true
+		}
+		g.generateStruct(c)
+		if !manualEncoders[c.Name] {
+			g.generateEncoder(c)
+		}
+	}
+
+	g.generateDecoder(combinators)
+	g.generateClient(combinators)
+	g.generateHelpers()
+	if g.err != nil {
+		return nil, g.err
+	}
+
+	source, err := format.Source(g.b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("can't format generated code: %s", err)
+	}
+
+	return source, nil
+}
+
+// fail remembers the first error found in schema
+func (g *generator) fail(format string, args ...interface{}) {
+	if g.err == nil {
+		g.err = fmt.Errorf(format, args...)
+	}
+}
+
+// kindOf returns Go representation of TL type. Bare types are vector<T>, %T and names of constructors.
+func (g *generator) kindOf(tlType string) kind {
+	if k, ok := kinds[tlType]; ok {
+		return k
+	}
+
+	if strings.HasSuffix(tlType, ">") && (strings.HasPrefix(tlType, "Vector<") || strings.HasPrefix(tlType, "vector<")) {
+		boxed := tlType[0] == 'V'
+		elem := g.kindOf(tlType[len("Vector<") : len(tlType)-1])
+		if boxed && elem.decode == "object"+elem.goType {
+			return kind{goType: "[]" + elem.goType, decode: "vector" + elem.goType, elem: &elem, boxed: true}
+		}
+		if elem == objectKind {
+			if !boxed {
+				g.fail("unknown type of bare vector: %s", tlType)
+			}
+			return primitive("[]TL", "Vector")
+		}
+
+		// vectors of bare and primitive values are read by generated helpers
+		name := "bareVector"
+		if boxed {
+			name = "vector"
+		}
+		switch {
+		case elem.bare != "":
+			name += "_" + strings.TrimPrefix(elem.goType, "TL_")
+		case elem.encode != "":
+			name += elem.decode
+		default:
+			name += elem.goType
+		}
+		return kind{goType: "[]" + elem.goType, decode: name, elem: &elem, boxed: boxed, list: true}
+	}
+
+	if strings.HasPrefix(tlType, "%") {
+		names := g.byType[tlType[1:]]
+		if len(names) != 1 {
+			g.fail("bare type %s must have exactly one constructor", tlType)
+			return objectKind
+		}
+		return g.kindOf(names[0])
+	}
+
+	if name, ok := g.types[tlType]; ok {
+		return kind{goType: name, decode: "object" + name}
+	}
+
+	if c, ok := g.constructors[tlType]; ok {
+		name := typeName(c)
+		return kind{goType: "TL_" + name, decode: "bare_" + name, bare: c.Name}
+	}
+
+	return objectKind
+}
+
+func (g *generator) generateInterface(tlType string) {
+	name := g.types[tlType]
+	fmt.Fprintf(g.b, "// %s is implemented by constructors of %s type\n", name, tlType)
+	fmt.Fprintf(g.b, "type %s interface {\n", name)
+	fmt.Fprintf(g.b, "TL\n")
+	fmt.Fprintf(g.b, "is%s()\n", name)
+	fmt.Fprintf(g.b, "}\n\n")
+}
+
+func (g *generator) generateStruct(c Combinator) {
+	name := typeName(c)
+	fmt.Fprintf(g.b, "// %s\n\n", declaration(c))
+	fmt.Fprintf(g.b, "const crc_%s = 0x%x\n\n", name, c.Crc)
+	fmt.Fprintf(g.b, "type TL_%s struct {\n", name)
+	for _, p := range c.Params {
+		if p.Type == "#" {
+			fmt.Fprintf(g.b, "%s int32\n", fieldName(p))
+			continue
+		}
+		t := p.Type
+		if p.Flag >= 0 {
+			t = fmt.Sprintf("flags.%d?%s", p.Flag, t)
+		}
+		fmt.Fprintf(g.b, "%s %s // %s:%s\n", fieldName(p), g.kindOf(p.Type).goType, paramName(p), t)
+	}
+	fmt.Fprintf(g.b, "}\n\n")
+
+	if !c.Function {
+		fmt.Fprintf(g.b, "func (e TL_%s) is%s() {}\n\n", name, g.types[c.Result])
+	}
+}
+
+func (g *generator) generateEncoder(c Combinator) {
+	name := typeName(c)
+	fmt.Fprintf(g.b, "// Encoding TL_%s\n", name)
+	fmt.Fprintf(g.b, "func (e TL_%s) encode() []byte {\n", name)
+	fmt.Fprintf(g.b, "x := NewEncodeBuf(512)\n")
+	fmt.Fprintf(g.b, "x.UInt(crc_%s)\n", name)
+
+	for _, p := range c.Params {
+		value := "e." + fieldName(p)
+		k := g.kindOf(p.Type)
+
+		if p.Type == "#" {
+			// flags are set by presence of optional params
+			fmt.Fprintf(g.b, "var %s int32\n", localName(p))
+			for _, o := range c.Params {
+				if o.Flag < 0 {
+					continue
+				}
+				fmt.Fprintf(g.b, "if %s {\n", g.presence(o, "e."+fieldName(o)))
+				fmt.Fprintf(g.b, "%s |= (1 << %d)\n", localName(p), o.Flag)
+				fmt.Fprintf(g.b, "}\n")
+			}
+			fmt.Fprintf(g.b, "x.Int(%s)\n", localName(p))
+			continue
+		}
+		if p.Type == "true" {
+			continue
+		}
+
+		line := g.encode(k, value)
+		if p.Flag >= 0 {
+			fmt.Fprintf(g.b, "if flags&(1<<%d) != 0 {\n%s}\n", p.Flag, line)
+		} else {
+			g.b.WriteString(line)
+		}
+	}
+
+	fmt.Fprintf(g.b, "return x.buf\n")
+	fmt.Fprintf(g.b, "}\n\n")
+}
+
+// encode returns code which writes value
+func (g *generator) encode(k kind, value string) string {
+	switch {
+	case k.encode != "":
+		return fmt.Sprintf("x.%s(%s)\n", k.encode, value)
+	case k.bare != "":
+		// the first 4 bytes are constructor number
+		return fmt.Sprintf("x.Bytes(%s.encode()[4:])\n", value)
+	case k.elem != nil:
+		// vectors of interfaces can't be passed as []TL
+		var line string
+		if k.boxed {
+			line = "x.UInt(crc_vector)\n"
+		}
+		return line + fmt.Sprintf("x.Int(int32(len(%s)))\nfor _, v := range %s {\n%s}\n", value, value, g.encode(*k.elem, "v"))
+	}
+
+	return fmt.Sprintf("x.Bytes(%s.encode())\n", value)
+}
+
+// presence returns condition which is true if optional value should be sent
+func (g *generator) presence(p Param, value string) string {
+	k := g.kindOf(p.Type)
+	switch {
+	case k.bare != "":
+		g.fail("optional bare type isn't supported: %s", p.Type)
+	case k == kinds["true"]:
+		return value
+	case k == kinds["int"], k == kinds["long"], k == kinds["double"]:
+		return value + " > 0"
+	case k == kinds["string"]:
+		return value + ` != ""`
+	case strings.HasPrefix(k.goType, "[]"):
+		return fmt.Sprintf("len(%s) != 0", value)
+	}
+
+	// optional objects are nil if they are absent
+	return value + " != nil"
+}
+
+// decode returns expression which reads value of TL type
+func (g *generator) decode(tlType string) string {
+	return g.decodeKind(g.kindOf(tlType))
+}
+
+func (g *generator) decodeKind(k kind) string {
+	switch {
+	case k.bare != "":
+		g.bares[k.bare] = true
+	case k.list:
+		g.lists[k.decode] = listHelper{goType: k.elem.goType, decode: g.decodeKind(*k.elem), boxed: k.boxed}
+	case strings.HasPrefix(k.decode, "object"):
+		g.objects[strings.TrimPrefix(k.decode, "object")] = true
+	case strings.HasPrefix(k.decode, "vector"):
+		g.vectors[strings.TrimPrefix(k.decode, "vector")] = true
+	}
+
+	return fmt.Sprintf("m.%s()", k.decode)
+}
+
+// generateClient writes Client methods which invoke functions and return their results typed
+func (g *generator) generateClient(combinators []Combinator) {
+	for _, c := range combinators {
+		if !c.Function {
+			continue
+		}
+
+		k := g.kindOf(c.Result)
+		fmt.Fprintf(g.b, "\n// %s invokes %s\n", methodName(c), c.Name)
+		fmt.Fprintf(g.b, "func (c *Client) %s(ctx context.Context, request *TL_%s) (%s, error) {\n", methodName(c), typeName(c), k.goType)
+		fmt.Fprintf(g.b, "tl, err := c.m.InvokeContext(ctx, *request)\n")
+		fmt.Fprintf(g.b, "if err != nil {\nreturn nil, err\n}\n")
+
+		switch {
+		case strings.HasPrefix(c.Result, "Vector<"):
+			// vectors have no constructors, they are decoded here
+			fmt.Fprintf(g.b, "d, err := vectorResult(*tl)\n")
+			fmt.Fprintf(g.b, "if err != nil {\nreturn nil, err\n}\n")
+			fmt.Fprintf(g.b, "r := %s\n", strings.Replace(g.decode(c.Result), "m.", "d.", 1))
+			fmt.Fprintf(g.b, "if d.err != nil {\nreturn nil, d.err\n}\n")
+			if _, ok := kinds[c.Result]; !ok {
+				fmt.Fprintf(g.b, "for _, v := range r {\nc.m.entities.remember(v)\n}\n")
+			}
+			fmt.Fprintf(g.b, "return r, nil\n")
+		case k == objectKind:
+			// result type depends on the query, e.g. of invokeWithLayer
+			fmt.Fprintf(g.b, "return *tl, nil\n")
+		default:
+			fmt.Fprintf(g.b, "r, ok := (*tl).(%s)\n", k.goType)
+			fmt.Fprintf(g.b, "if !ok {\nreturn nil, fmt.Errorf(\"Got: %%T\", *tl)\n}\n")
+			fmt.Fprintf(g.b, "return r, nil\n")
+		}
+		fmt.Fprintf(g.b, "}\n")
+	}
+}
+
+func (g *generator) generateDecoder(combinators []Combinator) {
+	fmt.Fprintf(g.b, "func (m *DecodeBuf) ObjectGenerated(constructor uint32) (r TL) {\n")
+	fmt.Fprintf(g.b, "switch constructor {\n")
+
+	for _, c := range combinators {
+		fmt.Fprintf(g.b, "case crc_%s:\n", typeName(c))
+		g.decodeConstructor(c, "r = ")
+	}
+
+	fmt.Fprintf(g.b, "default:\n")
+	fmt.Fprintf(g.b, "m.err = fmt.Errorf(\"Unknown constructor: %%x\", constructor)\n")
+	fmt.Fprintf(g.b, "return nil\n")
+	fmt.Fprintf(g.b, "}\n")
+	fmt.Fprintf(g.b, "return\n")
+	fmt.Fprintf(g.b, "}\n")
+}
+
+// decodeConstructor writes code which reads params of constructor and passes it to assign, e.g. "return "
+func (g *generator) decodeConstructor(c Combinator, assign string) {
+	name := typeName(c)
+	if len(c.Params) == 0 {
+		fmt.Fprintf(g.b, "%sTL_%s{}\n", assign, name)
+		return
+	}
+
+	if !c.HasFlags() {
+		fmt.Fprintf(g.b, "%sTL_%s{\n", assign, name)
+		for _, p := range c.Params {
+			fmt.Fprintf(g.b, "%s: %s,\n", fieldName(p), g.decode(p.Type))
+		}
+		fmt.Fprintf(g.b, "}\n")
+		return
+	}
+
+	// values are read to variables because optional ones depend on flags
+	var flags string
+	for _, p := range c.Params {
+		local := localName(p)
+		switch {
+		case p.Type == "#":
+			flags = local
+			fmt.Fprintf(g.b, "%s := m.Int()\n", local)
+		case p.Type == "true":
+			fmt.Fprintf(g.b, "%s := %s&(1<<%d) != 0\n", local, flags, p.Flag)
+		case p.Flag >= 0:
+			fmt.Fprintf(g.b, "var %s %s\n", local, g.kindOf(p.Type).goType)
+			fmt.Fprintf(g.b, "if %s&(1<<%d) != 0 {\n", flags, p.Flag)
+			fmt.Fprintf(g.b, "%s = %s\n", local, g.decode(p.Type))
+			fmt.Fprintf(g.b, "}\n")
+		default:
+			fmt.Fprintf(g.b, "%s := %s\n", local, g.decode(p.Type))
+		}
+	}
+	fmt.Fprintf(g.b, "%sTL_%s{\n", assign, name)
+	for _, p := range c.Params {
+		fmt.Fprintf(g.b, "%s: %s,\n", fieldName(p), localName(p))
+	}
+	fmt.Fprintf(g.b, "}\n")
+}
+
+// generateHelpers writes methods which read values used in fields: bare constructors, vectors of them
+// and of primitive values, objects and vectors of interfaces
+func (g *generator) generateHelpers() {
+	// helpers may use each other, they are written until nothing new is needed
+	bares := make(map[string]bool)
+	lists := make(map[string]bool)
+	for {
+		var next []string
+		for _, name := range sortedKeys(g.bares) {
+			if !bares[name] {
+				next = append(next, name)
+			}
+		}
+		for _, name := range next {
+			bares[name] = true
+			c := g.constructors[name]
+			fmt.Fprintf(g.b, "\nfunc (m *DecodeBuf) bare_%s() TL_%s {\n", typeName(c), typeName(c))
+			g.decodeConstructor(c, "return ")
+			fmt.Fprintf(g.b, "}\n")
+		}
+
+		names := make(map[string]bool, len(g.lists))
+		for name := range g.lists {
+			if !lists[name] {
+				names[name] = true
+			}
+		}
+		for _, name := range sortedKeys(names) {
+			lists[name] = true
+			g.generateList(name, g.lists[name])
+		}
+
+		if len(next) == 0 && len(names) == 0 {
+			break
+		}
+	}
+
+	for _, name := range sortedKeys(g.objects) {
+		fmt.Fprintf(g.b, "\nfunc (m *DecodeBuf) object%s() %s {\n", name, name)
+		fmt.Fprintf(g.b, "x := m.Object()\n")
+		fmt.Fprintf(g.b, "if m.err != nil {\nreturn nil\n}\n")
+		fmt.Fprintf(g.b, "r, ok := x.(%s)\n", name)
+		fmt.Fprintf(g.b, "if !ok {\n")
+		fmt.Fprintf(g.b, "m.err = fmt.Errorf(\"Got %%T instead of %s\", x)\n", name)
+		fmt.Fprintf(g.b, "}\n")
+		fmt.Fprintf(g.b, "return r\n")
+		fmt.Fprintf(g.b, "}\n")
+	}
+
+	for _, name := range sortedKeys(g.vectors) {
+		fmt.Fprintf(g.b, "\nfunc (m *DecodeBuf) vector%s() []%s {\n", name, name)
+		fmt.Fprintf(g.b, "v := m.Vector()\n")
+		fmt.Fprintf(g.b, "if m.err != nil {\nreturn nil\n}\n")
+		fmt.Fprintf(g.b, "r := make([]%s, len(v))\n", name)
+		fmt.Fprintf(g.b, "for i, x := range v {\n")
+		fmt.Fprintf(g.b, "var ok bool\n")
+		fmt.Fprintf(g.b, "if r[i], ok = x.(%s); !ok {\n", name)
+		fmt.Fprintf(g.b, "m.err = fmt.Errorf(\"Got %%T instead of %s\", x)\n", name)
+		fmt.Fprintf(g.b, "return nil\n")
+		fmt.Fprintf(g.b, "}\n")
+		fmt.Fprintf(g.b, "}\n")
+		fmt.Fprintf(g.b, "return r\n")
+		fmt.Fprintf(g.b, "}\n")
+	}
+}
+
+func (g *generator) generateList(name string, l listHelper) {
+	fmt.Fprintf(g.b, "\nfunc (m *DecodeBuf) %s() []%s {\n", name, l.goType)
+	if l.boxed {
+		fmt.Fprintf(g.b, "if constructor := m.UInt(); m.err == nil && constructor != crc_vector {\n")
+		fmt.Fprintf(g.b, "m.err = fmt.Errorf(\"DecodeVector: Wrong constructor (0x%%08x)\", constructor)\n")
+		fmt.Fprintf(g.b, "}\n")
+	}
+	fmt.Fprintf(g.b, "size := m.Int()\n")
+	fmt.Fprintf(g.b, "if m.err != nil {\nreturn nil\n}\n")
+	fmt.Fprintf(g.b, "if size < 0 {\nm.err = fmt.Errorf(\"DecodeVector: Wrong Size\")\nreturn nil\n}\n")
+	fmt.Fprintf(g.b, "r := make([]%s, size)\n", l.goType)
+	fmt.Fprintf(g.b, "for i := range r {\n")
+	fmt.Fprintf(g.b, "r[i] = %s\n", l.decode)
+	fmt.Fprintf(g.b, "if m.err != nil {\nreturn nil\n}\n")
+	fmt.Fprintf(g.b, "}\n")
+	fmt.Fprintf(g.b, "return r\n")
+	fmt.Fprintf(g.b, "}\n")
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestGenerateAPI checks that checked-in api.go is generated from its schema
+func TestGenerateAPI(t *testing.T) {
+	f, err := os.Open("../../schemes/api-layer-65.tl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	schema, err := ParseSchema(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	combinators, err := fromStart(schema.Combinators, "boolFalse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := Generate("mtproto", "schemes/api-layer-65.tl", "", schema.Layer, combinators)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	api, err := ioutil.ReadFile("../../api.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(source, api) {
+		t.Error("api.go differs from generated one, run: go run ./cmd/tlgen -o api.go schemes/api-layer-65.tl")
+	}
+}
+
+func TestGenerateBareTypes(t *testing.T) {
+	schema, err := ParseSchema(strings.NewReader(`
+future_salt#0949d9dc valid_since:int valid_until:int salt:long = FutureSalt;
+future_salts#ae500895 req_msg_id:long now:int salts:vector<future_salt> = FutureSalts;
+pair#1a2b3c4d salt:%FutureSalt ids:vector<long> ratios:Vector<double> = Pair;
+---functions---
+get_pair#5d6e7f80 = Pair;
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := Generate("mtproto", "test.tl", "", 1, schema.Combinators)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"Salts      []TL_future_salt",
+		"Ratios []float64",
+		"x.UInt(crc_vector)\n\tx.Int(int32(len(e.Ratios)))",
+		"x.Bytes(v.encode()[4:])",
+		"x.Bytes(e.Salt.encode()[4:])",
+		"Salts:      m.bareVector_future_salt(),",
+		"Salt:   m.bare_future_salt(),",
+		"Ratios: m.vectorDouble(),",
+		"func (m *DecodeBuf) bare_future_salt() TL_future_salt {",
+		"func (m *DecodeBuf) bareVector_future_salt() []TL_future_salt {",
+		"func (m *DecodeBuf) bareVectorLong() []int64 {",
+		"func (m *DecodeBuf) vectorDouble() []float64 {",
+	} {
+		if !bytes.Contains(source, []byte(line)) {
+			t.Errorf("no %q in generated code", line)
+		}
+	}
+}
+
+func TestGenerateUnknownBareType(t *testing.T) {
+	schema, err := ParseSchema(strings.NewReader(`
+pair#1a2b3c4d salt:%FutureSalt = Pair;
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Generate("mtproto", "test.tl", "", 1, schema.Combinators); err == nil {
+		t.Error("no error")
+	}
+}
+
+func TestFromStart(t *testing.T) {
+	combinators := []Combinator{{Name: "vector"}, {Name: "boolFalse"}, {Name: "boolTrue"}}
+	got, err := fromStart(combinators, "boolFalse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Name != "boolFalse" {
+		t.Errorf("got %v", got)
+	}
+
+	if _, err := fromStart(combinators, "inputPeerEmpty"); err == nil {
+		t.Error("no error for unknown start combinator")
+	}
+}
+
+func TestGenerateLayerAndTags(t *testing.T) {
+	schema, err := ParseSchema(strings.NewReader(`
+boolFalse#bc799737 = Bool;
+// LAYER 66
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := Generate("mtproto", "test.tl", "layer66", schema.Layer, schema.Combinators)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(source, []byte("DO NOT EDIT.\n\n//go:build layer66\n\npackage mtproto")) {
+		t.Error("no build constraint before package clause of generated code")
+	}
+	if !bytes.Contains(source, []byte("const layer = 66\n")) {
+		t.Error("no layer constant in generated code")
+	}
+}
@@ -0,0 +1,79 @@
+// Command tlgen generates Go structs, encoders and decoder of TL schema combinators.
+//
+//	tlgen -o api.go schemes/api-layer-65.tl
+//
+// Combinators declared before the start one belong to MTProto itself and are written by hand.
+// Layer is taken from "// LAYER N" line of schema unless it's set by -layer.
+// Build constraint of generated file may be set by -tags.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	output := flag.String("o", "api.go", "output file")
+	pkg := flag.String("package", "mtproto", "package of generated code")
+	start := flag.String("start", "boolFalse", "the first generated combinator")
+	layer := flag.Int("layer", 0, "layer of schema, by default it's read from schema")
+	tags := flag.String("tags", "", "build constraint of generated file")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: tlgen [flags] schema.tl")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	if err := run(flag.Arg(0), *output, *pkg, *start, *tags, *layer); err != nil {
+		fmt.Fprintln(os.Stderr, "tlgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(input, output, pkg, start, tags string, layer int) error {
+	f, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	schema, err := ParseSchema(f)
+	if err != nil {
+		return fmt.Errorf("%s: %s", input, err)
+	}
+
+	if layer == 0 {
+		layer = schema.Layer
+	}
+	if layer == 0 {
+		return fmt.Errorf("%s: unknown layer, set it by -layer", input)
+	}
+
+	combinators, err := fromStart(schema.Combinators, start)
+	if err != nil {
+		return fmt.Errorf("%s: %s", input, err)
+	}
+
+	source, err := Generate(pkg, filepath.ToSlash(input), tags, layer, combinators)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(output, source, 0644)
+}
+
+// fromStart returns combinators beginning with the start one
+func fromStart(combinators []Combinator, start string) ([]Combinator, error) {
+	for i, c := range combinators {
+		if c.Name == start {
+			return combinators[i:], nil
+		}
+	}
+
+	return nil, fmt.Errorf("start combinator %s not found", start)
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Param is a single argument of a combinator, e.g. access_hash:flags.0?long
+type Param struct {
+	Name string
+	Type string // type without flag condition, e.g. Vector<InputDocument>
+	Flag int    // bit of flags which marks presence of the param, -1 for required ones
+}
+
+// Combinator is a constructor or a function declared in schema
+type Combinator struct {
+	Name     string // full name including namespace, e.g. messages.sendMedia
+	Crc      uint32
+	CrcText  string
+	Generic  []string // type variables, e.g. {X:Type}
+	Params   []Param
+	Result   string
+	Function bool
+}
+
+// Schema is the list of combinators in order of declaration
+type Schema struct {
+	Layer       int
+	Combinators []Combinator
+}
+
+// builtin combinators which are implemented by hand
+var builtin = map[string]bool{
+	"vector": true,
+}
+
+// ParseSchema reads TL schema: constructors go before ---functions--- line, functions after it.
+// Declarations may span several lines, comments are skipped.
+func ParseSchema(r io.Reader) (*Schema, error) {
+	schema := new(Schema)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	function := false
+	var declaration string
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+
+		if strings.HasPrefix(text, "//") {
+			// the last line of schema usually tells its layer
+			if fields := strings.Fields(strings.TrimPrefix(text, "//")); len(fields) == 2 && fields[0] == "LAYER" {
+				layer, err := strconv.Atoi(fields[1])
+				if err != nil {
+					return nil, fmt.Errorf("line %d: wrong layer: %s", line, fields[1])
+				}
+				schema.Layer = layer
+			}
+			continue
+		}
+		if i := strings.Index(text, "//"); i >= 0 {
+			text = strings.TrimSpace(text[:i])
+		}
+
+		switch text {
+		case "":
+			continue
+		case "---functions---":
+			function = true
+			continue
+		case "---types---":
+			function = false
+			continue
+		}
+
+		declaration = strings.TrimSpace(declaration + " " + text)
+		if !strings.HasSuffix(declaration, ";") {
+			continue
+		}
+
+		combinator, err := parseCombinator(strings.TrimSuffix(declaration, ";"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line, err)
+		}
+		declaration = ""
+
+		if builtin[combinator.Name] {
+			continue
+		}
+		combinator.Function = function
+		schema.Combinators = append(schema.Combinators, *combinator)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if declaration != "" {
+		return nil, fmt.Errorf("unterminated declaration: %s", declaration)
+	}
+
+	return schema, nil
+}
+
+func parseCombinator(declaration string) (*Combinator, error) {
+	eq := strings.LastIndex(declaration, "=")
+	if eq < 0 {
+		return nil, fmt.Errorf("no result type: %s", declaration)
+	}
+
+	fields := strings.Fields(declaration[:eq])
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no name: %s", declaration)
+	}
+
+	c := &Combinator{
+		Result: strings.TrimSpace(declaration[eq+1:]),
+	}
+
+	hash := strings.Index(fields[0], "#")
+	if hash < 0 {
+		return nil, fmt.Errorf("no constructor number: %s", declaration)
+	}
+	c.Name = fields[0][:hash]
+	c.CrcText = fields[0][hash+1:]
+	crc, err := strconv.ParseUint(c.CrcText, 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("wrong constructor number: %s", fields[0])
+	}
+	c.Crc = uint32(crc)
+	if builtin[c.Name] {
+		return c, nil
+	}
+
+	for _, field := range fields[1:] {
+		if strings.HasPrefix(field, "{") {
+			c.Generic = append(c.Generic, field)
+			continue
+		}
+
+		colon := strings.Index(field, ":")
+		if colon < 0 {
+			return nil, fmt.Errorf("wrong param %q: %s", field, declaration)
+		}
+		param := Param{
+			Name: field[:colon],
+			Type: field[colon+1:],
+			Flag: -1,
+		}
+
+		if strings.HasPrefix(param.Type, "flags.") {
+			question := strings.Index(param.Type, "?")
+			if question < 0 {
+				return nil, fmt.Errorf("wrong param %q: %s", field, declaration)
+			}
+			bit, err := strconv.Atoi(param.Type[len("flags."):question])
+			if err != nil {
+				return nil, fmt.Errorf("wrong param %q: %s", field, declaration)
+			}
+			param.Flag = bit
+			param.Type = param.Type[question+1:]
+		}
+
+		c.Params = append(c.Params, param)
+	}
+
+	return c, nil
+}
+
+// HasFlags reports if combinator has flags:# param
+func (c *Combinator) HasFlags() bool {
+	for _, p := range c.Params {
+		if p.Type == "#" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCombinator(t *testing.T) {
+	tests := []struct {
+		declaration string
+		want        Combinator
+	}{
+		{
+			"boolFalse#bc799737 = Bool",
+			Combinator{Name: "boolFalse", Crc: 0xbc799737, CrcText: "bc799737", Result: "Bool"},
+		},
+		{
+			"inputPeerUser#7b8e7de6 user_id:int access_hash:long = InputPeer",
+			Combinator{Name: "inputPeerUser", Crc: 0x7b8e7de6, CrcText: "7b8e7de6", Result: "InputPeer", Params: []Param{
+				{Name: "user_id", Type: "int", Flag: -1},
+				{Name: "access_hash", Type: "long", Flag: -1},
+			}},
+		},
+		{
+			"channelFull#c3d5512f flags:# can_view_participants:flags.3?true id:int participants_count:flags.0?int = ChatFull",
+			Combinator{Name: "channelFull", Crc: 0xc3d5512f, CrcText: "c3d5512f", Result: "ChatFull", Params: []Param{
+				{Name: "flags", Type: "#", Flag: -1},
+				{Name: "can_view_participants", Type: "true", Flag: 3},
+				{Name: "id", Type: "int", Flag: -1},
+				{Name: "participants_count", Type: "int", Flag: 0},
+			}},
+		},
+		{
+			"messages.getMessages#4222fa74 id:Vector<int> = messages.Messages",
+			Combinator{Name: "messages.getMessages", Crc: 0x4222fa74, CrcText: "4222fa74", Result: "messages.Messages", Params: []Param{
+				{Name: "id", Type: "Vector<int>", Flag: -1},
+			}},
+		},
+		{
+			"messages.sendMessage#fa88427a flags:# entities:flags.3?Vector<MessageEntity> = Updates",
+			Combinator{Name: "messages.sendMessage", Crc: 0xfa88427a, CrcText: "fa88427a", Result: "Updates", Params: []Param{
+				{Name: "flags", Type: "#", Flag: -1},
+				{Name: "entities", Type: "Vector<MessageEntity>", Flag: 3},
+			}},
+		},
+		{
+			"invokeWithLayer#da9b0d0d {X:Type} layer:int query:!X = X",
+			Combinator{Name: "invokeWithLayer", Crc: 0xda9b0d0d, CrcText: "da9b0d0d", Result: "X", Generic: []string{"{X:Type}"}, Params: []Param{
+				{Name: "layer", Type: "int", Flag: -1},
+				{Name: "query", Type: "!X", Flag: -1},
+			}},
+		},
+		{
+			"vector#1cb5c415 {t:Type} # [ t ] = Vector t",
+			Combinator{Name: "vector", Crc: 0x1cb5c415, CrcText: "1cb5c415", Result: "Vector t"},
+		},
+	}
+
+	for _, test := range tests {
+		c, err := parseCombinator(test.declaration)
+		if err != nil {
+			t.Errorf("%s: %s", test.declaration, err)
+			continue
+		}
+		if !reflect.DeepEqual(*c, test.want) {
+			t.Errorf("%s:\ngot  %+v\nwant %+v", test.declaration, *c, test.want)
+		}
+	}
+}
+
+func TestParseCombinatorErrors(t *testing.T) {
+	for _, declaration := range []string{
+		"boolFalse#bc799737",
+		"boolFalse = Bool",
+		"boolFalse#xyz = Bool",
+		"inputPeerUser#7b8e7de6 user_id = InputPeer",
+		"channelFull#c3d5512f flags:# id:flags.a?int = ChatFull",
+	} {
+		if _, err := parseCombinator(declaration); err == nil {
+			t.Errorf("%s: no error", declaration)
+		}
+	}
+}
@@ -78,7 +78,7 @@ func (m *DecodeBuf) Bytes(size int) []byte {
 	if m.err != nil {
 		return nil
 	}
-	if m.off+size > m.size {
+	if size < 0 || m.off+size > m.size {
 		m.err = errors.New("DecodeBytes")
 		return nil
 	}
@@ -323,7 +323,13 @@ func (m *DecodeBuf) Object() (r TL) {
 		size := m.Int()
 		arr := make([]TL_MT_message, size)
 		for i := int32(0); i < size; i++ {
-			arr[i] = TL_MT_message{m.Long(), m.Int(), m.Int(), m.Object()}
+			msgId, seqNo, length := m.Long(), m.Int(), m.Int()
+			// message is decoded within its length, see crc_vector below
+			body := NewDecodeBuf(m.Bytes(int(length)))
+			arr[i] = TL_MT_message{msgId, seqNo, length, body.Object()}
+			if m.err == nil {
+				m.err = body.err
+			}
 			if m.err != nil {
 				return nil
 			}
@@ -333,9 +339,23 @@ func (m *DecodeBuf) Object() (r TL) {
 	case crc_rpc_result:
 		r = TL_rpc_result{m.Long(), m.Object()}
 
+	case crc_vector:
+		// only results of functions are vectors without type, they are the last object of message
+		r = TL_vector{m.buf[m.off-4 : m.size]}
+		m.off = m.size
+
 	case crc_rpc_error:
 		r = TL_rpc_error{m.Int(), m.String()}
 
+	case crc_rpc_answer_unknown:
+		r = TL_rpc_answer_unknown{}
+
+	case crc_rpc_answer_dropped_running:
+		r = TL_rpc_answer_dropped_running{}
+
+	case crc_rpc_answer_dropped:
+		r = TL_rpc_answer_dropped{m.Long(), m.Int(), m.Int()}
+
 	case crc_new_session_created:
 		r = TL_new_session_created{m.Long(), m.Long(), m.Bytes(8)}
 
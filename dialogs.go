@@ -0,0 +1,182 @@
+package mtproto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+const dialogsPageSize = 100
+
+// DialogEntry joins dialog with its peer entity and top message
+type DialogEntry struct {
+	Dialog     TL_dialog
+	Entity     TL      // TL_user, TL_chat, TL_channel or their forbidden/empty variants
+	TopMessage Message // nil if top message isn't returned by server
+}
+
+// DialogIterator walks all dialogs of the account, pinned dialogs go first:
+//
+//	it := m.DialogIter()
+//	for it.Next(ctx) {
+//		dialog := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type DialogIterator struct {
+	m *MTProto
+
+	offsetDate int32
+	offsetId   int32
+	offsetPeer InputPeer
+	seen       map[Peer]bool
+	done       bool
+
+	dialogs []DialogEntry
+	index   int
+	err     error
+}
+
+// DialogIter returns iterator over dialogs
+func (m *MTProto) DialogIter() *DialogIterator {
+	return &DialogIterator{
+		m:          m,
+		offsetPeer: TL_inputPeerEmpty{},
+		seen:       make(map[Peer]bool),
+		index:      -1,
+	}
+}
+
+// Next advances iterator to the next dialog, requesting the next page when current one is over.
+// It returns false when dialogs are over or an error occurred.
+func (it *DialogIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+	for it.index >= len(it.dialogs) {
+		if it.done {
+			return false
+		}
+		if it.err = it.fetch(ctx); it.err != nil {
+			return false
+		}
+		it.index = 0
+	}
+
+	return true
+}
+
+// Value returns current dialog
+func (it *DialogIterator) Value() DialogEntry {
+	if it.index < 0 || it.index >= len(it.dialogs) {
+		return DialogEntry{}
+	}
+
+	return it.dialogs[it.index]
+}
+
+// Err returns error which stopped iteration
+func (it *DialogIterator) Err() error {
+	return it.err
+}
+
+func (it *DialogIterator) fetch(ctx context.Context) error {
+	tl, err := it.m.invokeWaiting(ctx, TL_messages_getDialogs{
+		Offset_date: it.offsetDate,
+		Offset_id:   it.offsetId,
+		Offset_peer: it.offsetPeer,
+		Limit:       dialogsPageSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		dialogs  []Dialog
+		messages []Message
+		chats    []Chat
+		users    []User
+	)
+	switch x := (*tl).(type) {
+	case TL_messages_dialogs:
+		// all dialogs are returned at once
+		dialogs, messages, chats, users = x.Dialogs, x.Messages, x.Chats, x.Users
+		it.done = true
+	case TL_messages_dialogsSlice:
+		dialogs, messages, chats, users = x.Dialogs, x.Messages, x.Chats, x.Users
+	default:
+		return fmt.Errorf("Got: %T", *tl)
+	}
+
+	it.dialogs = it.dialogs[:0]
+	var last *DialogEntry
+	for _, v := range dialogs {
+		dialog, ok := v.(TL_dialog)
+		if !ok {
+			continue
+		}
+		d := DialogEntry{
+			Dialog:     dialog,
+			Entity:     peerEntity(dialog.Peer, users, chats),
+			TopMessage: dialogMessage(dialog, messages),
+		}
+		// offset date is taken from top message, dialogs without it can't be offset
+		if messageDate(d.TopMessage) != 0 {
+			last = &d
+		}
+
+		// pages may overlap when several dialogs have the same date
+		if it.seen[dialog.Peer] {
+			continue
+		}
+		it.seen[dialog.Peer] = true
+		it.dialogs = append(it.dialogs, d)
+	}
+
+	if len(it.dialogs) == 0 {
+		it.done = true
+		return nil
+	}
+	if last == nil {
+		// starting from the top again would return the same page
+		return errors.New("top messages of dialogs are missing")
+	}
+
+	// the next page starts after the last dialog of this one
+	it.offsetId = last.Dialog.Top_message
+	it.offsetDate = messageDate(last.TopMessage)
+	if it.offsetPeer, err = inputPeer(last.Entity); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// dialogMessage finds top message of dialog, ids of channel messages are unique only within the channel
+func dialogMessage(dialog TL_dialog, messages []Message) Message {
+	var channelId int32
+	if channel, ok := dialog.Peer.(TL_peerChannel); ok {
+		channelId = channel.Channel_id
+	}
+
+	for _, message := range messages {
+		if messageId(message) == dialog.Top_message && messageChannelId(message) == channelId {
+			return message
+		}
+	}
+
+	return nil
+}
+
+func messageDate(message TL) int32 {
+	switch x := message.(type) {
+	case TL_message:
+		return x.Date
+	case TL_messageService:
+		return x.Date
+	}
+
+	return 0
+}
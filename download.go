@@ -0,0 +1,169 @@
+package mtproto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+const (
+	downloadChunkSize = 512 * 1024
+	downloadProbeSize = 4 * 1024
+	downloadRetries   = 3
+)
+
+type downloadChunk struct {
+	offset int64
+	data   []byte
+	err    error
+}
+
+// DownloadFile writes file at location to w and returns number of written bytes
+func (m *MTProto) DownloadFile(ctx context.Context, location InputFileLocation, w io.Writer) (int64, error) {
+	return m.DownloadFileFrom(ctx, location, w, 0)
+}
+
+// DownloadFileFrom writes file at location to w starting from offset. Chunks are requested by
+// parallel workers (see WithDownloadWorkers) and written in order. Returned number of written bytes
+// allows to resume interrupted download from offset+written.
+func (m *MTProto) DownloadFileFrom(ctx context.Context, location InputFileLocation, w io.Writer, offset int64) (int64, error) {
+	if offset < 0 {
+		return 0, fmt.Errorf("MTProto::DownloadFile wrong offset: %d", offset)
+	}
+
+	dc, err := m.fileDC(ctx, location)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// requests must be aligned to chunk size, head of the first chunk is skipped
+	start := offset - offset%downloadChunkSize
+	skip := offset - start
+
+	// tokens limits number of chunks being downloaded or waiting to be written
+	tokens := make(chan struct{}, m.downloadWorkers)
+	jobs := make(chan int64)
+	results := make(chan downloadChunk)
+
+	go func() {
+		defer close(jobs)
+		for next := start; ; next += downloadChunkSize {
+			select {
+			case tokens <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- next:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < m.downloadWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for offset := range jobs {
+				data, err := m.downloadChunk(ctx, dc, location, offset)
+				select {
+				case results <- downloadChunk{offset: offset, data: data, err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	var written int64
+	finish := func(err error) (int64, error) {
+		cancel()
+		wg.Wait()
+		return written, err
+	}
+
+	chunks := make(map[int64][]byte)
+	next := start
+	for {
+		var chunk downloadChunk
+		select {
+		case chunk = <-results:
+		case <-ctx.Done():
+			return finish(ctx.Err())
+		}
+		if chunk.err != nil {
+			return finish(chunk.err)
+		}
+		chunks[chunk.offset] = chunk.data
+
+		for data, ok := chunks[next]; ok; data, ok = chunks[next] {
+			delete(chunks, next)
+			<-tokens
+
+			if int64(len(data)) > skip {
+				n, err := w.Write(data[skip:])
+				written += int64(n)
+				if err != nil {
+					return finish(err)
+				}
+			}
+			skip = 0
+
+			// the last chunk is shorter than requested
+			if len(data) < downloadChunkSize {
+				return finish(nil)
+			}
+			next += downloadChunkSize
+		}
+	}
+}
+
+// fileDC returns data center where file at location is stored
+func (m *MTProto) fileDC(ctx context.Context, location InputFileLocation) (int32, error) {
+	_, err := m.invoke(ctx, TL_upload_getFile{
+		Location: location,
+		Offset:   0,
+		Limit:    downloadProbeSize,
+	})
+
+	var rpcError *RPCError
+	if errors.As(err, &rpcError) && rpcError.Is(ErrFileMigrate) {
+		return rpcError.Argument, nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return 0, ctxErr
+	}
+
+	// other errors are reported by workers
+	return m.currentDC(), nil
+}
+
+// downloadChunk requests one chunk from data center dc, failed attempts are repeated
+func (m *MTProto) downloadChunk(ctx context.Context, dc int32, location InputFileLocation, offset int64) ([]byte, error) {
+	var data []byte
+	err := retry(ctx, downloadRetries, func() error {
+		tl, err := m.InvokeOnDC(ctx, dc, TL_upload_getFile{
+			Location: location,
+			Offset:   int32(offset),
+			Limit:    downloadChunkSize,
+		})
+		if err != nil {
+			return err
+		}
+		file, ok := (*tl).(TL_upload_file)
+		if !ok {
+			return fmt.Errorf("Got: %T", *tl)
+		}
+		data = file.Bytes
+		return nil
+	})
+
+	return data, err
+}
@@ -125,17 +125,21 @@ func (e *EncodeBuf) Vector(v []TL) {
 }
 
 // TODO: Does only server send messages below?
-func (e TL_msg_container) encode() []byte         { return nil }
-func (e TL_resPQ) encode() []byte                 { return nil }
-func (e TL_server_DH_params_ok) encode() []byte   { return nil }
-func (e TL_server_DH_params_fail) encode() []byte { return nil }
-func (e TL_server_DH_inner_data) encode() []byte  { return nil }
-func (e TL_dh_gen_ok) encode() []byte             { return nil }
-func (e TL_rpc_result) encode() []byte            { return nil }
-func (e TL_rpc_error) encode() []byte             { return nil }
-func (e TL_new_session_created) encode() []byte   { return nil }
-func (e TL_bad_server_salt) encode() []byte       { return nil }
-func (e TL_bad_msg_notification) encode() []byte  { return nil }
+func (e TL_msg_container) encode() []byte              { return nil }
+func (e TL_vector) encode() []byte                     { return nil }
+func (e TL_resPQ) encode() []byte                      { return nil }
+func (e TL_server_DH_params_ok) encode() []byte        { return nil }
+func (e TL_server_DH_params_fail) encode() []byte      { return nil }
+func (e TL_server_DH_inner_data) encode() []byte       { return nil }
+func (e TL_dh_gen_ok) encode() []byte                  { return nil }
+func (e TL_rpc_result) encode() []byte                 { return nil }
+func (e TL_rpc_error) encode() []byte                  { return nil }
+func (e TL_new_session_created) encode() []byte        { return nil }
+func (e TL_bad_server_salt) encode() []byte            { return nil }
+func (e TL_bad_msg_notification) encode() []byte       { return nil }
+func (e TL_rpc_answer_unknown) encode() []byte         { return nil }
+func (e TL_rpc_answer_dropped_running) encode() []byte { return nil }
+func (e TL_rpc_answer_dropped) encode() []byte         { return nil }
 
 func (e TL_req_pq) encode() []byte {
 	x := NewEncodeBuf(20)
@@ -209,6 +213,13 @@ func (e TL_msgs_ack) encode() []byte {
 	return x.buf
 }
 
+func (e TL_rpc_drop_answer) encode() []byte {
+	x := NewEncodeBuf(12)
+	x.UInt(crc_rpc_drop_answer)
+	x.Long(e.Req_msg_id)
+	return x.buf
+}
+
 func (e TL_boolFalse) encode() []byte {
 	x := NewEncodeBuf(4)
 	x.UInt(crc_boolFalse)
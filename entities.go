@@ -0,0 +1,348 @@
+package mtproto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownEntity is returned when entity isn't found in the cache
+var ErrUnknownEntity = errors.New("unknown entity")
+
+// Types of entities
+type EntityType int32
+
+const (
+	EntityUser EntityType = iota + 1
+	EntityChat
+	EntityChannel
+)
+
+// Entity is a user, chat or channel seen in responses and updates
+type Entity struct {
+	Type       EntityType
+	Id         int32
+	AccessHash int64
+	Username   string
+}
+
+// InputPeer returns TL_inputPeerUser, TL_inputPeerChat or TL_inputPeerChannel
+func (e Entity) InputPeer() InputPeer {
+	switch e.Type {
+	case EntityUser:
+		return TL_inputPeerUser{User_id: e.Id, Access_hash: e.AccessHash}
+	case EntityChat:
+		return TL_inputPeerChat{Chat_id: e.Id}
+	case EntityChannel:
+		return TL_inputPeerChannel{Channel_id: e.Id, Access_hash: e.AccessHash}
+	}
+
+	return TL_inputPeerEmpty{}
+}
+
+// Entity storage interface
+type IEntityStorage interface {
+	// LoadEntities returns all saved entities
+	LoadEntities() ([]Entity, error)
+	// SaveEntity adds entity or replaces saved one with the same type and id
+	SaveEntity(entity Entity) error
+}
+
+// MemoryEntityStorage keeps entities in memory, they are lost on exit
+type MemoryEntityStorage struct {
+	mutex    sync.Mutex
+	entities map[entityKey]Entity
+}
+
+func NewMemoryEntityStorage() IEntityStorage {
+	return &MemoryEntityStorage{entities: make(map[entityKey]Entity)}
+}
+
+func (s *MemoryEntityStorage) LoadEntities() ([]Entity, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entities := make([]Entity, 0, len(s.entities))
+	for _, entity := range s.entities {
+		entities = append(entities, entity)
+	}
+
+	return entities, nil
+}
+
+func (s *MemoryEntityStorage) SaveEntity(entity Entity) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.entities[entityKey{entity.Type, entity.Id}] = entity
+
+	return nil
+}
+
+// EntityFileStorage appends entities to a file, the latest record of an entity wins on load
+type EntityFileStorage struct {
+	mutex sync.Mutex
+	path  string
+}
+
+func NewEntityFileStorage(path string) IEntityStorage {
+	return &EntityFileStorage{path: path}
+}
+
+func (s *EntityFileStorage) LoadEntities() ([]Entity, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []Entity
+	decoder := NewDecodeBuf(data)
+	for decoder.off < decoder.size {
+		entity := Entity{
+			Type:       EntityType(decoder.Int()),
+			Id:         decoder.Int(),
+			AccessHash: decoder.Long(),
+			Username:   decoder.String(),
+		}
+		if decoder.err != nil {
+			return nil, decoder.err
+		}
+		entities = append(entities, entity)
+	}
+
+	return entities, nil
+}
+
+func (s *EntityFileStorage) SaveEntity(entity Entity) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	buffer := NewEncodeBuf(20 + len(entity.Username))
+	buffer.Int(int32(entity.Type))
+	buffer.Int(entity.Id)
+	buffer.Long(entity.AccessHash)
+	buffer.String(entity.Username)
+
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(buffer.buf); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+type entityKey struct {
+	Type EntityType
+	Id   int32
+}
+
+// entityCache remembers users, chats and channels found in responses and updates
+type entityCache struct {
+	mutex     sync.RWMutex
+	storage   IEntityStorage
+	entities  map[entityKey]Entity
+	usernames map[string]entityKey
+}
+
+func newEntityCache(storage IEntityStorage) (*entityCache, error) {
+	c := &entityCache{
+		storage:   storage,
+		entities:  make(map[entityKey]Entity),
+		usernames: make(map[string]entityKey),
+	}
+
+	entities, err := storage.LoadEntities()
+	if err != nil {
+		return nil, err
+	}
+	for _, entity := range entities {
+		c.set(entity)
+	}
+
+	return c, nil
+}
+
+// remember walks through tl and saves every user, chat and channel found in it
+func (c *entityCache) remember(tl TL) {
+	if tl == nil {
+		return
+	}
+	c.walk(reflect.ValueOf(tl))
+}
+
+func (c *entityCache) walk(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if !v.IsNil() {
+			c.walk(v.Elem())
+		}
+	case reflect.Slice:
+		// bytes and vectors of numbers can't contain entities
+		if v.Type().Elem().Kind() != reflect.Interface {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			c.walk(v.Index(i))
+		}
+	case reflect.Struct:
+		if !v.CanInterface() {
+			return
+		}
+		if entity, ok := newEntity(v.Interface()); ok {
+			c.add(entity)
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			c.walk(v.Field(i))
+		}
+	}
+}
+
+// add saves entity if it's new or changed
+func (c *entityCache) add(entity Entity) {
+	c.mutex.Lock()
+	old, ok := c.entities[entityKey{entity.Type, entity.Id}]
+	// users may come without access hash, it doesn't mean the known one is wrong
+	if ok && entity.AccessHash == 0 {
+		entity.AccessHash = old.AccessHash
+	}
+	if ok && old == entity {
+		c.mutex.Unlock()
+		return
+	}
+	c.set(entity)
+	c.mutex.Unlock()
+
+	if err := c.storage.SaveEntity(entity); err != nil {
+		log.Println("EntityCache:", err)
+	}
+}
+
+func (c *entityCache) set(entity Entity) {
+	key := entityKey{entity.Type, entity.Id}
+	if old, ok := c.entities[key]; ok && old.Username != "" {
+		delete(c.usernames, normalizeUsername(old.Username))
+	}
+	c.entities[key] = entity
+	if entity.Username != "" {
+		c.usernames[normalizeUsername(entity.Username)] = key
+	}
+}
+
+func (c *entityCache) get(entityType EntityType, id int32) (Entity, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	entity, ok := c.entities[entityKey{entityType, id}]
+
+	return entity, ok
+}
+
+func (c *entityCache) getByUsername(username string) (Entity, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	key, ok := c.usernames[normalizeUsername(username)]
+	if !ok {
+		return Entity{}, false
+	}
+
+	return c.entities[key], true
+}
+
+// newEntity converts user, chat or channel to Entity. Min constructors carry no usable access hash.
+func newEntity(tl interface{}) (Entity, bool) {
+	switch x := tl.(type) {
+	case TL_user:
+		if x.Min {
+			return Entity{}, false
+		}
+		return Entity{Type: EntityUser, Id: x.Id, AccessHash: x.Access_hash, Username: x.Username}, true
+	case TL_chat:
+		return Entity{Type: EntityChat, Id: x.Id}, true
+	case TL_chatForbidden:
+		return Entity{Type: EntityChat, Id: x.Id}, true
+	case TL_channel:
+		if x.Min {
+			return Entity{}, false
+		}
+		return Entity{Type: EntityChannel, Id: x.Id, AccessHash: x.Access_hash, Username: x.Username}, true
+	case TL_channelForbidden:
+		return Entity{Type: EntityChannel, Id: x.Id, AccessHash: x.Access_hash}, true
+	}
+
+	return Entity{}, false
+}
+
+func normalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimPrefix(username, "@"))
+}
+
+// Entity returns cached user, chat or channel
+func (m *MTProto) Entity(entityType EntityType, id int32) (Entity, error) {
+	entity, ok := m.entities.get(entityType, id)
+	if !ok {
+		return Entity{}, ErrUnknownEntity
+	}
+
+	return entity, nil
+}
+
+// InputPeer returns InputPeer for TL_peerUser, TL_peerChat or TL_peerChannel using cached access hashes
+func (m *MTProto) InputPeer(peer Peer) (InputPeer, error) {
+	var entityType EntityType
+	var id int32
+	switch x := peer.(type) {
+	case TL_peerUser:
+		entityType, id = EntityUser, x.User_id
+	case TL_peerChat:
+		entityType, id = EntityChat, x.Chat_id
+	case TL_peerChannel:
+		entityType, id = EntityChannel, x.Channel_id
+	default:
+		return nil, fmt.Errorf("Got: %T", peer)
+	}
+
+	entity, err := m.Entity(entityType, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.InputPeer(), nil
+}
+
+// InputPeerByUsername returns InputPeer of user or channel with username.
+// Unknown usernames are resolved by contacts.resolveUsername.
+func (m *MTProto) InputPeerByUsername(ctx context.Context, username string) (InputPeer, error) {
+	if entity, ok := m.entities.getByUsername(username); ok {
+		return entity.InputPeer(), nil
+	}
+
+	tl, err := m.InvokeContext(ctx, TL_contacts_resolveUsername{Username: normalizeUsername(username)})
+	if err != nil {
+		return nil, err
+	}
+	resolved, ok := (*tl).(TL_contacts_resolvedPeer)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+
+	// users and chats of the response are already cached
+	return m.InputPeer(resolved.Peer)
+}
@@ -0,0 +1,117 @@
+package mtproto
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// API Errors
+const (
+	errorSeeOther     = 303
+	errorBadRequest   = 400
+	errorUnauthorized = 401
+	errorForbidden    = 403
+	errorNotFound     = 404
+	errorFlood        = 420
+	errorInternal     = 500
+)
+
+// RPCError is an error returned by server in rpc_error
+type RPCError struct {
+	Code    int32
+	Message string
+	// Type is a message without numeric argument: FLOOD_WAIT for FLOOD_WAIT_30,
+	// FILE_PART_X_MISSING for FILE_PART_3_MISSING
+	Type string
+	// Argument is a numeric argument of message: 30 for FLOOD_WAIT_30
+	Argument int32
+}
+
+// Error classes, use them with errors.Is
+var (
+	ErrSeeOther     = &RPCError{Code: errorSeeOther, Message: "SEE_OTHER"}
+	ErrBadRequest   = &RPCError{Code: errorBadRequest, Message: "BAD_REQUEST"}
+	ErrUnauthorized = &RPCError{Code: errorUnauthorized, Message: "UNAUTHORIZED"}
+	ErrForbidden    = &RPCError{Code: errorForbidden, Message: "FORBIDDEN"}
+	ErrNotFound     = &RPCError{Code: errorNotFound, Message: "NOT_FOUND"}
+	ErrFlood        = &RPCError{Code: errorFlood, Message: "FLOOD"}
+	ErrInternal     = &RPCError{Code: errorInternal, Message: "INTERNAL"}
+)
+
+// Errors which need special handling, use them with errors.Is
+var (
+	ErrPhoneMigrate   = newRPCErrorType(errorSeeOther, "PHONE_MIGRATE")
+	ErrNetworkMigrate = newRPCErrorType(errorSeeOther, "NETWORK_MIGRATE")
+	ErrUserMigrate    = newRPCErrorType(errorSeeOther, "USER_MIGRATE")
+	ErrFileMigrate    = newRPCErrorType(errorSeeOther, "FILE_MIGRATE")
+	ErrStatsMigrate   = newRPCErrorType(errorSeeOther, "STATS_MIGRATE")
+
+	ErrPhoneNumberInvalid    = newRPCErrorType(errorBadRequest, "PHONE_NUMBER_INVALID")
+	ErrPhoneNumberUnoccupied = newRPCErrorType(errorBadRequest, "PHONE_NUMBER_UNOCCUPIED")
+	ErrPhoneCodeEmpty        = newRPCErrorType(errorBadRequest, "PHONE_CODE_EMPTY")
+	ErrPhoneCodeInvalid      = newRPCErrorType(errorBadRequest, "PHONE_CODE_INVALID")
+	ErrPhoneCodeExpired      = newRPCErrorType(errorBadRequest, "PHONE_CODE_EXPIRED")
+	ErrPasswordHashInvalid   = newRPCErrorType(errorBadRequest, "PASSWORD_HASH_INVALID")
+	ErrPeerIdInvalid         = newRPCErrorType(errorBadRequest, "PEER_ID_INVALID")
+	ErrFilePartMissing       = newRPCErrorType(errorBadRequest, "FILE_PART_X_MISSING")
+
+	ErrAuthKeyUnregistered   = newRPCErrorType(errorUnauthorized, "AUTH_KEY_UNREGISTERED")
+	ErrSessionPasswordNeeded = newRPCErrorType(errorUnauthorized, "SESSION_PASSWORD_NEEDED")
+	ErrSessionRevoked        = newRPCErrorType(errorUnauthorized, "SESSION_REVOKED")
+	ErrUserDeactivated       = newRPCErrorType(errorUnauthorized, "USER_DEACTIVATED")
+
+	ErrFloodWait = newRPCErrorType(errorFlood, "FLOOD_WAIT")
+)
+
+func newRPCErrorType(code int32, errorType string) *RPCError {
+	return &RPCError{Code: code, Message: errorType, Type: errorType}
+}
+
+// newRPCError parses argument of rpc_error message
+func newRPCError(err TL_rpc_error) *RPCError {
+	rpcError := &RPCError{
+		Code:    err.Error_code,
+		Message: err.Error_message,
+		Type:    err.Error_message,
+	}
+
+	// argument is the first numeric word, it's replaced by X unless it ends message
+	words := strings.Split(err.Error_message, "_")
+	for i, word := range words {
+		if i == 0 {
+			continue
+		}
+		argument, e := strconv.ParseInt(word, 10, 32)
+		if e != nil {
+			continue
+		}
+		rpcError.Argument = int32(argument)
+		if i == len(words)-1 {
+			rpcError.Type = strings.Join(words[:i], "_")
+		} else {
+			words[i] = "X"
+			rpcError.Type = strings.Join(words, "_")
+		}
+		break
+	}
+
+	return rpcError
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("RPC error %d: %s", e.Code, e.Message)
+}
+
+// Is reports whether e belongs to class (code) or type of target
+func (e *RPCError) Is(target error) bool {
+	t, ok := target.(*RPCError)
+	if !ok {
+		return false
+	}
+	if t.Code != 0 && t.Code != e.Code {
+		return false
+	}
+
+	return t.Type == "" || t.Type == e.Type
+}
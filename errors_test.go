@@ -0,0 +1,77 @@
+package mtproto
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewRPCError(t *testing.T) {
+	tests := []struct {
+		code      int32
+		message   string
+		errorType string
+		argument  int32
+		is        []error
+		isNot     []error
+	}{
+		{
+			420, "FLOOD_WAIT_30", "FLOOD_WAIT", 30,
+			[]error{ErrFlood, ErrFloodWait},
+			[]error{ErrSeeOther, ErrBadRequest},
+		},
+		{
+			303, "PHONE_MIGRATE_2", "PHONE_MIGRATE", 2,
+			[]error{ErrSeeOther, ErrPhoneMigrate},
+			[]error{ErrUserMigrate, ErrFileMigrate, ErrFlood},
+		},
+		{
+			401, "SESSION_PASSWORD_NEEDED", "SESSION_PASSWORD_NEEDED", 0,
+			[]error{ErrUnauthorized, ErrSessionPasswordNeeded},
+			[]error{ErrAuthKeyUnregistered, ErrBadRequest},
+		},
+		{
+			400, "FILE_PART_3_MISSING", "FILE_PART_X_MISSING", 3,
+			[]error{ErrBadRequest, ErrFilePartMissing},
+			[]error{ErrPeerIdInvalid, ErrSeeOther},
+		},
+	}
+
+	for _, test := range tests {
+		err := newRPCError(TL_rpc_error{Error_code: test.code, Error_message: test.message})
+		if err.Code != test.code || err.Message != test.message || err.Type != test.errorType || err.Argument != test.argument {
+			t.Errorf("%s: got %+v", test.message, *err)
+		}
+		for _, target := range test.is {
+			if !errors.Is(err, target) {
+				t.Errorf("%s isn't %v", test.message, target)
+			}
+		}
+		for _, target := range test.isNot {
+			if errors.Is(err, target) {
+				t.Errorf("%s is %v", test.message, target)
+			}
+		}
+	}
+}
+
+func TestFloodWaitError(t *testing.T) {
+	var err error = &FloodWaitError{
+		Duration: 30 * time.Second,
+		Err:      newRPCError(TL_rpc_error{Error_code: 420, Error_message: "FLOOD_WAIT_30"}),
+	}
+
+	if !errors.Is(err, ErrFlood) || !errors.Is(err, ErrFloodWait) {
+		t.Error("FloodWaitError doesn't match its RPC error")
+	}
+
+	var rpcError *RPCError
+	if !errors.As(err, &rpcError) || rpcError.Argument != 30 {
+		t.Errorf("RPCError isn't found in FloodWaitError: %v", rpcError)
+	}
+
+	var floodWait *FloodWaitError
+	if !errors.As(err, &floodWait) || floodWait.Duration != 30*time.Second {
+		t.Errorf("FloodWaitError isn't found: %v", floodWait)
+	}
+}
@@ -0,0 +1,127 @@
+package mtproto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// FloodWaitError is returned when server asks to wait longer than allowed by WithFloodWait
+type FloodWaitError struct {
+	Duration time.Duration
+	Err      *RPCError
+}
+
+func (e *FloodWaitError) Error() string {
+	return fmt.Sprintf("flood wait: %s", e.Duration)
+}
+
+func (e *FloodWaitError) Unwrap() error {
+	return e.Err
+}
+
+// rateLimiter keeps minimal interval between requests of the same method
+type rateLimiter struct {
+	mutex     sync.Mutex
+	interval  time.Duration
+	intervals map[string]time.Duration
+	next      map[string]time.Time
+}
+
+func newRateLimiter(interval time.Duration, intervals map[string]time.Duration) *rateLimiter {
+	return &rateLimiter{
+		interval:  interval,
+		intervals: intervals,
+		next:      make(map[string]time.Time),
+	}
+}
+
+// wait blocks until request of method can be sent
+func (l *rateLimiter) wait(ctx context.Context, method string) error {
+	l.mutex.Lock()
+	now := time.Now()
+	interval, ok := l.intervals[method]
+	if !ok {
+		interval = l.interval
+	}
+	start := now
+	if next := l.next[method]; next.After(now) {
+		start = next
+	}
+	if interval > 0 || start.After(now) {
+		l.next[method] = start.Add(interval)
+	}
+	l.mutex.Unlock()
+
+	delay := start.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// block delays all requests of method for duration d
+func (l *rateLimiter) block(method string, d time.Duration) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if until := time.Now().Add(d); until.After(l.next[method]) {
+		l.next[method] = until
+	}
+}
+
+func methodName(msg TL) string {
+	return fmt.Sprintf("%T", msg)
+}
+
+// retry calls f until it succeeds, at most attempts times. Bad requests aren't repeated.
+// Delay between attempts grows by a second, FloodWaitError makes it wait as long as server asked.
+func retry(ctx context.Context, attempts int, f func() error) error {
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if err = f(); err == nil || ctx.Err() != nil || errors.Is(err, ErrBadRequest) {
+			return err
+		}
+
+		delay := time.Duration(attempt+1) * time.Second
+		var floodErr *FloodWaitError
+		if errors.As(err, &floodErr) {
+			delay = floodErr.Duration
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return err
+}
+
+// invokeWaiting sends request again after FLOOD_WAIT longer than allowed by WithFloodWait
+func (m *MTProto) invokeWaiting(ctx context.Context, msg TL) (*TL, error) {
+	for {
+		tl, err := m.InvokeContext(ctx, msg)
+
+		var floodErr *FloodWaitError
+		if !errors.As(err, &floodErr) {
+			return tl, err
+		}
+
+		select {
+		case <-time.After(floodErr.Duration):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package mtproto
+
+import (
+	"context"
+	"fmt"
+)
+
+const historyPageSize = 100
+
+// HistoryIterator walks message history of a peer from the newest message to the oldest one:
+//
+//	it := m.HistoryIter(peer)
+//	for it.Next(ctx) {
+//		message := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type HistoryIterator struct {
+	m    *MTProto
+	peer InputPeer
+
+	offsetId int32
+	done     bool
+
+	messages []Message
+	users    []User
+	chats    []Chat
+	index    int
+	err      error
+}
+
+// HistoryIter returns iterator over messages of peer
+func (m *MTProto) HistoryIter(peer InputPeer) *HistoryIterator {
+	return &HistoryIterator{
+		m:     m,
+		peer:  peer,
+		index: -1,
+	}
+}
+
+// Next advances iterator to the next message, requesting the next page when current one is over.
+// It returns false when history is over or an error occurred.
+func (it *HistoryIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+	for it.index >= len(it.messages) {
+		if it.done {
+			return false
+		}
+		if it.err = it.fetch(ctx); it.err != nil {
+			return false
+		}
+		it.index = 0
+	}
+
+	return true
+}
+
+// Value returns current message
+func (it *HistoryIterator) Value() Message {
+	if it.index < 0 || it.index >= len(it.messages) {
+		return nil
+	}
+
+	return it.messages[it.index]
+}
+
+// Users returns users of the page with current message
+func (it *HistoryIterator) Users() []User {
+	return it.users
+}
+
+// Chats returns chats of the page with current message
+func (it *HistoryIterator) Chats() []Chat {
+	return it.chats
+}
+
+// Err returns error which stopped iteration
+func (it *HistoryIterator) Err() error {
+	return it.err
+}
+
+func (it *HistoryIterator) fetch(ctx context.Context) error {
+	tl, err := it.m.invokeWaiting(ctx, TL_messages_getHistory{
+		Peer:      it.peer,
+		Offset_id: it.offsetId,
+		Limit:     historyPageSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch x := (*tl).(type) {
+	case TL_messages_messages:
+		// whole history is returned at once
+		it.messages, it.users, it.chats = x.Messages, x.Users, x.Chats
+		it.done = true
+	case TL_messages_messagesSlice:
+		it.messages, it.users, it.chats = x.Messages, x.Users, x.Chats
+	case TL_messages_channelMessages:
+		it.messages, it.users, it.chats = x.Messages, x.Users, x.Chats
+	default:
+		return fmt.Errorf("Got: %T", *tl)
+	}
+
+	if len(it.messages) == 0 {
+		it.done = true
+		return nil
+	}
+	it.offsetId = messageId(it.messages[len(it.messages)-1])
+
+	return nil
+}
+
+func messageId(message TL) int32 {
+	switch x := message.(type) {
+	case TL_message:
+		return x.Id
+	case TL_messageService:
+		return x.Id
+	case TL_messageEmpty:
+		return x.Id
+	}
+
+	return 0
+}
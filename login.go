@@ -0,0 +1,187 @@
+package mtproto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Authenticator supplies user data requested by Login
+type Authenticator interface {
+	// Phone returns phone number in international format
+	Phone(ctx context.Context) (string, error)
+	// Code returns code sent to the user. Return ErrResendCode to get the code sent again.
+	Code(ctx context.Context, sentCode *TL_auth_sentCode) (string, error)
+	// Password returns two-step verification password
+	Password(ctx context.Context, hint string) (string, error)
+	// SignUp returns name of the new account when phone number isn't registered yet
+	SignUp(ctx context.Context) (firstName, lastName string, err error)
+}
+
+// cancelCodeTimeout limits auth.cancelCode sent when Login is cancelled
+const cancelCodeTimeout = 5 * time.Second
+
+// ErrResendCode is returned by Authenticator.Code to request the code again
+var ErrResendCode = errors.New("resend code")
+
+// Login signs in with phone number, asking auth for code, password and name of the new account when they are needed
+func (m *MTProto) Login(ctx context.Context, auth Authenticator) (*TL_user, error) {
+	phone, err := auth.Phone(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tl, err := m.InvokeContext(ctx, TL_auth_sendCode{
+		Phone_number:   phone,
+		Current_number: TL_boolTrue{},
+		Api_id:         m.id,
+		Api_hash:       m.hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sentCode, ok := (*tl).(TL_auth_sentCode)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", *tl)
+	}
+
+	authorization, err := m.loginWithCode(ctx, auth, phone, &sentCode)
+	if err != nil {
+		if ctx.Err() != nil {
+			// code isn't needed anymore, Login mustn't hang on it if connection is down
+			cancelCtx, cancel := context.WithTimeout(context.Background(), cancelCodeTimeout)
+			_, _ = m.InvokeContext(cancelCtx, TL_auth_cancelCode{
+				Phone_number:    phone,
+				Phone_code_hash: sentCode.Phone_code_hash,
+			})
+			cancel()
+		}
+		return nil, err
+	}
+
+	user, ok := authorization.User.(TL_user)
+	if !ok {
+		return nil, fmt.Errorf("Got: %T", authorization.User)
+	}
+
+	return &user, nil
+}
+
+func (m *MTProto) loginWithCode(ctx context.Context, auth Authenticator, phone string, sentCode *TL_auth_sentCode) (*TL_auth_authorization, error) {
+	for {
+		code, err := auth.Code(ctx, sentCode)
+		if err == ErrResendCode {
+			if err = m.resendCode(ctx, phone, sentCode); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var tl *TL
+		if sentCode.Phone_registered {
+			tl, err = m.InvokeContext(ctx, TL_auth_signIn{
+				Phone_number:    phone,
+				Phone_code_hash: sentCode.Phone_code_hash,
+				Phone_code:      code,
+			})
+		}
+		if !sentCode.Phone_registered || errors.Is(err, ErrPhoneNumberUnoccupied) {
+			tl, err = m.signUp(ctx, auth, phone, sentCode.Phone_code_hash, code)
+		}
+
+		switch {
+		case errors.Is(err, ErrPhoneCodeInvalid), errors.Is(err, ErrPhoneCodeEmpty):
+			// ask for the code again
+			continue
+		case errors.Is(err, ErrPhoneCodeExpired):
+			if err = m.resendCode(ctx, phone, sentCode); err != nil {
+				return nil, err
+			}
+			continue
+		case errors.Is(err, ErrSessionPasswordNeeded):
+			return m.loginWithPassword(ctx, auth)
+		case err != nil:
+			return nil, err
+		}
+
+		authorization, ok := (*tl).(TL_auth_authorization)
+		if !ok {
+			return nil, fmt.Errorf("Got: %T", *tl)
+		}
+
+		return &authorization, nil
+	}
+}
+
+func (m *MTProto) loginWithPassword(ctx context.Context, auth Authenticator) (*TL_auth_authorization, error) {
+	for {
+		tl, err := m.InvokeContext(ctx, TL_account_getPassword{})
+		if err != nil {
+			return nil, err
+		}
+		accountPassword, ok := (*tl).(TL_account_password)
+		if !ok {
+			return nil, fmt.Errorf("Got: %T", *tl)
+		}
+
+		password, err := auth.Password(ctx, accountPassword.Hint)
+		if err != nil {
+			return nil, err
+		}
+
+		tl, err = m.InvokeContext(ctx, TL_auth_checkPassword{
+			Password_hash: passwordHash(accountPassword.Current_salt, password),
+		})
+		if errors.Is(err, ErrPasswordHashInvalid) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		authorization, ok := (*tl).(TL_auth_authorization)
+		if !ok {
+			return nil, fmt.Errorf("Got: %T", *tl)
+		}
+
+		return &authorization, nil
+	}
+}
+
+func (m *MTProto) signUp(ctx context.Context, auth Authenticator, phone, phoneCodeHash, code string) (*TL, error) {
+	firstName, lastName, err := auth.SignUp(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.InvokeContext(ctx, TL_auth_signUp{
+		Phone_number:    phone,
+		Phone_code_hash: phoneCodeHash,
+		Phone_code:      code,
+		First_name:      firstName,
+		Last_name:       lastName,
+	})
+}
+
+// resendCode sends code again by the next method and replaces sentCode with the new one
+func (m *MTProto) resendCode(ctx context.Context, phone string, sentCode *TL_auth_sentCode) error {
+	tl, err := m.InvokeContext(ctx, TL_auth_resendCode{
+		Phone_number:    phone,
+		Phone_code_hash: sentCode.Phone_code_hash,
+	})
+	if err != nil {
+		return err
+	}
+
+	newCode, ok := (*tl).(TL_auth_sentCode)
+	if !ok {
+		return fmt.Errorf("Got: %T", *tl)
+	}
+	*sentCode = newCode
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/aes"
 	"crypto/rsa"
 	sha1lib "crypto/sha1"
+	sha256lib "crypto/sha256"
 	"errors"
 	"math/big"
 	"math/rand"
@@ -29,6 +30,11 @@ func sha1(data []byte) []byte {
 	return r[:]
 }
 
+func sha256(data []byte) []byte {
+	r := sha256lib.Sum256(data)
+	return r[:]
+}
+
 func doRSAencrypt(em []byte) []byte {
 	z := make([]byte, 255)
 	copy(z, em)
@@ -175,6 +181,58 @@ func generateAES(msg_key, auth_key []byte, decode bool) ([]byte, []byte) {
 	return aes_key, aes_iv
 }
 
+// messageKey returns msg_key of MTProto 2.0, plaintext includes padding
+func messageKey(authKey, plaintext []byte, decode bool) []byte {
+	var x int
+	if decode {
+		x = 8
+	}
+
+	data := make([]byte, 0, 32+len(plaintext))
+	data = append(data, authKey[88+x:88+x+32]...)
+	data = append(data, plaintext...)
+
+	return sha256(data)[8:24]
+}
+
+// paddingLen returns random length of MTProto 2.0 padding for plaintext of length bytes,
+// it's 12 to 1024 bytes and makes padded plaintext divisible by 16
+func paddingLen(length int) int {
+	n := 12 + (16-(length+12)%16)%16
+	return n + 16*rand.Intn((1024-n)/16+1)
+}
+
+// generateAES2 returns AES key and IV of MTProto 2.0 message
+func generateAES2(msgKey, authKey []byte, decode bool) ([]byte, []byte) {
+	var x int
+	if decode {
+		x = 8
+	}
+
+	a := make([]byte, 0, 52)
+	a = append(a, msgKey...)
+	a = append(a, authKey[x:x+36]...)
+
+	b := make([]byte, 0, 52)
+	b = append(b, authKey[40+x:40+x+36]...)
+	b = append(b, msgKey...)
+
+	sha256a := sha256(a)
+	sha256b := sha256(b)
+
+	aesKey := make([]byte, 0, 32)
+	aesKey = append(aesKey, sha256a[0:8]...)
+	aesKey = append(aesKey, sha256b[8:8+16]...)
+	aesKey = append(aesKey, sha256a[24:24+8]...)
+
+	aesIV := make([]byte, 0, 32)
+	aesIV = append(aesIV, sha256b[0:8]...)
+	aesIV = append(aesIV, sha256a[8:8+16]...)
+	aesIV = append(aesIV, sha256b[24:24+8]...)
+
+	return aesKey, aesIV
+}
+
 func doAES256IGEencrypt(data, key, iv []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
@@ -0,0 +1,131 @@
+package mtproto
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// testAuthKey returns auth key 00 01 02 ... ff
+func testAuthKey() []byte {
+	authKey := make([]byte, 256)
+	for i := range authKey {
+		authKey[i] = byte(i)
+	}
+	return authKey
+}
+
+func testPlaintext() []byte {
+	plaintext := make([]byte, 48)
+	for i := range plaintext {
+		plaintext[i] = byte(i*7 + 3)
+	}
+	return plaintext
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestMTProto2Keys(t *testing.T) {
+	tests := []struct {
+		decode bool
+		msgKey string
+		aesKey string
+		aesIV  string
+	}{
+		{
+			false,
+			"435622907169557b61564be2e3e3bca5",
+			"f6afa1e590d7de76bc472804ab66f2cabc3d96f60add66f1dd1e3793d181dcc2",
+			"91869c82991d43a51ca68a694fafccd2fe91f785025ff8a79ba7bc84c5af3a51",
+		},
+		{
+			true,
+			"9670dcf1d596c2887bef79e9df0f4bb7",
+			"74d9a1d5667051f9093f1e6e605e230339a45b2e042ee19a8db9e0b799127fa7",
+			"ef68cc3776a72d76f0822ff62ecb59257f6165959925989d91e4af099e699a0b",
+		},
+	}
+
+	authKey := testAuthKey()
+	for _, test := range tests {
+		msgKey := messageKey(authKey, testPlaintext(), test.decode)
+		if !bytes.Equal(msgKey, decodeHex(t, test.msgKey)) {
+			t.Errorf("decode %v: msg_key %x, want %s", test.decode, msgKey, test.msgKey)
+		}
+
+		aesKey, aesIV := generateAES2(msgKey, authKey, test.decode)
+		if !bytes.Equal(aesKey, decodeHex(t, test.aesKey)) {
+			t.Errorf("decode %v: aes key %x, want %s", test.decode, aesKey, test.aesKey)
+		}
+		if !bytes.Equal(aesIV, decodeHex(t, test.aesIV)) {
+			t.Errorf("decode %v: aes iv %x, want %s", test.decode, aesIV, test.aesIV)
+		}
+	}
+}
+
+func TestAES256IGE(t *testing.T) {
+	// test vector of OpenSSL AES IGE implementation
+	key := decodeHex(t, "000102030405060708090a0b0c0d0e0f")
+	iv := decodeHex(t, "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f")
+	plaintext := make([]byte, 32)
+	ciphertext := decodeHex(t, "1a8519a6557be652e9da8e43da4ef4453cf456b4ca488aa383c79c98b34797cb")
+
+	encrypted, err := doAES256IGEencrypt(plaintext, key, iv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(encrypted, ciphertext) {
+		t.Errorf("encrypted %x, want %x", encrypted, ciphertext)
+	}
+
+	decrypted, err := doAES256IGEdecrypt(ciphertext, key, iv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Errorf("decrypted %x, want %x", decrypted, plaintext)
+	}
+}
+
+func TestMTProto2RoundTrip(t *testing.T) {
+	authKey := testAuthKey()
+	plaintext := testPlaintext()
+	plaintext = append(plaintext, GenerateNonce(paddingLen(len(plaintext)))...)
+
+	msgKey := messageKey(authKey, plaintext, false)
+	aesKey, aesIV := generateAES2(msgKey, authKey, false)
+	encrypted, err := doAES256IGEencrypt(plaintext, aesKey, aesIV)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// receiver derives the same key from msg_key of sender
+	aesKey, aesIV = generateAES2(msgKey, authKey, false)
+	decrypted, err := doAES256IGEdecrypt(encrypted, aesKey, aesIV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Error("decrypted message differs from plaintext")
+	}
+	if !bytes.Equal(messageKey(authKey, decrypted, false), msgKey) {
+		t.Error("msg_key of decrypted message differs")
+	}
+}
+
+func TestPaddingLen(t *testing.T) {
+	for length := 0; length < 64; length++ {
+		for i := 0; i < 100; i++ {
+			n := paddingLen(length)
+			if n < 12 || n > 1024 || (length+n)%16 != 0 {
+				t.Fatalf("padding of %d bytes: %d", length, n)
+			}
+		}
+	}
+}
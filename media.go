@@ -0,0 +1,91 @@
+package mtproto
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"mime"
+	"path/filepath"
+)
+
+// Layer 65 has no messages.sendMultiMedia, so albums can't be sent: every file is a separate message.
+
+// MessagesSendMedia sends uploaded or existing media to peer, replyTo is ignored if it's zero
+func (m *MTProto) MessagesSendMedia(ctx context.Context, silent bool, peer InputPeer, replyTo int32, media InputMedia) (*TL, error) {
+	return m.InvokeContext(ctx, TL_messages_sendMedia{
+		Silent:          silent,
+		Peer:            peer,
+		Reply_to_msg_id: replyTo,
+		Media:           media,
+		Random_id:       rand.Int63(),
+	})
+}
+
+// MessagesSendPhoto uploads size bytes read from r and sends them to peer as photo
+func (m *MTProto) MessagesSendPhoto(ctx context.Context, silent bool, peer InputPeer, replyTo int32, r io.Reader, size int64, name, caption string) (*TL, error) {
+	file, err := m.UploadFile(ctx, r, size, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.MessagesSendMedia(ctx, silent, peer, replyTo, TL_inputMediaUploadedPhoto{
+		File:    file,
+		Caption: caption,
+	})
+}
+
+// MessagesSendDocument uploads size bytes read from r and sends them to peer as document.
+// Filename attribute is added to attributes, empty mimeType is guessed by extension of name.
+func (m *MTProto) MessagesSendDocument(ctx context.Context, silent bool, peer InputPeer, replyTo int32, r io.Reader, size int64, name, mimeType, caption string, attributes ...DocumentAttribute) (*TL, error) {
+	file, err := m.UploadFile(ctx, r, size, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if mimeType == "" {
+		mimeType = mimeTypeByName(name)
+	}
+
+	return m.MessagesSendMedia(ctx, silent, peer, replyTo, TL_inputMediaUploadedDocument{
+		File:       file,
+		Mime_type:  mimeType,
+		Attributes: append([]DocumentAttribute{TL_documentAttributeFilename{File_name: name}}, attributes...),
+		Caption:    caption,
+	})
+}
+
+// MessagesSendAudio sends audio file, it's shown as voice message if voice is set
+func (m *MTProto) MessagesSendAudio(ctx context.Context, silent bool, peer InputPeer, replyTo int32, r io.Reader, size int64, name, caption string, duration int32, title, performer string, voice bool) (*TL, error) {
+	return m.MessagesSendDocument(ctx, silent, peer, replyTo, r, size, name, "", caption, TL_documentAttributeAudio{
+		Voice:     voice,
+		Duration:  duration,
+		Title:     title,
+		Performer: performer,
+	})
+}
+
+// MessagesSendVideo sends video file with its duration in seconds and size of the frame
+func (m *MTProto) MessagesSendVideo(ctx context.Context, silent bool, peer InputPeer, replyTo int32, r io.Reader, size int64, name, caption string, duration, w, h int32) (*TL, error) {
+	return m.MessagesSendDocument(ctx, silent, peer, replyTo, r, size, name, "", caption, TL_documentAttributeVideo{
+		Duration: duration,
+		W:        w,
+		H:        h,
+	})
+}
+
+// MessagesSendSticker sends webp image as sticker, alt is emoji associated with it
+func (m *MTProto) MessagesSendSticker(ctx context.Context, silent bool, peer InputPeer, replyTo int32, r io.Reader, size int64, name, alt string) (*TL, error) {
+	return m.MessagesSendDocument(ctx, silent, peer, replyTo, r, size, name, "image/webp", "", TL_documentAttributeSticker{
+		Alt:        alt,
+		Stickerset: TL_inputStickerSetEmpty{},
+	})
+}
+
+func mimeTypeByName(name string) string {
+	// parameters such as charset aren't expected by server
+	if mimeType, _, err := mime.ParseMediaType(mime.TypeByExtension(filepath.Ext(name))); err == nil {
+		return mimeType
+	}
+
+	return "application/octet-stream"
+}
@@ -1,6 +1,6 @@
 package mtproto
 
-func (m *MTProto) MessagesGetHistory(peer TL, offsetId, offsetDate, addOffset, limit, maxId, minId int32) (*TL, error) {
+func (m *MTProto) MessagesGetHistory(peer InputPeer, offsetId, offsetDate, addOffset, limit, maxId, minId int32) (*TL, error) {
 	return m.InvokeSync(TL_messages_getHistory{
 		Peer:        peer,
 		Offset_id:   offsetId,
@@ -12,7 +12,7 @@ func (m *MTProto) MessagesGetHistory(peer TL, offsetId, offsetDate, addOffset, l
 	})
 }
 
-func (m *MTProto) MessagesGetDialogs(excludePinned bool, offsetDate, offsetId int32, offsetPeer TL, limit int32) (*TL, error) {
+func (m *MTProto) MessagesGetDialogs(excludePinned bool, offsetDate, offsetId int32, offsetPeer InputPeer, limit int32) (*TL, error) {
 	return m.InvokeSync(TL_messages_getDialogs{
 		Exclude_pinned: excludePinned,
 		Offset_date:    offsetDate,
@@ -22,7 +22,7 @@ func (m *MTProto) MessagesGetDialogs(excludePinned bool, offsetDate, offsetId in
 	})
 }
 
-func (m *MTProto) MessagesSendMessage(no_webpage, silent, background, clear_draft bool, peer TL, reply_to_msg_id int32, message string, random_id int64, reply_markup TL, entities []TL) (*TL, error) {
+func (m *MTProto) MessagesSendMessage(no_webpage, silent, background, clear_draft bool, peer InputPeer, reply_to_msg_id int32, message string, random_id int64, reply_markup ReplyMarkup, entities []MessageEntity) (*TL, error) {
 	return m.InvokeSync(TL_messages_sendMessage{
 		No_webpage:      no_webpage,
 		Silent:          silent,
@@ -1,12 +1,13 @@
 package mtproto
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"io"
-	"log"
 	"os"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -15,18 +16,57 @@ type MTProto struct {
 	stopRoutines chan struct{}
 	allDone      sync.WaitGroup
 
+	connMutex  sync.Mutex
+	stateMutex sync.Mutex
+	connected  bool
+	closed     bool
+	recovering int32
+	// reconnection gave up, requests fail with it until Connect is called
+	failure error
+
+	stateHandler ConnectionStateHandler
+
+	reconnectMin      time.Duration
+	reconnectMax      time.Duration
+	reconnectAttempts int
+
+	floodWait time.Duration
+	limiter   *rateLimiter
+
+	downloadWorkers int
+
+	entities *entityCache
+
 	network INetwork
 
-	IPv6        bool
-	authkeyfile string
-	id          int32
-	hash        string
-	version     string
-	device      string
-	system      string
-	language    string
+	updates        []interface{}
+	updatesMutex   sync.Mutex
+	updatesSignal  chan struct{}
+	updatesStop    chan struct{}
+	updatesLock    sync.Mutex
+	updateHandlers []UpdateHandler
+	handlersMutex  sync.RWMutex
+	updatesManager *updatesManager
+
+	IPv6       bool
+	storage    ISessionStorage
+	dropAnswer bool
+	mtproto2   bool
+	id         int32
+	hash       string
+	version    string
+	device     string
+	system     string
+	language   string
 
 	dclist map[int32]string
+	dc     int32
+
+	pool          map[int32]*poolConn
+	poolMutex     sync.Mutex
+	dcStorage     func(dc int32) ISessionStorage
+	dcStorages    map[int32]ISessionStorage
+	storagesMutex sync.Mutex
 }
 
 type packetToSend struct {
@@ -39,6 +79,19 @@ type response struct {
 	err  error
 }
 
+// Connection states reported to ConnectionStateHandler
+type ConnectionState int
+
+const (
+	StateConnected ConnectionState = iota
+	StateDisconnected
+	StateReconnecting
+)
+
+// ConnectionStateHandler is called when connection is lost or established again.
+// err describes the reason of disconnection.
+type ConnectionStateHandler func(state ConnectionState, err error)
+
 type Option func(*options)
 
 type options struct {
@@ -48,8 +101,32 @@ type options struct {
 	Language      string
 	IPv6          bool
 	AuthkeyFile   string
+	Storage       ISessionStorage
+	DCStorage     func(dc int32) ISessionStorage
 	ServerAddress string
 	NewSession    bool
+	DropAnswer    bool
+	MTProto2      bool
+	StateHandler  ConnectionStateHandler
+
+	ReconnectMin      time.Duration
+	ReconnectMax      time.Duration
+	ReconnectAttempts int
+
+	FloodWait        time.Duration
+	RateLimit        time.Duration
+	MethodRateLimits map[string]time.Duration
+
+	UpdatesState bool
+	UpdatesStore IUpdatesStorage
+
+	DownloadWorkers int
+
+	EntityStorage IEntityStorage
+
+	// connections of the pool share these with the main one
+	limiter  *rateLimiter
+	entities *entityCache
 }
 
 func WithVersion(version string) Option {
@@ -90,6 +167,110 @@ func WithAuthFile(authfile string, newSession bool) Option {
 	}
 }
 
+// WithUpdatesState enables tracking of updates sequence: updates are delivered in order
+// and missed ones are fetched from server. State is kept in storage between restarts, storage may be nil.
+// Updates returned as results of requests, e.g. of messages.sendMessage, are applied and delivered as well.
+func WithUpdatesState(storage IUpdatesStorage) Option {
+	return func(opts *options) {
+		opts.UpdatesState = true
+		opts.UpdatesStore = storage
+	}
+}
+
+// WithSessionStorage sets backend where session key is kept instead of the auth file
+func WithSessionStorage(storage ISessionStorage, newSession bool) Option {
+	return func(opts *options) {
+		opts.Storage = storage
+		opts.NewSession = newSession
+	}
+}
+
+// WithDCStorage sets storages for auth keys of other data centers.
+// By default keys are kept in files next to auth file, or in memory if WithSessionStorage is used.
+func WithDCStorage(storage func(dc int32) ISessionStorage) Option {
+	return func(opts *options) {
+		opts.DCStorage = storage
+	}
+}
+
+// WithDropAnswer makes InvokeContext send rpc_drop_answer when context is done before response
+func WithDropAnswer(dropAnswer bool) Option {
+	return func(opts *options) {
+		opts.DropAnswer = dropAnswer
+	}
+}
+
+// WithMTProto2 makes connection encrypt messages by MTProto 2.0 instead of deprecated MTProto 1.0
+func WithMTProto2(enabled bool) Option {
+	return func(opts *options) {
+		opts.MTProto2 = enabled
+	}
+}
+
+func WithConnectionStateHandler(handler ConnectionStateHandler) Option {
+	return func(opts *options) {
+		opts.StateHandler = handler
+	}
+}
+
+// WithReconnect configures reconnection after network errors: delay between attempts starts from min
+// and doubles up to max. Zero attempts means reconnect until Disconnect is called.
+func WithReconnect(min, max time.Duration, attempts int) Option {
+	return func(opts *options) {
+		opts.ReconnectMin = min
+		opts.ReconnectMax = max
+		opts.ReconnectAttempts = attempts
+	}
+}
+
+// WithFloodWait sets the longest FLOOD_WAIT which is waited out before request is sent again.
+// Longer waits are returned as FloodWaitError.
+func WithFloodWait(maxWait time.Duration) Option {
+	return func(opts *options) {
+		opts.FloodWait = maxWait
+	}
+}
+
+// WithRateLimit sets minimal interval between requests of the same method
+func WithRateLimit(interval time.Duration) Option {
+	return func(opts *options) {
+		opts.RateLimit = interval
+	}
+}
+
+// WithMethodRateLimit sets minimal interval between requests of the same type as method,
+// e.g. WithMethodRateLimit(TL_messages_sendMessage{}, time.Second)
+func WithMethodRateLimit(method TL, interval time.Duration) Option {
+	return func(opts *options) {
+		if opts.MethodRateLimits == nil {
+			opts.MethodRateLimits = make(map[string]time.Duration)
+		}
+		opts.MethodRateLimits[methodName(method)] = interval
+	}
+}
+
+// WithEntityStorage sets backend where access hashes of users and channels are kept, by default they are kept in memory
+func WithEntityStorage(storage IEntityStorage) Option {
+	return func(opts *options) {
+		opts.EntityStorage = storage
+	}
+}
+
+// WithDownloadWorkers sets how many chunks DownloadFile requests in parallel
+func WithDownloadWorkers(workers int) Option {
+	return func(opts *options) {
+		opts.DownloadWorkers = workers
+	}
+}
+
+// withShared makes connection use limiter and entity cache of connection m
+func withShared(m *MTProto) Option {
+	return func(opts *options) {
+		opts.limiter = m.limiter
+		opts.entities = m.entities
+	}
+}
+
 var defaultOptions = options{
 	DeviceModel:   "Unknown",
 	SystemVersion: runtime.GOOS + "/" + runtime.GOARCH,
@@ -99,21 +280,23 @@ var defaultOptions = options{
 	ServerAddress: "149.154.167.50:443",
 	Version:       "0.0.1",
 	NewSession:    false,
+	ReconnectMin:  time.Second,
+	ReconnectMax:  time.Minute,
+
+	DownloadWorkers: 4,
 }
 
-// API Errors
-const (
-	errorSeeOther     = 303
-	errorBadRequest   = 400
-	errorUnauthorized = 401
-	errorForbidden    = 403
-	errorNotFound     = 404
-	errorFlood        = 420
-	errorInternal     = 500
-)
+// Layer is chosen by schema api.go is generated from, see "API layers" in README.md
+//go:generate go run ./cmd/tlgen -o api.go schemes/api-layer-65.tl
+
+// Requests made after Disconnect get this error
+var errDisconnected = errors.New("disconnected")
 
-// Current API Layer Version
-const layer = 65
+// How many times request follows *_MIGRATE_X errors
+const maxMigrations = 3
+
+// How long Connect waits for initConnection response
+const connectTimeout = 30 * time.Second
 
 func NewMTProto(id int32, hash string, opts ...Option) (*MTProto, error) {
 	var err error
@@ -134,7 +317,9 @@ func NewMTProto(id int32, hash string, opts ...Option) (*MTProto, error) {
 	m := new(MTProto)
 
 	m.queueSend = make(chan packetToSend, 64)
+	m.updatesSignal = make(chan struct{}, 1)
 	m.stopRoutines = make(chan struct{})
+	close(m.stopRoutines)
 	m.allDone = sync.WaitGroup{}
 
 	m.id = id
@@ -143,27 +328,107 @@ func NewMTProto(id int32, hash string, opts ...Option) (*MTProto, error) {
 	m.device = configuration.DeviceModel
 	m.system = configuration.SystemVersion
 	m.language = configuration.Language
-	m.authkeyfile = configuration.AuthkeyFile
+	m.storage = configuration.Storage
+	m.dcStorage = configuration.DCStorage
+	if m.storage == nil {
+		m.storage = NewFileStorage(configuration.AuthkeyFile)
+		if m.dcStorage == nil {
+			m.dcStorage = func(dc int32) ISessionStorage {
+				return NewFileStorage(fmt.Sprintf("%s.dc%d", configuration.AuthkeyFile, dc))
+			}
+		}
+	}
+	if m.dcStorage == nil {
+		m.dcStorage = func(dc int32) ISessionStorage {
+			return NewMemoryStorage()
+		}
+	}
+	m.dcStorages = make(map[int32]ISessionStorage)
+	m.pool = make(map[int32]*poolConn)
 	m.IPv6 = configuration.IPv6
+	m.dropAnswer = configuration.DropAnswer
+	m.mtproto2 = configuration.MTProto2
+	m.stateHandler = configuration.StateHandler
+	m.reconnectMin = configuration.ReconnectMin
+	m.reconnectMax = configuration.ReconnectMax
+	m.reconnectAttempts = configuration.ReconnectAttempts
+	m.floodWait = configuration.FloodWait
+	m.limiter = configuration.limiter
+	if m.limiter == nil {
+		m.limiter = newRateLimiter(configuration.RateLimit, configuration.MethodRateLimits)
+	}
+	m.downloadWorkers = configuration.DownloadWorkers
+	if m.downloadWorkers < 1 {
+		m.downloadWorkers = 1
+	}
+
+	m.entities = configuration.entities
+	if m.entities == nil {
+		if configuration.EntityStorage == nil {
+			configuration.EntityStorage = NewMemoryEntityStorage()
+		}
+		if m.entities, err = newEntityCache(configuration.EntityStorage); err != nil {
+			return nil, err
+		}
+	}
+
+	if configuration.UpdatesState {
+		m.updatesManager = newUpdatesManager(m, configuration.UpdatesStore)
+	}
 
-	if m.network, err = NewNetwork(configuration.NewSession, m.authkeyfile, m.queueSend, configuration.ServerAddress, m.IPv6); err != nil {
+	if m.network, err = NewNetwork(configuration.NewSession, m.storage, m.queueSend, configuration.ServerAddress, m.IPv6, m.mtproto2); err != nil {
 		return nil, err
 	}
 
 	return m, nil
 }
 
-func (m *MTProto) Connect() (err error) {
-	m.network.Connect()
+func (m *MTProto) Connect() error {
+	m.connMutex.Lock()
+	defer m.connMutex.Unlock()
+
+	m.stateMutex.Lock()
+	m.closed = false
+	m.failure = nil
+	connected := m.connected
+	m.stateMutex.Unlock()
+
+	// e.g. Connect is called by state handler while recover waits for the next attempt
+	if connected {
+		return nil
+	}
+
+	if err := m.connect(); err != nil {
+		return err
+	}
+	m.startUpdates()
+
+	return nil
+}
+
+func (m *MTProto) connect() (err error) {
+	if err = m.network.Connect(); err != nil {
+		return
+	}
+
+	stop := make(chan struct{})
+	m.stateMutex.Lock()
+	m.stopRoutines = stop
+	m.connected = true
+	m.stateMutex.Unlock()
 
 	// start goroutines
-	go m.sendRoutine()
-	go m.readRoutine()
+	m.allDone.Add(2)
+	go m.sendRoutine(stop)
+	go m.readRoutine(stop)
 
 	var data *TL
 
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+	defer cancel()
+
 	// (help_getConfig)
-	if data, err = m.InvokeSync(TL_invokeWithLayer{
+	if data, err = m.InvokeContext(ctx, TL_invokeWithLayer{
 		Layer: layer,
 		Query: TL_initConnection{
 			Api_id:         m.id,
@@ -174,64 +439,325 @@ func (m *MTProto) Connect() (err error) {
 			Query:          TL_help_getConfig{},
 		},
 	}); err != nil {
+		// routines of failed connection mustn't outlive it
+		_ = m.disconnect()
 		return
 	}
 
 	switch (*data).(type) {
 	case TL_config:
-		m.dclist = make(map[int32]string, 5)
-		for _, v := range (*data).(TL_config).Dc_options {
+		config := (*data).(TL_config)
+		dclist := make(map[int32]string, 5)
+		for _, v := range config.Dc_options {
 			v := v.(TL_dcOption)
+			// media only addresses don't serve other requests
+			if _, ok := dclist[v.Id]; ok && v.Media_only {
+				continue
+			}
 			if m.IPv6 && v.Ipv6 {
-				m.dclist[v.Id] = fmt.Sprintf("[%s]:%d", v.Ip_address, v.Port)
+				dclist[v.Id] = fmt.Sprintf("[%s]:%d", v.Ip_address, v.Port)
 			} else if !v.Ipv6 {
-				m.dclist[v.Id] = fmt.Sprintf("%s:%d", v.Ip_address, v.Port)
+				dclist[v.Id] = fmt.Sprintf("%s:%d", v.Ip_address, v.Port)
 			}
 		}
+		m.stateMutex.Lock()
+		m.dclist = dclist
+		m.dc = config.This_dc
+		m.stateMutex.Unlock()
 	default:
-		err = fmt.Errorf("Connection error: got: %T", data)
+		_ = m.disconnect()
+		return fmt.Errorf("Connection error: got: %T", *data)
 	}
 
 	// start keep alive ping
-	go m.pingRoutine()
+	m.allDone.Add(1)
+	go m.pingRoutine(stop)
 
 	return
 }
 
 func (m *MTProto) Disconnect() error {
+	m.connMutex.Lock()
+	defer m.connMutex.Unlock()
+
+	m.stateMutex.Lock()
+	m.closed = true
+	m.stateMutex.Unlock()
+
+	err := m.disconnect()
+	m.network.Fail(errDisconnected)
+	// nothing sends queued requests anymore
+	m.drainQueue(errDisconnected)
+	m.stopUpdates()
+	m.closePool()
+
+	return err
+}
+
+func (m *MTProto) disconnect() error {
+	m.stateMutex.Lock()
+	if !m.connected {
+		m.stateMutex.Unlock()
+		return nil
+	}
+	m.connected = false
 	// stop ping, send and read routine by closing channel stopRoutines
 	close(m.stopRoutines)
+	m.stateMutex.Unlock()
+
+	// unblock read routine
+	err := m.network.Disconnect()
 
 	// Wait until all goroutines stopped
 	m.allDone.Wait()
 
-	// close send queue
-	close(m.queueSend)
-
-	return m.network.Disconnect()
+	return err
 }
 
-func (m *MTProto) reconnect(newaddr string) error {
-	err := m.Disconnect()
+// migrate moves connection to data center dc. Auth key of the current DC is kept
+// in the DC storage and key of the new DC is reused if it was created before.
+func (m *MTProto) migrate(dc int32) error {
+	newaddr, ok := m.dcAddress(dc)
+	if !ok {
+		return fmt.Errorf("wrong DC index: %d", dc)
+	}
+
+	m.connMutex.Lock()
+	defer m.connMutex.Unlock()
+
+	err := m.disconnect()
 	if err != nil {
 		return err
 	}
+	pending := m.network.Pending()
 
 	// renew connection
 	if newaddr != m.network.Address() {
-		m.network, err = NewNetwork(true, m.authkeyfile, m.queueSend, newaddr, m.IPv6)
+		// keys mustn't be overwritten if they can't be read
+		data, err := m.storage.Load()
+		if err != nil {
+			failRequests(pending, err)
+			return err
+		}
+		if len(data) != 0 && m.dc != 0 {
+			_ = m.storageForDC(m.dc).Save(data)
+		}
+		newSession := true
+		data, err = m.storageForDC(dc).Load()
+		if err != nil {
+			failRequests(pending, err)
+			return err
+		}
+		if len(data) != 0 {
+			if err = m.storage.Save(data); err == nil {
+				newSession = false
+			}
+		}
+
+		network, err := NewNetwork(newSession, m.storage, m.queueSend, newaddr, m.IPv6, m.mtproto2)
+		if err != nil {
+			failRequests(pending, err)
+			return err
+		}
+		// invoke reads network without connMutex
+		m.stateMutex.Lock()
+		m.network = network
+		m.stateMutex.Unlock()
+
+		// connection to the new DC isn't needed in pool anymore
+		m.poolMutex.Lock()
+		c, ok := m.pool[dc]
+		delete(m.pool, dc)
+		m.poolMutex.Unlock()
+		if ok {
+			c.close()
+		}
 	}
 
-	err = m.Connect()
-	return err
+	if err = m.connect(); err != nil {
+		failRequests(pending, err)
+		return err
+	}
+	go m.resend(pending)
+
+	return nil
 }
 
-func (m *MTProto) pingRoutine() {
-	m.allDone.Add(1)
+// networkError is called by send and read routines when connection is broken
+func (m *MTProto) networkError(err error) {
+	// only the first error of the connection starts recovery
+	if !atomic.CompareAndSwapInt32(&m.recovering, 0, 1) {
+		return
+	}
+
+	go m.recover(err)
+}
+
+// recover establishes connection again with exponential backoff
+// and resends requests which didn't get response
+func (m *MTProto) recover(err error) {
+	defer atomic.StoreInt32(&m.recovering, 0)
+
+	m.connMutex.Lock()
+	// Disconnect was called by user
+	if m.isClosed() {
+		m.connMutex.Unlock()
+		return
+	}
+	_ = m.disconnect()
+	pending := m.network.Pending()
+	m.connMutex.Unlock()
+
+	// handler may call Disconnect, so it's called without connMutex
+	m.notifyState(StateDisconnected, err)
+
+	delay := m.reconnectMin
+	if delay <= 0 {
+		delay = time.Second
+	}
+	for attempt := 1; ; attempt++ {
+		m.notifyState(StateReconnecting, nil)
+
+		m.connMutex.Lock()
+		if m.isClosed() {
+			m.connMutex.Unlock()
+			failRequests(pending, errDisconnected)
+			return
+		}
+		if m.isConnected() {
+			// Connect was called by user during backoff
+			m.connMutex.Unlock()
+			break
+		}
+		err = m.connect()
+		if err != nil {
+			_ = m.disconnect()
+		}
+		m.connMutex.Unlock()
+
+		if err == nil {
+			break
+		}
+
+		m.notifyState(StateDisconnected, err)
+		if m.reconnectAttempts > 0 && attempt >= m.reconnectAttempts {
+			m.stateMutex.Lock()
+			m.failure = err
+			m.stateMutex.Unlock()
+			failRequests(pending, err)
+			// requests queued during backoff won't be sent either
+			m.drainQueue(err)
+			return
+		}
+
+		time.Sleep(delay)
+		delay *= 2
+		if delay > m.reconnectMax {
+			delay = m.reconnectMax
+		}
+	}
+
+	m.notifyState(StateConnected, nil)
+	go m.resend(pending)
+}
+
+// resend puts requests back to send queue, they get new msg_id when they are sent again
+func (m *MTProto) resend(requests []packetToSend) {
+	for i, packet := range requests {
+		if err := m.enqueue(context.Background(), packet); err != nil {
+			failRequests(requests[i:], err)
+			return
+		}
+	}
+}
+
+// enqueue puts packet to send queue. Nothing is queued after Disconnect or failed reconnection,
+// the error is returned instead.
+func (m *MTProto) enqueue(ctx context.Context, packet packetToSend) error {
+	if err := m.unavailable(); err != nil {
+		return err
+	}
+
+	select {
+	case m.queueSend <- packet:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	// queue could be drained by Disconnect before the packet was put there
+	if err := m.unavailable(); err != nil {
+		m.drainQueue(err)
+	}
+
+	return nil
+}
+
+// unavailable returns error if requests can't be sent until Connect is called
+func (m *MTProto) unavailable() error {
+	m.stateMutex.Lock()
+	defer m.stateMutex.Unlock()
+
+	if m.closed {
+		return errDisconnected
+	}
+
+	return m.failure
+}
+
+// drainQueue answers err to all queued requests
+func (m *MTProto) drainQueue(err error) {
+	for {
+		select {
+		case packet := <-m.queueSend:
+			failRequests([]packetToSend{packet}, err)
+		default:
+			return
+		}
+	}
+}
+
+// currentNetwork returns network of the current connection, it's replaced by migrate
+func (m *MTProto) currentNetwork() INetwork {
+	m.stateMutex.Lock()
+	defer m.stateMutex.Unlock()
+
+	return m.network
+}
+
+func (m *MTProto) isConnected() bool {
+	m.stateMutex.Lock()
+	defer m.stateMutex.Unlock()
+
+	return m.connected
+}
+
+func (m *MTProto) isClosed() bool {
+	m.stateMutex.Lock()
+	defer m.stateMutex.Unlock()
+
+	return m.closed
+}
+
+func failRequests(requests []packetToSend, err error) {
+	for _, v := range requests {
+		if v.resp != nil {
+			v.resp <- response{err: err}
+			close(v.resp)
+		}
+	}
+}
+
+func (m *MTProto) notifyState(state ConnectionState, err error) {
+	if m.stateHandler != nil {
+		m.stateHandler(state, err)
+	}
+}
+
+func (m *MTProto) pingRoutine(stop chan struct{}) {
 	defer func() { m.allDone.Done() }()
 	for {
 		select {
-		case <-m.stopRoutines:
+		case <-stop:
 			return
 		case <-time.After(60 * time.Second):
 			// TODO: m.InvokeSync()?
@@ -240,95 +766,153 @@ func (m *MTProto) pingRoutine() {
 	}
 }
 
-func (m *MTProto) sendRoutine() {
-	m.allDone.Add(1)
+func (m *MTProto) sendRoutine(stop chan struct{}) {
 	defer func() { m.allDone.Done() }()
 	for {
 		select {
-		case <-m.stopRoutines:
+		case <-stop:
 			return
 		case x := <-m.queueSend:
 			err := m.network.Send(x.msg, x.resp)
 			if err != nil {
-				log.Fatalln("SendRoutine:", err)
+				// request stays pending and will be sent again after reconnect
+				m.networkError(err)
+				return
 			}
 		}
 	}
 }
 
-func (m *MTProto) readRoutine() {
-	m.allDone.Add(1)
+func (m *MTProto) readRoutine(stop chan struct{}) {
 	defer func() { m.allDone.Done() }()
+
+	type result struct {
+		data interface{}
+		err  error
+	}
+
 	for {
 		// Run async wait for data from server
-		ch := make(chan interface{}, 1)
-		go func(ch chan<- interface{}) {
+		ch := make(chan result, 1)
+		go func(ch chan<- result) {
 			data, err := m.network.Read()
-			if err == io.EOF {
-				// TODO: Last message to the server was lost. Fix it.
-				// Connection closed by server, trying to reconnect
-				err = m.reconnect(m.network.Address())
-				if err != nil {
-					log.Fatalln("ReadRoutine: ", err)
-				}
-			}
-			if err != nil {
-				log.Fatalln("ReadRoutine: ", err)
-			}
-			ch <- data
+			ch <- result{data, err}
 		}(ch)
 
 		select {
-		case <-m.stopRoutines:
+		case <-stop:
 			return
-		case data := <-ch:
-			if data == nil {
+		case x := <-ch:
+			if x.err != nil {
+				select {
+				case <-stop:
+					// connection was closed by Disconnect
+				default:
+					m.networkError(x.err)
+				}
 				return
 			}
-			m.network.Process(data)
+			for _, update := range m.network.Process(x.data) {
+				m.dispatchUpdate(update)
+			}
 		}
 	}
 }
 
 func (m *MTProto) InvokeSync(msg TL) (*TL, error) {
-	x := <-m.InvokeAsync(msg)
+	return m.InvokeContext(context.Background(), msg)
+}
 
-	if x.err != nil {
-		if err, ok := x.err.(TL_rpc_error); ok {
-			switch err.Error_code {
-			case errorSeeOther:
-				var newDc int32
-				n, _ := fmt.Sscanf(err.Error_message, "PHONE_MIGRATE_%d", &newDc)
-				if n != 1 {
-					n, _ := fmt.Sscanf(err.Error_message, "NETWORK_MIGRATE_%d", &newDc)
-					if n != 1 {
-						return nil, fmt.Errorf("RPC error_string: %s", err.Error_message)
-					}
-				}
-				newDcAddr, ok := m.dclist[newDc]
-				if !ok {
-					return nil, fmt.Errorf("wrong DC index: %d", newDc)
-				}
-				err := m.reconnect(newDcAddr)
-				if err != nil {
-					return nil, err
-				}
+// InvokeContext sends request and waits for response until ctx is done.
+// Requests answered with *_MIGRATE_X errors are sent again to the data center X.
+// FLOOD_WAIT_X errors are waited out if X isn't longer than allowed by WithFloodWait.
+func (m *MTProto) InvokeContext(ctx context.Context, msg TL) (*TL, error) {
+	method := methodName(msg)
+	for migrations := 0; ; {
+		if err := m.limiter.wait(ctx, method); err != nil {
+			return nil, err
+		}
+
+		data, err := m.invoke(ctx, msg)
+
+		rpcError, ok := err.(*RPCError)
+		if !ok {
+			return data, err
+		}
+
+		if rpcError.Is(ErrFloodWait) {
+			wait := time.Duration(rpcError.Argument) * time.Second
+			if wait > m.floodWait {
+				return nil, &FloodWaitError{Duration: wait, Err: rpcError}
+			}
+			// limiter sleeps before the next attempt
+			m.limiter.block(method, wait)
+			continue
+		}
+
+		if rpcError.Code != errorSeeOther || migrations == maxMigrations {
+			return data, err
+		}
+		migrations++
+
+		switch {
+		case rpcError.Is(ErrFileMigrate), rpcError.Is(ErrStatsMigrate):
+			// only this request should be served by another DC
+			return m.InvokeOnDC(ctx, rpcError.Argument, msg)
+		case rpcError.Is(ErrPhoneMigrate), rpcError.Is(ErrNetworkMigrate), rpcError.Is(ErrUserMigrate):
+			// account lives in another DC
+			if err := m.migrate(rpcError.Argument); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, err
+		}
+	}
+}
+
+// invoke sends request and waits for response until ctx is done
+func (m *MTProto) invoke(ctx context.Context, msg TL) (*TL, error) {
+	resp := make(chan response, 1)
+	if err := m.enqueue(ctx, packetToSend{msg: msg, resp: resp}); err != nil {
+		return nil, err
+	}
+
+	var x response
+	select {
+	case x = <-resp:
+	case <-ctx.Done():
+		if msgId, ok := m.currentNetwork().Cancel(resp); ok && m.dropAnswer {
+			select {
+			case m.queueSend <- packetToSend{msg: TL_rpc_drop_answer{msgId}}:
 			default:
-				return nil, x.err
 			}
 		}
+		return nil, ctx.Err()
+	}
 
+	if x.err != nil {
+		if rpcError, ok := x.err.(TL_rpc_error); ok {
+			return nil, newRPCError(rpcError)
+		}
 		return nil, x.err
 	}
+	m.entities.remember(x.data)
+	// results such as updateShortSentMessage move pts forward, otherwise they look like a gap later
+	if m.updatesManager != nil && isUpdates(x.data) {
+		m.queueUpdate(x.data)
+	}
 
 	return &x.data, nil
 }
 
 func (m *MTProto) InvokeAsync(msg TL) chan response {
 	resp := make(chan response, 1)
-	m.queueSend <- packetToSend{
+	packet := packetToSend{
 		msg:  msg,
 		resp: resp,
 	}
+	if err := m.enqueue(context.Background(), packet); err != nil {
+		failRequests([]packetToSend{packet}, err)
+	}
 	return resp
 }
@@ -0,0 +1,107 @@
+package mtproto
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// testNetwork is connection which never sends or receives anything
+type testNetwork struct{}
+
+func (testNetwork) Connect() error                          { return nil }
+func (testNetwork) Disconnect() error                       { return nil }
+func (testNetwork) Send(msg TL, resp chan response) error   { return nil }
+func (testNetwork) Read() (interface{}, error)              { select {} }
+func (testNetwork) Process(data interface{}) []interface{}  { return nil }
+func (testNetwork) Cancel(resp chan response) (int64, bool) { return 0, false }
+func (testNetwork) Fail(err error)                          {}
+func (testNetwork) Pending() []packetToSend                 { return nil }
+func (testNetwork) Address() string                         { return "" }
+
+// failingNetwork can't connect
+type failingNetwork struct {
+	testNetwork
+	err error
+}
+
+func (nw failingNetwork) Connect() error { return nw.err }
+
+func newTestMTProto() *MTProto {
+	return &MTProto{
+		queueSend:     make(chan packetToSend, 64),
+		updatesSignal: make(chan struct{}, 1),
+		network:       testNetwork{},
+	}
+}
+
+func TestDisconnectFailsQueuedRequests(t *testing.T) {
+	m := newTestMTProto()
+	resp := m.InvokeAsync(TL_ping{})
+
+	if err := m.Disconnect(); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case x := <-resp:
+		if x.err != errDisconnected {
+			t.Errorf("queued request got %v", x.err)
+		}
+	default:
+		t.Fatal("queued request didn't get an answer")
+	}
+}
+
+func TestInvokeAfterDisconnect(t *testing.T) {
+	m := newTestMTProto()
+	if err := m.Disconnect(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := m.invoke(ctx, TL_ping{}); err != errDisconnected {
+		t.Errorf("invoke after Disconnect returned %v", err)
+	}
+	if len(m.queueSend) != 0 {
+		t.Errorf("%d packets are queued after Disconnect", len(m.queueSend))
+	}
+}
+
+func TestRecoverGivesUp(t *testing.T) {
+	m := newTestMTProto()
+	m.reconnectAttempts = 1
+	m.reconnectMin = time.Millisecond
+	errConnect := errors.New("connection refused")
+	m.network = failingNetwork{err: errConnect}
+
+	resp := m.InvokeAsync(TL_ping{})
+	m.recover(errors.New("connection reset"))
+
+	select {
+	case x := <-resp:
+		if x.err != errConnect {
+			t.Errorf("queued request got %v", x.err)
+		}
+	default:
+		t.Fatal("queued request didn't get an answer")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := m.invoke(ctx, TL_ping{}); err != errConnect {
+		t.Errorf("invoke after failed reconnection returned %v", err)
+	}
+}
+
+func TestConnectWhenConnected(t *testing.T) {
+	m := newTestMTProto()
+	m.connected = true
+	m.network = failingNetwork{err: errors.New("connection refused")}
+
+	if err := m.Connect(); err != nil {
+		t.Errorf("Connect of connected instance: %v", err)
+	}
+}
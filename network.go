@@ -4,9 +4,9 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
-	"os"
 	"fmt"
 	"net"
+	"sort"
 	"sync"
 	"time"
 )
@@ -18,7 +18,15 @@ type INetwork interface {
 
 	Send(msg TL, resp chan response) error
 	Read() (interface{}, error)
-	Process(data interface{}) interface{}
+	// Process handles service messages and returns the rest, e.g. updates, containers are unpacked
+	Process(data interface{}) []interface{}
+	// Cancel forgets request waiting for response on resp channel.
+	// Returns message id if request was already sent.
+	Cancel(resp chan response) (int64, bool)
+	// Fail returns err to all requests waiting for response
+	Fail(err error)
+	// Pending removes and returns all requests sent but not answered yet
+	Pending() []packetToSend
 
 	Address() string
 }
@@ -26,14 +34,18 @@ type INetwork interface {
 type Network struct {
 	session ISession
 
-	useIPv6 bool
-	address string
+	useIPv6  bool
+	address  string
+	mtproto2 bool
 
 	conn *net.TCPConn
+	// closed by Disconnect, read routine stops waiting for the send queue
+	closed chan struct{}
 
 	mutex        *sync.Mutex
 	msgsIdToAck  map[int64]packetToSend
-	msgsIdToResp map[int64]chan response
+	msgsIdToResp map[int64]packetToSend
+	cancelled    map[chan response]struct{}
 
 	queueSend chan packetToSend
 	lastSeqNo int32
@@ -41,22 +53,25 @@ type Network struct {
 	msgId     int64
 }
 
-func NewNetwork(newSession bool, authkeyfile string, queueSend chan packetToSend, address string, useIPv6 bool) (INetwork, error) {
+// NewNetwork creates connection to address, messages are encrypted by MTProto 2.0 if mtproto2 is set
+func NewNetwork(newSession bool, storage ISessionStorage, queueSend chan packetToSend, address string, useIPv6, mtproto2 bool) (INetwork, error) {
 	nw := new(Network)
 
 	nw.queueSend = queueSend
 	nw.msgsIdToAck = make(map[int64]packetToSend)
-	nw.msgsIdToResp = make(map[int64]chan response)
+	nw.msgsIdToResp = make(map[int64]packetToSend)
+	nw.cancelled = make(map[chan response]struct{})
 	nw.mutex = &sync.Mutex{}
 
 	nw.useIPv6 = useIPv6
 	nw.address = address
+	nw.mtproto2 = mtproto2
 
 	var err error
 	if newSession {
-		err = nw.CreateSession(authkeyfile)
+		err = nw.CreateSession(storage)
 	} else {
-		err = nw.LoadSession(authkeyfile)
+		err = nw.LoadSession(storage)
 	}
 
 	if err != nil {
@@ -66,14 +81,9 @@ func NewNetwork(newSession bool, authkeyfile string, queueSend chan packetToSend
 	return nw, nil
 }
 
-// Accepts path to file where to store session key
-func (nw *Network) CreateSession(session string) error {
-	file, err := os.OpenFile(session, os.O_RDWR|os.O_CREATE, 0600)
-	if err != nil {
-		return err
-	}
-
-	nw.session = NewSession(file)
+// Accepts storage where to keep session key
+func (nw *Network) CreateSession(storage ISessionStorage) error {
+	nw.session = NewSession(storage)
 	nw.session.SetAddress(nw.address)
 	nw.session.UseIPv6(nw.useIPv6)
 	nw.session.Encrypted(false)
@@ -81,18 +91,16 @@ func (nw *Network) CreateSession(session string) error {
 	return nil
 }
 
-// Accepts path to file where to store session key
-func (nw *Network) LoadSession(session string) error {
-	file, err := os.OpenFile(session, os.O_RDWR|os.O_CREATE, 0600)
-	if err != nil {
+// Accepts storage where session key is kept. New session is created only if nothing is saved,
+// other errors are returned as creating session would overwrite the saved key.
+func (nw *Network) LoadSession(storage ISessionStorage) error {
+	nw.session = NewSession(storage)
+	if err := nw.session.Load(); err == ErrNoSession {
+		return nw.CreateSession(storage)
+	} else if err != nil {
 		return err
 	}
 
-	nw.session = NewSession(file)
-	if err = nw.session.Load(); err != nil {
-		return nw.CreateSession(session)
-	}
-
 	nw.session.Encrypted(true)
 
 	return nil
@@ -102,6 +110,8 @@ func (nw *Network) Connect() error {
 	var err error
 	var tcpAddr *net.TCPAddr
 
+	nw.closed = make(chan struct{})
+
 	// connect
 	tcpAddr, err = net.ResolveTCPAddr("tcp", nw.session.GetAddress())
 	if err != nil {
@@ -114,12 +124,14 @@ func (nw *Network) Connect() error {
 	// Packet Length is encoded by a single byte (see: https://core.telegram.org/mtproto)
 	_, err = nw.conn.Write([]byte{0xef})
 	if err != nil {
+		_ = nw.conn.Close()
 		return err
 	}
 	// get new authKey if need
 	if !nw.session.IsEncrypted() {
 		err = nw.makeAuthKey()
 		if err != nil {
+			_ = nw.conn.Close()
 			return err
 		}
 	}
@@ -128,11 +140,37 @@ func (nw *Network) Connect() error {
 }
 
 func (nw *Network) Disconnect() error {
+	select {
+	case <-nw.closed:
+	default:
+		close(nw.closed)
+	}
 
 	return nw.conn.Close()
 }
 
+// push puts packet to send queue. It gives up when connection is closed, as send routine
+// may be already stopped and nothing would take the packet.
+func (nw *Network) push(packet packetToSend) bool {
+	select {
+	case nw.queueSend <- packet:
+		return true
+	case <-nw.closed:
+		return false
+	}
+}
+
 func (nw *Network) Send(msg TL, resp chan response) error {
+	if resp != nil {
+		nw.mutex.Lock()
+		_, cancelled := nw.cancelled[resp]
+		delete(nw.cancelled, resp)
+		nw.mutex.Unlock()
+		if cancelled {
+			return nil
+		}
+	}
+
 	obj := msg.encode()
 
 	x := NewEncodeBuf(256)
@@ -159,11 +197,18 @@ func (nw *Network) Send(msg TL, resp chan response) error {
 		z.Int(int32(len(obj)))
 		z.Bytes(obj)
 
-		msgKey := sha1(z.buf)[4:20]
-		aesKey, aesIV := generateAES(msgKey, nw.session.GetAuthKey(), false)
-
-		y := make([]byte, len(z.buf)+((16-(len(obj)%16))&15))
-		copy(y, z.buf)
+		var msgKey, aesKey, aesIV, y []byte
+		if nw.mtproto2 {
+			z.Bytes(GenerateNonce(paddingLen(len(z.buf))))
+			y = z.buf
+			msgKey = messageKey(nw.session.GetAuthKey(), y, false)
+			aesKey, aesIV = generateAES2(msgKey, nw.session.GetAuthKey(), false)
+		} else {
+			msgKey = sha1(z.buf)[4:20]
+			aesKey, aesIV = generateAES(msgKey, nw.session.GetAuthKey(), false)
+			y = make([]byte, len(z.buf)+((16-(len(obj)%16))&15))
+			copy(y, z.buf)
+		}
 		encryptedData, err := doAES256IGEencrypt(y, aesKey, aesIV)
 		if err != nil {
 			return err
@@ -182,7 +227,7 @@ func (nw *Network) Send(msg TL, resp chan response) error {
 
 		if resp != nil {
 			nw.mutex.Lock()
-			nw.msgsIdToResp[newMsgId] = resp
+			nw.msgsIdToResp[newMsgId] = packetToSend{msg, resp}
 			nw.mutex.Unlock()
 		}
 
@@ -271,7 +316,12 @@ func (nw *Network) Read() (interface{}, error) {
 	} else {
 		msgKey := dbuf.Bytes(16)
 		encryptedData := dbuf.Bytes(dbuf.size - 24)
-		aesKey, aesIV := generateAES(msgKey, nw.session.GetAuthKey(), true)
+		var aesKey, aesIV []byte
+		if nw.mtproto2 {
+			aesKey, aesIV = generateAES2(msgKey, nw.session.GetAuthKey(), true)
+		} else {
+			aesKey, aesIV = generateAES(msgKey, nw.session.GetAuthKey(), true)
+		}
 		x, err := doAES256IGEdecrypt(encryptedData, aesKey, aesIV)
 		if err != nil {
 			return nil, err
@@ -282,10 +332,17 @@ func (nw *Network) Read() (interface{}, error) {
 		nw.msgId = dbuf.Long()
 		nw.seqNo = dbuf.Int()
 		messageLen := dbuf.Int()
-		if int(messageLen) > dbuf.size-32 {
+		if messageLen < 0 || int(messageLen) > dbuf.size-32 {
 			return nil, fmt.Errorf("Message len: %d (need less than %d)", messageLen, dbuf.size-32)
 		}
-		if !bytes.Equal(sha1(dbuf.buf[0 : 32+messageLen])[4:20], msgKey) {
+		if nw.mtproto2 {
+			if padding := dbuf.size - 32 - int(messageLen); padding < 12 || padding > 1024 {
+				return nil, fmt.Errorf("Wrong padding: %d", padding)
+			}
+			if !bytes.Equal(messageKey(nw.session.GetAuthKey(), dbuf.buf, true), msgKey) {
+				return nil, errors.New("Wrong msg_key")
+			}
+		} else if !bytes.Equal(sha1(dbuf.buf[0 : 32+messageLen])[4:20], msgKey) {
 			return nil, errors.New("Wrong msg_key")
 		}
 
@@ -476,16 +533,19 @@ func (nw *Network) makeAuthKey() error {
 	return nil
 }
 
-func (nw *Network) Process(data interface{}) interface{} {
+func (nw *Network) Process(data interface{}) []interface{} {
 	return nw.process(nw.msgId, nw.seqNo, data)
 }
 
-func (nw *Network) process(msgId int64, seqNo int32, data interface{}) interface{} {
+func (nw *Network) process(msgId int64, seqNo int32, data interface{}) []interface{} {
+	var result []interface{}
+
 	switch data.(type) {
 	case TL_msg_container:
 		data := data.(TL_msg_container).Items
+		// server batches pushed updates in containers
 		for _, v := range data {
-			nw.process(v.Msg_id, v.Seq_no, v.Data)
+			result = append(result, nw.process(v.Msg_id, v.Seq_no, v.Data)...)
 		}
 
 	case TL_bad_server_salt:
@@ -495,8 +555,12 @@ func (nw *Network) process(msgId int64, seqNo int32, data interface{}) interface
 		nw.mutex.Lock()
 		defer nw.mutex.Unlock()
 		for k, v := range nw.msgsIdToAck {
+			// requests which weren't queued stay pending and are sent again after reconnect
+			if !nw.push(v) {
+				break
+			}
 			delete(nw.msgsIdToAck, k)
-			nw.queueSend <- v
+			delete(nw.msgsIdToResp, k)
 		}
 
 	case TL_new_session_created:
@@ -506,7 +570,7 @@ func (nw *Network) process(msgId int64, seqNo int32, data interface{}) interface
 
 	case TL_ping:
 		data := data.(TL_ping)
-		nw.queueSend <- packetToSend{TL_pong{msgId, data.Ping_id}, nil}
+		nw.push(packetToSend{TL_pong{msgId, data.Ping_id}, nil})
 
 	case TL_pong:
 		// ignore
@@ -521,10 +585,12 @@ func (nw *Network) process(msgId int64, seqNo int32, data interface{}) interface
 
 	case TL_rpc_result:
 		data := data.(TL_rpc_result)
-		x := nw.process(msgId, seqNo, data.Obj)
+		// result object is acknowledged together with rpc_result itself
+		x := data.Obj
 		nw.mutex.Lock()
 		defer nw.mutex.Unlock()
 		if v, ok := nw.msgsIdToResp[data.Req_msg_id]; ok {
+			v := v.resp
 			var resp response
 			if rpcError, ok := x.(TL_rpc_error); ok {
 				resp.err = rpcError
@@ -533,18 +599,89 @@ func (nw *Network) process(msgId int64, seqNo int32, data interface{}) interface
 			v <- resp
 
 			close(v)
+			delete(nw.msgsIdToResp, data.Req_msg_id)
 		}
 		delete(nw.msgsIdToAck, data.Req_msg_id)
 	default:
-		return data
+		result = []interface{}{data}
 	}
 
-	// TODO: Check why I should do this
+	// Content-related messages (odd seqNo) must be acknowledged
 	if (seqNo & 1) == 1 {
-		nw.queueSend <- packetToSend{TL_msgs_ack{[]int64{msgId}}, nil}
+		nw.push(packetToSend{TL_msgs_ack{[]int64{msgId}}, nil})
 	}
 
-	return nil
+	return result
+}
+
+func (nw *Network) Cancel(resp chan response) (int64, bool) {
+	nw.mutex.Lock()
+	defer nw.mutex.Unlock()
+
+	for msgId, v := range nw.msgsIdToResp {
+		if v.resp == resp {
+			delete(nw.msgsIdToResp, msgId)
+			delete(nw.msgsIdToAck, msgId)
+			return msgId, true
+		}
+	}
+
+	// Response is already delivered
+	if len(resp) != 0 {
+		return 0, false
+	}
+
+	// Request is still in the send queue
+	nw.cancelled[resp] = struct{}{}
+
+	return 0, false
+}
+
+func (nw *Network) Fail(err error) {
+	nw.mutex.Lock()
+	defer nw.mutex.Unlock()
+
+	for msgId, v := range nw.msgsIdToResp {
+		resp := v.resp
+		select {
+		case resp <- response{err: err}:
+		default:
+		}
+		close(resp)
+		delete(nw.msgsIdToResp, msgId)
+	}
+	for msgId := range nw.msgsIdToAck {
+		delete(nw.msgsIdToAck, msgId)
+	}
+}
+
+func (nw *Network) Pending() []packetToSend {
+	nw.mutex.Lock()
+	defer nw.mutex.Unlock()
+
+	ids := make([]int64, 0, len(nw.msgsIdToResp)+len(nw.msgsIdToAck))
+	packets := make(map[int64]packetToSend, cap(ids))
+	for msgId, v := range nw.msgsIdToResp {
+		ids = append(ids, msgId)
+		packets[msgId] = v
+	}
+	for msgId, v := range nw.msgsIdToAck {
+		if _, ok := packets[msgId]; !ok {
+			ids = append(ids, msgId)
+			packets[msgId] = v
+		}
+	}
+	nw.msgsIdToResp = make(map[int64]packetToSend)
+	nw.msgsIdToAck = make(map[int64]packetToSend)
+
+	// keep original order of requests
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	pending := make([]packetToSend, len(ids))
+	for i, msgId := range ids {
+		pending[i] = packets[msgId]
+	}
+
+	return pending
 }
 
 func (nw Network) Address() string {
@@ -0,0 +1,31 @@
+package mtproto
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestProcessAfterDisconnect(t *testing.T) {
+	nw := &Network{
+		mutex:        &sync.Mutex{},
+		msgsIdToAck:  make(map[int64]packetToSend),
+		msgsIdToResp: make(map[int64]packetToSend),
+		// nothing takes packets from the queue
+		queueSend: make(chan packetToSend),
+		closed:    make(chan struct{}),
+	}
+	close(nw.closed)
+
+	done := make(chan struct{})
+	go func() {
+		nw.process(1, 1, TL_ping{Ping_id: 1})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("process is blocked by the send queue")
+	}
+}
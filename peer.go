@@ -0,0 +1,81 @@
+package mtproto
+
+import "fmt"
+
+// peerEntity finds user, chat or channel referenced by peer among users and chats of a response
+func peerEntity(peer Peer, users []User, chats []Chat) TL {
+	switch p := peer.(type) {
+	case TL_peerUser:
+		for _, user := range users {
+			if entityId(user) == p.User_id {
+				return user
+			}
+		}
+	case TL_peerChat:
+		for _, chat := range chats {
+			switch chat.(type) {
+			case TL_chat, TL_chatForbidden, TL_chatEmpty:
+				if entityId(chat) == p.Chat_id {
+					return chat
+				}
+			}
+		}
+	case TL_peerChannel:
+		for _, chat := range chats {
+			switch chat.(type) {
+			case TL_channel, TL_channelForbidden:
+				if entityId(chat) == p.Channel_id {
+					return chat
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// entityId returns identifier of user, chat or channel
+func entityId(entity TL) int32 {
+	switch x := entity.(type) {
+	case TL_user:
+		return x.Id
+	case TL_userEmpty:
+		return x.Id
+	case TL_chat:
+		return x.Id
+	case TL_chatForbidden:
+		return x.Id
+	case TL_chatEmpty:
+		return x.Id
+	case TL_channel:
+		return x.Id
+	case TL_channelForbidden:
+		return x.Id
+	}
+
+	return 0
+}
+
+// inputPeer makes InputPeer for user, chat or channel. Access hash of min constructors is taken as is,
+// it's enough for offsets of dialogs which only compare ids.
+func inputPeer(entity TL) (InputPeer, error) {
+	switch x := entity.(type) {
+	case TL_user:
+		if x.Self {
+			return TL_inputPeerSelf{}, nil
+		}
+		return TL_inputPeerUser{User_id: x.Id, Access_hash: x.Access_hash}, nil
+	case TL_userEmpty:
+		return TL_inputPeerUser{User_id: x.Id}, nil
+	case TL_chat:
+		return TL_inputPeerChat{Chat_id: x.Id}, nil
+	case TL_chatForbidden:
+		return TL_inputPeerChat{Chat_id: x.Id}, nil
+	case TL_channel:
+		return TL_inputPeerChannel{Channel_id: x.Id, Access_hash: x.Access_hash}, nil
+	case TL_channelForbidden:
+		return TL_inputPeerChannel{Channel_id: x.Id, Access_hash: x.Access_hash}, nil
+	}
+
+	return nil, fmt.Errorf("Got: %T", entity)
+}
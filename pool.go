@@ -0,0 +1,204 @@
+package mtproto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// poolConn is connection to data center which may be still being established
+type poolConn struct {
+	done chan struct{} // closed when conn or err is set
+	conn *MTProto
+	err  error
+}
+
+// close disconnects established connection, connection being established is closed by DC
+func (c *poolConn) close() {
+	select {
+	case <-c.done:
+		if c.conn != nil {
+			_ = c.conn.Disconnect()
+		}
+	default:
+	}
+}
+
+// DC returns connection to data center dc. Connections to DCs other than the current one
+// are created on demand, each of them has its own auth key kept in the storage for that DC.
+func (m *MTProto) DC(dc int32) (*MTProto, error) {
+	if dc == m.currentDC() {
+		return m, nil
+	}
+
+	// connection is established without lock, concurrent callers wait for the same one
+	m.poolMutex.Lock()
+	c, ok := m.pool[dc]
+	if ok {
+		m.poolMutex.Unlock()
+		<-c.done
+		return c.conn, c.err
+	}
+	c = &poolConn{done: make(chan struct{})}
+	m.pool[dc] = c
+	m.poolMutex.Unlock()
+
+	c.conn, c.err = m.connectDC(dc)
+
+	m.poolMutex.Lock()
+	switch {
+	case m.pool[dc] != c:
+		// pool was closed or dc became the current one meanwhile
+		if c.conn != nil {
+			_ = c.conn.Disconnect()
+		}
+		c.conn, c.err = nil, errors.New("disconnected")
+	case c.err != nil:
+		// the next call tries again
+		delete(m.pool, dc)
+	}
+	m.poolMutex.Unlock()
+	close(c.done)
+
+	return c.conn, c.err
+}
+
+// connectDC creates connection to data center dc and authorizes it if its auth key is new
+func (m *MTProto) connectDC(dc int32) (*MTProto, error) {
+	address, ok := m.dcAddress(dc)
+	if !ok {
+		return nil, fmt.Errorf("wrong DC index: %d", dc)
+	}
+
+	// auth key for this DC is going to be created, so it isn't authorized yet
+	data, err := m.storageForDC(dc).Load()
+	if err != nil {
+		return nil, err
+	}
+	fresh := len(data) == 0
+
+	conn, err := NewMTProto(m.id, m.hash,
+		WithVersion(m.version),
+		WithDevice(m.device),
+		WithSystem(m.system),
+		WithLanguage(m.language),
+		WithServer(address, m.IPv6),
+		WithSessionStorage(m.storageForDC(dc), false),
+		WithDCStorage(m.storageForDC),
+		WithDropAnswer(m.dropAnswer),
+		WithMTProto2(m.mtproto2),
+		WithFloodWait(m.floodWait),
+		WithReconnect(m.reconnectMin, m.reconnectMax, m.reconnectAttempts),
+		// rate limits and known entities are per account, not per connection
+		withShared(m),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = conn.Connect(); err != nil {
+		_ = conn.Disconnect()
+		return nil, err
+	}
+
+	if fresh {
+		// user may be not authorized yet, then connection stays unauthorized
+		if err = m.authorizeDC(context.Background(), conn, dc); err != nil && !isUnauthorized(err) {
+			_ = conn.Disconnect()
+			return nil, err
+		}
+	}
+
+	return conn, nil
+}
+
+// InvokeOnDC sends request to data center dc.
+// If connection to dc isn't authorized, current authorization is exported to it and request is sent again.
+func (m *MTProto) InvokeOnDC(ctx context.Context, dc int32, msg TL) (*TL, error) {
+	conn, err := m.DC(dc)
+	if err != nil {
+		return nil, err
+	}
+
+	tl, err := conn.InvokeContext(ctx, msg)
+	if conn == m || !isUnauthorized(err) {
+		return tl, err
+	}
+
+	if err = m.authorizeDC(ctx, conn, dc); err != nil {
+		return nil, err
+	}
+
+	return conn.InvokeContext(ctx, msg)
+}
+
+// authorizeDC exports authorization of the current connection and imports it to connection with data center dc
+func (m *MTProto) authorizeDC(ctx context.Context, conn *MTProto, dc int32) error {
+	tl, err := m.InvokeContext(ctx, TL_auth_exportAuthorization{Dc_id: dc})
+	if err != nil {
+		return err
+	}
+	exported, ok := (*tl).(TL_auth_exportedAuthorization)
+	if !ok {
+		return fmt.Errorf("Got: %T", *tl)
+	}
+
+	tl, err = conn.InvokeContext(ctx, TL_auth_importAuthorization{
+		Id:    exported.Id,
+		Bytes: exported.Bytes,
+	})
+	if err != nil {
+		return err
+	}
+	if _, ok := (*tl).(TL_auth_authorization); !ok {
+		return fmt.Errorf("Got: %T", *tl)
+	}
+
+	return nil
+}
+
+func isUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// closePool disconnects from all data centers except the current one
+func (m *MTProto) closePool() {
+	m.poolMutex.Lock()
+	pool := m.pool
+	m.pool = make(map[int32]*poolConn)
+	m.poolMutex.Unlock()
+
+	for _, c := range pool {
+		c.close()
+	}
+}
+
+// storageForDC returns session storage for data center dc
+func (m *MTProto) storageForDC(dc int32) ISessionStorage {
+	m.storagesMutex.Lock()
+	defer m.storagesMutex.Unlock()
+
+	if storage, ok := m.dcStorages[dc]; ok {
+		return storage
+	}
+
+	storage := m.dcStorage(dc)
+	m.dcStorages[dc] = storage
+
+	return storage
+}
+
+func (m *MTProto) currentDC() int32 {
+	m.stateMutex.Lock()
+	defer m.stateMutex.Unlock()
+
+	return m.dc
+}
+
+func (m *MTProto) dcAddress(dc int32) (string, bool) {
+	m.stateMutex.Lock()
+	defer m.stateMutex.Unlock()
+
+	address, ok := m.dclist[dc]
+
+	return address, ok
+}
@@ -2,7 +2,6 @@ package mtproto
 
 import (
 	"errors"
-	"os"
 	"math/rand"
 	"time"
 )
@@ -35,8 +34,7 @@ type ISession interface {
 }
 
 type Session struct {
-	// TODO: ReaderWriter interface
-	file *os.File
+	storage ISessionStorage
 
 	address     string
 	authKey     []byte
@@ -47,9 +45,9 @@ type Session struct {
 	encrypted   bool
 }
 
-func NewSession(file *os.File) ISession {
+func NewSession(storage ISessionStorage) ISession {
 	session := &Session{
-		file: file,
+		storage: storage,
 	}
 
 	rand.Seed(time.Now().UnixNano())
@@ -58,12 +56,17 @@ func NewSession(file *os.File) ISession {
 	return session
 }
 
+// ErrNoSession is returned by Session.Load when storage has no saved session yet
+var ErrNoSession = errors.New("no saved session")
+
+// Load reads session from storage, errors other than ErrNoSession mean the saved session can't be used now
 func (s *Session) Load() error {
-	// TODO: Magic number
-	buffer := make([]byte, 1024*4)
-	n, _ := s.file.ReadAt(buffer, 0)
-	if n <= 0 {
-		return errors.New("New session")
+	buffer, err := s.storage.Load()
+	if err != nil {
+		return err
+	}
+	if len(buffer) == 0 {
+		return ErrNoSession
 	}
 
 	decoder := NewDecodeBuf(buffer)
@@ -97,17 +100,7 @@ func (s Session) Save() error {
 	}
 	buffer.UInt(useIPv6UInt)
 
-	err := s.file.Truncate(0)
-	if err != nil {
-		return err
-	}
-
-	_, err = s.file.WriteAt(buffer.buf, 0)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return s.storage.Save(buffer.buf)
 }
 
 func (s Session) IsIPv6() bool {
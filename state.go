@@ -0,0 +1,615 @@
+package mtproto
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"time"
+)
+
+// How long an out of order update waits for the missing ones before getDifference is called
+const updatesGapTimeout = 500 * time.Millisecond
+
+// Number of messages requested by a single updates.getChannelDifference call
+const channelDifferenceLimit = 100
+
+// UpdatesState is a local copy of the server updates sequence
+type UpdatesState struct {
+	Pts  int32
+	Qts  int32
+	Seq  int32
+	Date int32
+
+	// Channels maps channel id to its own pts sequence
+	Channels map[int32]ChannelState
+}
+
+type ChannelState struct {
+	Pts        int32
+	AccessHash int64
+}
+
+// Updates state storage interface
+type IUpdatesStorage interface {
+	// LoadUpdatesState returns nil state if nothing was saved yet
+	LoadUpdatesState() (*UpdatesState, error)
+	SaveUpdatesState(state *UpdatesState) error
+}
+
+// UpdatesFileStorage keeps updates state in a file
+type UpdatesFileStorage struct {
+	path string
+}
+
+func NewUpdatesFileStorage(path string) IUpdatesStorage {
+	return &UpdatesFileStorage{path: path}
+}
+
+func (s *UpdatesFileStorage) LoadUpdatesState() (*UpdatesState, error) {
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) || (err == nil && len(data) == 0) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := NewDecodeBuf(data)
+	state := &UpdatesState{
+		Pts:      decoder.Int(),
+		Qts:      decoder.Int(),
+		Seq:      decoder.Int(),
+		Date:     decoder.Int(),
+		Channels: make(map[int32]ChannelState),
+	}
+	count := decoder.Int()
+	for i := int32(0); i < count && decoder.err == nil; i++ {
+		id := decoder.Int()
+		state.Channels[id] = ChannelState{
+			Pts:        decoder.Int(),
+			AccessHash: decoder.Long(),
+		}
+	}
+
+	if decoder.err != nil {
+		return nil, decoder.err
+	}
+
+	return state, nil
+}
+
+func (s *UpdatesFileStorage) SaveUpdatesState(state *UpdatesState) error {
+	buffer := NewEncodeBuf(20 + len(state.Channels)*16)
+	buffer.Int(state.Pts)
+	buffer.Int(state.Qts)
+	buffer.Int(state.Seq)
+	buffer.Int(state.Date)
+	buffer.Int(int32(len(state.Channels)))
+	for id, channel := range state.Channels {
+		buffer.Int(id)
+		buffer.Int(channel.Pts)
+		buffer.Long(channel.AccessHash)
+	}
+
+	return ioutil.WriteFile(s.path, buffer.buf, 0600)
+}
+
+// Result of the sequence check
+const (
+	updateApply = iota
+	updateSkip
+	updateGap
+)
+
+// Sequence an update belongs to
+const (
+	sequenceNone = iota
+	sequenceCommon
+	sequenceChannel
+	sequenceSecret
+)
+
+// updatesSync asks updates routine to synchronize state with the server
+type updatesSync struct {
+	result chan error
+}
+
+// updatesManager applies updates in order, holds back updates which came too early
+// and fills gaps with updates.getDifference and updates.getChannelDifference.
+// All methods are called from updates routine only.
+type updatesManager struct {
+	m       *MTProto
+	storage IUpdatesStorage
+	state   *UpdatesState
+	// invoke sends getState and getDifference requests
+	invoke func(msg TL) (*TL, error)
+
+	pending []TL
+	timeout <-chan time.Time
+}
+
+func newUpdatesManager(m *MTProto, storage IUpdatesStorage) *updatesManager {
+	return &updatesManager{
+		m:       m,
+		storage: storage,
+		invoke:  m.InvokeSync,
+	}
+}
+
+// SyncUpdates loads saved updates state and fetches everything missed since then.
+// If there is no saved state, current state is requested from server.
+// Must be called after authorization, requires WithUpdatesState option.
+func (m *MTProto) SyncUpdates() error {
+	if m.updatesManager == nil {
+		return errors.New("updates state isn't enabled")
+	}
+
+	m.updatesMutex.Lock()
+	stop := m.updatesStop
+	m.updatesMutex.Unlock()
+	if stop == nil {
+		return errors.New("disconnected")
+	}
+
+	request := updatesSync{make(chan error, 1)}
+	m.queueUpdate(request)
+	select {
+	case err := <-request.result:
+		return err
+	case <-stop:
+		return errors.New("disconnected")
+	}
+}
+
+func (u *updatesManager) sync() error {
+	if u.state == nil && u.storage != nil {
+		state, err := u.storage.LoadUpdatesState()
+		if err != nil {
+			return err
+		}
+		// custom storages may return state without channels
+		if state != nil && state.Channels == nil {
+			state.Channels = make(map[int32]ChannelState)
+		}
+		u.state = state
+	}
+
+	if u.state == nil {
+		tl, err := u.invoke(TL_updates_getState{})
+		if err != nil {
+			return err
+		}
+		state, ok := (*tl).(TL_updates_state)
+		if !ok {
+			return fmt.Errorf("Got: %T", *tl)
+		}
+		u.state = &UpdatesState{Channels: make(map[int32]ChannelState)}
+		u.setState(state)
+		return u.save()
+	}
+
+	return u.getDifference()
+}
+
+// handle checks update against local state and delivers everything which can be applied
+func (u *updatesManager) handle(update TL) {
+	// state is unknown until sync, pass updates as is
+	if u.state == nil {
+		u.deliver(update)
+		return
+	}
+
+	if _, ok := update.(TL_updatesTooLong); ok {
+		u.recover()
+		return
+	}
+
+	switch u.apply(update) {
+	case updateGap:
+		u.pending = append(u.pending, update)
+		if u.timeout == nil {
+			u.timeout = time.After(updatesGapTimeout)
+		}
+		return
+	case updateSkip:
+		return
+	}
+
+	// gap could be filled by this update
+	for len(u.pending) != 0 {
+		applied := false
+		rest := u.pending[:0]
+		for _, v := range u.pending {
+			switch u.apply(v) {
+			case updateGap:
+				rest = append(rest, v)
+			case updateApply:
+				applied = true
+			}
+		}
+		u.pending = rest
+		if !applied {
+			break
+		}
+	}
+	if len(u.pending) == 0 {
+		u.timeout = nil
+	}
+}
+
+// gapTimeout is called when pending updates waited too long for the missing ones
+func (u *updatesManager) gapTimeout() {
+	u.timeout = nil
+	if len(u.pending) != 0 {
+		u.recover()
+	}
+}
+
+func (u *updatesManager) recover() {
+	u.pending = nil
+	u.timeout = nil
+	if err := u.getDifference(); err != nil {
+		log.Println("UpdatesRoutine:", err)
+	}
+}
+
+// apply delivers update if it's the next one in sequence
+func (u *updatesManager) apply(update TL) int {
+	var updates []TL
+	var seqStart, seq, date int32
+
+	switch x := update.(type) {
+	case TL_updates:
+		updates = updateList(x.Updates)
+		seqStart, seq, date = x.Seq, x.Seq, x.Date
+		u.rememberChats(x.Chats)
+	case TL_updatesCombined:
+		updates = updateList(x.Updates)
+		seqStart, seq, date = x.Seq_start, x.Seq, x.Date
+		u.rememberChats(x.Chats)
+	case TL_updateShort:
+		updates = []TL{x.Update}
+		date = x.Date
+	default:
+		updates = []TL{update}
+	}
+
+	if seq != 0 {
+		if u.state.Seq+1 > seqStart {
+			return updateSkip
+		}
+		if u.state.Seq+1 < seqStart {
+			return updateGap
+		}
+	}
+
+	// check all updates before changing state
+	pts := u.state.Pts
+	qts := u.state.Qts
+	channels := make(map[int32]int32)
+	accepted := make([]TL, 0, len(updates))
+	var tooLong []int32
+	for _, v := range updates {
+		kind, channelId, updatePts, count := updateSequence(v)
+		switch kind {
+		case sequenceCommon:
+			switch checkSequence(pts, updatePts, count) {
+			case updateGap:
+				return updateGap
+			case updateSkip:
+				continue
+			}
+			pts = updatePts
+		case sequenceSecret:
+			switch checkSequence(qts, updatePts, count) {
+			case updateGap:
+				return updateGap
+			case updateSkip:
+				continue
+			}
+			qts = updatePts
+		case sequenceChannel:
+			local, ok := channels[channelId]
+			if !ok {
+				local = u.state.Channels[channelId].Pts
+			}
+			if local != 0 {
+				switch checkSequence(local, updatePts, count) {
+				case updateGap:
+					// without access hash the gap can't be filled, channel goes on from this update
+					if u.state.Channels[channelId].AccessHash != 0 {
+						tooLong = append(tooLong, channelId)
+						continue
+					}
+				case updateSkip:
+					continue
+				}
+			}
+			channels[channelId] = updatePts
+		}
+
+		if x, ok := v.(TL_updateChannelTooLong); ok {
+			tooLong = append(tooLong, x.Channel_id)
+		}
+		accepted = append(accepted, v)
+	}
+
+	u.state.Pts = pts
+	u.state.Qts = qts
+	for id, channelPts := range channels {
+		channel := u.state.Channels[id]
+		channel.Pts = channelPts
+		u.state.Channels[id] = channel
+	}
+	if seq != 0 {
+		u.state.Seq = seq
+	}
+	if date != 0 {
+		u.state.Date = date
+	}
+
+	if len(accepted) != 0 {
+		switch x := update.(type) {
+		case TL_updates:
+			x.Updates = updateSlice(accepted)
+			u.deliver(x)
+		case TL_updatesCombined:
+			x.Updates = updateSlice(accepted)
+			u.deliver(x)
+		default:
+			u.deliver(update)
+		}
+	}
+
+	for _, id := range tooLong {
+		if err := u.getChannelDifference(id); err != nil {
+			log.Println("UpdatesRoutine:", err)
+		}
+	}
+
+	if err := u.save(); err != nil {
+		log.Println("UpdatesRoutine:", err)
+	}
+
+	return updateApply
+}
+
+func (u *updatesManager) getDifference() error {
+	for {
+		tl, err := u.invoke(TL_updates_getDifference{
+			Pts:  u.state.Pts,
+			Date: u.state.Date,
+			Qts:  u.state.Qts,
+		})
+		if err != nil {
+			return err
+		}
+
+		switch x := (*tl).(type) {
+		case TL_updates_differenceEmpty:
+			u.state.Date = x.Date
+			u.state.Seq = x.Seq
+			return u.save()
+		case TL_updates_difference:
+			u.deliverDifference(x.New_messages, x.New_encrypted_messages, x.Other_updates, x.Users, x.Chats)
+			if state, ok := x.State.(TL_updates_state); ok {
+				u.setState(state)
+			}
+			return u.save()
+		case TL_updates_differenceSlice:
+			u.deliverDifference(x.New_messages, x.New_encrypted_messages, x.Other_updates, x.Users, x.Chats)
+			if state, ok := x.Intermediate_state.(TL_updates_state); ok {
+				u.setState(state)
+			}
+			if err := u.save(); err != nil {
+				return err
+			}
+		case TL_updates_differenceTooLong:
+			u.state.Pts = x.Pts
+		default:
+			return fmt.Errorf("Got: %T", x)
+		}
+	}
+}
+
+func (u *updatesManager) getChannelDifference(channelId int32) error {
+	// nothing to start from, channel updates will be applied as they come
+	channel, ok := u.state.Channels[channelId]
+	if !ok || channel.Pts == 0 || channel.AccessHash == 0 {
+		return nil
+	}
+
+	for {
+		tl, err := u.invoke(TL_updates_getChannelDifference{
+			Channel: TL_inputChannel{Channel_id: channelId, Access_hash: channel.AccessHash},
+			Filter:  TL_channelMessagesFilterEmpty{},
+			Pts:     channel.Pts,
+			Limit:   channelDifferenceLimit,
+		})
+		if err != nil {
+			return err
+		}
+
+		var final bool
+		switch x := (*tl).(type) {
+		case TL_updates_channelDifferenceEmpty:
+			channel.Pts, final = x.Pts, x.Final
+		case TL_updates_channelDifference:
+			u.rememberChats(x.Chats)
+			updates := make([]Update, 0, len(x.New_messages)+len(x.Other_updates))
+			for _, message := range x.New_messages {
+				updates = append(updates, TL_updateNewChannelMessage{Message: message})
+			}
+			updates = append(updates, x.Other_updates...)
+			u.deliver(TL_updates{Updates: updates, Users: x.Users, Chats: x.Chats, Date: u.state.Date})
+			channel.Pts, final = x.Pts, x.Final
+		case TL_updates_channelDifferenceTooLong:
+			u.rememberChats(x.Chats)
+			updates := make([]Update, 0, len(x.Messages))
+			for _, message := range x.Messages {
+				updates = append(updates, TL_updateNewChannelMessage{Message: message})
+			}
+			u.deliver(TL_updates{Updates: updates, Users: x.Users, Chats: x.Chats, Date: u.state.Date})
+			channel.Pts, final = x.Pts, x.Final
+		default:
+			return fmt.Errorf("Got: %T", x)
+		}
+
+		channel.AccessHash = u.state.Channels[channelId].AccessHash
+		u.state.Channels[channelId] = channel
+		if err := u.save(); err != nil {
+			return err
+		}
+		if final {
+			return nil
+		}
+	}
+}
+
+// deliverDifference passes missed updates to handlers as a single TL_updates
+func (u *updatesManager) deliverDifference(messages []Message, encrypted []EncryptedMessage, other []Update, users []User, chats []Chat) {
+	u.rememberChats(chats)
+
+	updates := make([]Update, 0, len(messages)+len(encrypted)+len(other))
+	for _, message := range messages {
+		updates = append(updates, TL_updateNewMessage{Message: message})
+	}
+	for _, message := range encrypted {
+		updates = append(updates, TL_updateNewEncryptedMessage{Message: message})
+	}
+	var tooLong []int32
+	for _, v := range other {
+		if x, ok := v.(TL_updateChannelTooLong); ok {
+			tooLong = append(tooLong, x.Channel_id)
+			continue
+		}
+		updates = append(updates, v)
+	}
+
+	u.deliver(TL_updates{Updates: updates, Users: users, Chats: chats, Date: u.state.Date})
+
+	for _, id := range tooLong {
+		if err := u.getChannelDifference(id); err != nil {
+			log.Println("UpdatesRoutine:", err)
+		}
+	}
+}
+
+// updateList converts updates of TL_updates to the list which may hold short updates as well
+func updateList(updates []Update) []TL {
+	list := make([]TL, len(updates))
+	for i, v := range updates {
+		list[i] = v
+	}
+
+	return list
+}
+
+// updateSlice is the reverse of updateList, it's used only for updates taken from TL_updates
+func updateSlice(list []TL) []Update {
+	updates := make([]Update, 0, len(list))
+	for _, v := range list {
+		if x, ok := v.(Update); ok {
+			updates = append(updates, x)
+		}
+	}
+
+	return updates
+}
+
+func (u *updatesManager) deliver(update TL) {
+	u.m.deliverUpdate(update)
+}
+
+// rememberChats keeps channels access hashes, they are needed for updates.getChannelDifference
+func (u *updatesManager) rememberChats(chats []Chat) {
+	for _, v := range chats {
+		if x, ok := v.(TL_channel); ok && !x.Min {
+			channel := u.state.Channels[x.Id]
+			channel.AccessHash = x.Access_hash
+			u.state.Channels[x.Id] = channel
+		}
+	}
+}
+
+func (u *updatesManager) setState(state TL_updates_state) {
+	u.state.Pts = state.Pts
+	u.state.Qts = state.Qts
+	u.state.Seq = state.Seq
+	u.state.Date = state.Date
+}
+
+func (u *updatesManager) save() error {
+	if u.storage == nil {
+		return nil
+	}
+
+	return u.storage.SaveUpdatesState(u.state)
+}
+
+func checkSequence(local, pts, count int32) int {
+	switch {
+	case local+count == pts:
+		return updateApply
+	case local+count > pts:
+		return updateSkip
+	default:
+		return updateGap
+	}
+}
+
+// updateSequence returns sequence of the update, channel id for channel updates, new pts (qts) and pts_count
+func updateSequence(update TL) (kind int, channelId, pts, count int32) {
+	switch x := update.(type) {
+	case TL_updateNewMessage:
+		return sequenceCommon, 0, x.Pts, x.Pts_count
+	case TL_updateDeleteMessages:
+		return sequenceCommon, 0, x.Pts, x.Pts_count
+	case TL_updateReadHistoryInbox:
+		return sequenceCommon, 0, x.Pts, x.Pts_count
+	case TL_updateReadHistoryOutbox:
+		return sequenceCommon, 0, x.Pts, x.Pts_count
+	case TL_updateWebPage:
+		return sequenceCommon, 0, x.Pts, x.Pts_count
+	case TL_updateReadMessagesContents:
+		return sequenceCommon, 0, x.Pts, x.Pts_count
+	case TL_updateEditMessage:
+		return sequenceCommon, 0, x.Pts, x.Pts_count
+	case TL_updateShortMessage:
+		return sequenceCommon, 0, x.Pts, x.Pts_count
+	case TL_updateShortChatMessage:
+		return sequenceCommon, 0, x.Pts, x.Pts_count
+	case TL_updateShortSentMessage:
+		return sequenceCommon, 0, x.Pts, x.Pts_count
+	case TL_updateNewEncryptedMessage:
+		return sequenceSecret, 0, x.Qts, 1
+	case TL_updateNewChannelMessage:
+		return sequenceChannel, messageChannelId(x.Message), x.Pts, x.Pts_count
+	case TL_updateEditChannelMessage:
+		return sequenceChannel, messageChannelId(x.Message), x.Pts, x.Pts_count
+	case TL_updateDeleteChannelMessages:
+		return sequenceChannel, x.Channel_id, x.Pts, x.Pts_count
+	case TL_updateChannelWebPage:
+		return sequenceChannel, x.Channel_id, x.Pts, x.Pts_count
+	}
+
+	return sequenceNone, 0, 0, 0
+}
+
+func messageChannelId(message TL) int32 {
+	var peer TL
+	switch x := message.(type) {
+	case TL_message:
+		peer = x.To_id
+	case TL_messageService:
+		peer = x.To_id
+	}
+
+	if channel, ok := peer.(TL_peerChannel); ok {
+		return channel.Channel_id
+	}
+
+	return 0
+}
@@ -0,0 +1,340 @@
+package mtproto
+
+import (
+	"errors"
+	"testing"
+)
+
+// memoryUpdatesStorage keeps updates state in memory
+type memoryUpdatesStorage struct {
+	state *UpdatesState
+}
+
+func (s *memoryUpdatesStorage) LoadUpdatesState() (*UpdatesState, error) {
+	return s.state, nil
+}
+
+func (s *memoryUpdatesStorage) SaveUpdatesState(state *UpdatesState) error {
+	s.state = state
+	return nil
+}
+
+// testUpdates is updates manager which answers requests by responses and collects delivered updates
+type testUpdates struct {
+	*updatesManager
+	requests  []TL
+	responses []TL
+	delivered []TL
+}
+
+func newTestUpdates(state *UpdatesState, responses ...TL) *testUpdates {
+	m := new(MTProto)
+	t := &testUpdates{
+		updatesManager: newUpdatesManager(m, &memoryUpdatesStorage{state: state}),
+		responses:      responses,
+	}
+	t.invoke = func(msg TL) (*TL, error) {
+		t.requests = append(t.requests, msg)
+		if len(t.responses) == 0 {
+			return nil, errors.New("no response")
+		}
+		response := t.responses[0]
+		t.responses = t.responses[1:]
+		return &response, nil
+	}
+	m.AddUpdateHandler(func(update TL) {
+		t.delivered = append(t.delivered, update)
+	})
+
+	return t
+}
+
+func testState() *UpdatesState {
+	return &UpdatesState{
+		Pts:  10,
+		Qts:  5,
+		Seq:  3,
+		Date: 1000,
+		Channels: map[int32]ChannelState{
+			100: {Pts: 20, AccessHash: 1},
+			200: {Pts: 30},
+		},
+	}
+}
+
+func channelMessage(channelId, pts int32) TL_updateNewChannelMessage {
+	return TL_updateNewChannelMessage{
+		Message:   TL_message{To_id: TL_peerChannel{Channel_id: channelId}},
+		Pts:       pts,
+		Pts_count: 1,
+	}
+}
+
+func TestCheckSequence(t *testing.T) {
+	tests := []struct {
+		local, pts, count int32
+		want              int
+	}{
+		{10, 11, 1, updateApply},
+		{10, 12, 2, updateApply},
+		{10, 10, 0, updateApply},
+		{10, 10, 1, updateSkip},
+		{10, 9, 1, updateSkip},
+		{10, 12, 1, updateGap},
+	}
+
+	for _, test := range tests {
+		if got := checkSequence(test.local, test.pts, test.count); got != test.want {
+			t.Errorf("checkSequence(%d, %d, %d) = %d, want %d", test.local, test.pts, test.count, got, test.want)
+		}
+	}
+}
+
+func TestUpdatesApply(t *testing.T) {
+	tests := []struct {
+		name      string
+		update    TL
+		responses []TL
+		want      int
+		pts       int32
+		qts       int32
+		seq       int32
+		channels  map[int32]int32
+		delivered int
+	}{
+		{
+			name:      "pts apply",
+			update:    TL_updateShortMessage{Pts: 11, Pts_count: 1},
+			want:      updateApply,
+			pts:       11,
+			qts:       5,
+			seq:       3,
+			delivered: 1,
+		},
+		{
+			// nothing is delivered but sequence is fine
+			name:   "pts skip",
+			update: TL_updateShortMessage{Pts: 10, Pts_count: 1},
+			want:   updateApply,
+			pts:    10,
+			qts:    5,
+			seq:    3,
+		},
+		{
+			name:   "pts gap",
+			update: TL_updateShortMessage{Pts: 13, Pts_count: 1},
+			want:   updateGap,
+			pts:    10,
+			qts:    5,
+			seq:    3,
+		},
+		{
+			name:      "qts apply",
+			update:    TL_updates{Updates: []Update{TL_updateNewEncryptedMessage{Qts: 6}}},
+			want:      updateApply,
+			pts:       10,
+			qts:       6,
+			seq:       3,
+			delivered: 1,
+		},
+		{
+			name:   "qts gap",
+			update: TL_updates{Updates: []Update{TL_updateNewEncryptedMessage{Qts: 8}}},
+			want:   updateGap,
+			pts:    10,
+			qts:    5,
+			seq:    3,
+		},
+		{
+			name:   "seq apply",
+			update: TL_updates{Seq: 4, Date: 1001},
+			want:   updateApply,
+			pts:    10,
+			qts:    5,
+			seq:    4,
+		},
+		{
+			name:   "seq skip",
+			update: TL_updates{Seq: 3},
+			want:   updateSkip,
+			pts:    10,
+			qts:    5,
+			seq:    3,
+		},
+		{
+			name:   "seq gap",
+			update: TL_updates{Seq: 5},
+			want:   updateGap,
+			pts:    10,
+			qts:    5,
+			seq:    3,
+		},
+		{
+			name:   "combined seq apply",
+			update: TL_updatesCombined{Seq_start: 4, Seq: 5},
+			want:   updateApply,
+			pts:    10,
+			qts:    5,
+			seq:    5,
+		},
+		{
+			name:      "channel apply",
+			update:    TL_updates{Updates: []Update{channelMessage(100, 21)}},
+			want:      updateApply,
+			pts:       10,
+			qts:       5,
+			seq:       3,
+			channels:  map[int32]int32{100: 21, 200: 30},
+			delivered: 1,
+		},
+		{
+			name:     "channel skip",
+			update:   TL_updates{Updates: []Update{channelMessage(100, 20)}},
+			want:     updateApply,
+			pts:      10,
+			qts:      5,
+			seq:      3,
+			channels: map[int32]int32{100: 20, 200: 30},
+		},
+		{
+			name:      "channel gap is filled by difference",
+			update:    TL_updates{Updates: []Update{channelMessage(100, 23)}},
+			responses: []TL{TL_updates_channelDifferenceEmpty{Final: true, Pts: 25}},
+			want:      updateApply,
+			pts:       10,
+			qts:       5,
+			seq:       3,
+			channels:  map[int32]int32{100: 25, 200: 30},
+		},
+		{
+			name:      "channel gap without access hash",
+			update:    TL_updates{Updates: []Update{channelMessage(200, 35)}},
+			want:      updateApply,
+			pts:       10,
+			qts:       5,
+			seq:       3,
+			channels:  map[int32]int32{100: 20, 200: 35},
+			delivered: 1,
+		},
+		{
+			name:      "unknown channel",
+			update:    TL_updates{Updates: []Update{channelMessage(300, 7)}},
+			want:      updateApply,
+			pts:       10,
+			qts:       5,
+			seq:       3,
+			channels:  map[int32]int32{100: 20, 200: 30, 300: 7},
+			delivered: 1,
+		},
+	}
+
+	for _, test := range tests {
+		u := newTestUpdates(testState(), test.responses...)
+		u.state = testState()
+
+		if got := u.apply(test.update); got != test.want {
+			t.Errorf("%s: apply = %d, want %d", test.name, got, test.want)
+		}
+		if u.state.Pts != test.pts || u.state.Qts != test.qts || u.state.Seq != test.seq {
+			t.Errorf("%s: pts %d, qts %d, seq %d, want %d, %d, %d",
+				test.name, u.state.Pts, u.state.Qts, u.state.Seq, test.pts, test.qts, test.seq)
+		}
+		if test.channels == nil {
+			test.channels = map[int32]int32{100: 20, 200: 30}
+		}
+		for id, pts := range test.channels {
+			if got := u.state.Channels[id].Pts; got != pts {
+				t.Errorf("%s: pts of channel %d is %d, want %d", test.name, id, got, pts)
+			}
+		}
+		if len(u.delivered) != test.delivered {
+			t.Errorf("%s: %d updates delivered, want %d", test.name, len(u.delivered), test.delivered)
+		}
+		if len(u.responses) != 0 {
+			t.Errorf("%s: %d requests weren't sent", test.name, len(u.responses))
+		}
+	}
+}
+
+func TestUpdatesPendingReplay(t *testing.T) {
+	u := newTestUpdates(nil)
+	u.state = testState()
+
+	u.handle(TL_updateShortMessage{Id: 3, Pts: 13, Pts_count: 1})
+	u.handle(TL_updateShortMessage{Id: 2, Pts: 12, Pts_count: 1})
+	if len(u.delivered) != 0 || len(u.pending) != 2 || u.timeout == nil {
+		t.Fatalf("updates after gap: %d delivered, %d pending", len(u.delivered), len(u.pending))
+	}
+
+	// the missing update fills the gap, pending ones follow it in order
+	u.handle(TL_updateShortMessage{Id: 1, Pts: 11, Pts_count: 1})
+	if len(u.pending) != 0 || u.timeout != nil {
+		t.Errorf("%d updates are still pending", len(u.pending))
+	}
+	if u.state.Pts != 13 {
+		t.Errorf("pts %d, want 13", u.state.Pts)
+	}
+	if len(u.delivered) != 3 {
+		t.Fatalf("%d updates delivered, want 3", len(u.delivered))
+	}
+	for i, update := range u.delivered {
+		if id := update.(TL_updateShortMessage).Id; id != int32(i+1) {
+			t.Errorf("update %d delivered at position %d", id, i)
+		}
+	}
+}
+
+func TestUpdatesGapTimeout(t *testing.T) {
+	u := newTestUpdates(nil,
+		TL_updates_differenceSlice{
+			New_messages:       []Message{TL_message{Id: 1}},
+			Intermediate_state: TL_updates_state{Pts: 11, Qts: 5, Seq: 3, Date: 1001},
+		},
+		TL_updates_difference{
+			New_messages: []Message{TL_message{Id: 2}},
+			State:        TL_updates_state{Pts: 13, Qts: 5, Seq: 4, Date: 1002},
+		},
+	)
+	u.state = testState()
+
+	u.handle(TL_updateShortMessage{Pts: 13, Pts_count: 1})
+	u.gapTimeout()
+
+	if len(u.pending) != 0 || u.timeout != nil {
+		t.Errorf("%d updates are still pending", len(u.pending))
+	}
+	if len(u.requests) != 2 {
+		t.Fatalf("%d requests sent, want 2", len(u.requests))
+	}
+	if request := u.requests[0].(TL_updates_getDifference); request.Pts != 10 || request.Qts != 5 || request.Date != 1000 {
+		t.Errorf("first request %+v", request)
+	}
+	if request := u.requests[1].(TL_updates_getDifference); request.Pts != 11 {
+		t.Errorf("second request %+v", request)
+	}
+	if u.state.Pts != 13 || u.state.Seq != 4 || u.state.Date != 1002 {
+		t.Errorf("state %+v", *u.state)
+	}
+	if len(u.delivered) != 2 {
+		t.Errorf("%d updates delivered, want 2", len(u.delivered))
+	}
+}
+
+func TestUpdatesSyncWithoutChannels(t *testing.T) {
+	u := newTestUpdates(&UpdatesState{Pts: 10, Qts: 5, Seq: 3, Date: 1000},
+		TL_updates_differenceEmpty{Date: 1001, Seq: 3},
+	)
+
+	if err := u.sync(); err != nil {
+		t.Fatal(err)
+	}
+	u.handle(TL_updates{
+		Updates: []Update{channelMessage(100, 21)},
+		Chats:   []Chat{TL_channel{Id: 100, Access_hash: 7}},
+		Seq:     4,
+	})
+
+	if channel := u.state.Channels[100]; channel.Pts != 21 || channel.AccessHash != 7 {
+		t.Errorf("channel %+v", channel)
+	}
+}
@@ -0,0 +1,67 @@
+package mtproto
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+// Session persistence backend
+type ISessionStorage interface {
+	// Load returns empty data if nothing was saved yet
+	Load() ([]byte, error)
+	// Save replaces previously saved data
+	Save(data []byte) error
+}
+
+// MemoryStorage keeps session in memory only
+type MemoryStorage struct {
+	mutex sync.Mutex
+	data  []byte
+}
+
+func NewMemoryStorage() ISessionStorage {
+	return &MemoryStorage{}
+}
+
+func (s *MemoryStorage) Load() ([]byte, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	data := make([]byte, len(s.data))
+	copy(data, s.data)
+
+	return data, nil
+}
+
+func (s *MemoryStorage) Save(data []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.data = make([]byte, len(data))
+	copy(s.data, data)
+
+	return nil
+}
+
+// FileStorage keeps session in a file
+type FileStorage struct {
+	path string
+}
+
+func NewFileStorage(path string) ISessionStorage {
+	return &FileStorage{path: path}
+}
+
+func (s *FileStorage) Load() ([]byte, error) {
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	return data, err
+}
+
+func (s *FileStorage) Save(data []byte) error {
+	return ioutil.WriteFile(s.path, data, 0600)
+}
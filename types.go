@@ -8,6 +8,12 @@ type TL interface {
 
 const crc_vector = 0x1cb5c415 // Processed manually
 
+// TL_vector is Vector<T> result of function, it can't be decoded without knowing T.
+// Data holds the rest of message starting with crc_vector, elements are decoded by Client.
+type TL_vector struct {
+	Data []byte
+}
+
 const crc_msg_container = 0x73f1f8dc
 
 type TL_msg_container struct {
@@ -151,6 +157,30 @@ func (err TL_rpc_error) Error() string {
 	return err.Error_message
 }
 
+const crc_rpc_drop_answer = 0x58e4a740
+
+type TL_rpc_drop_answer struct {
+	Req_msg_id int64
+}
+
+const crc_rpc_answer_unknown = 0x5e2ad36e
+
+type TL_rpc_answer_unknown struct {
+}
+
+const crc_rpc_answer_dropped_running = 0xcd78e586
+
+type TL_rpc_answer_dropped_running struct {
+}
+
+const crc_rpc_answer_dropped = 0xa43ad8b7
+
+type TL_rpc_answer_dropped struct {
+	Msg_id int64
+	Seq_no int32
+	Bytes  int32
+}
+
 const crc_dh_gen_ok = 0x3bcbf734
 
 type TL_dh_gen_ok struct {
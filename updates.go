@@ -1,5 +1,156 @@
 package mtproto
 
+import "time"
+
+// UpdateHandler receives updates pushed by the server: TL_updates, TL_updatesCombined,
+// TL_updateShort, TL_updateShortMessage, TL_updateShortChatMessage,
+// TL_updateShortSentMessage and TL_updatesTooLong
+type UpdateHandler func(update TL)
+
+// AddUpdateHandler registers handler for incoming updates.
+// Handlers are called one by one from a separate goroutine while responses are still read,
+// so it's safe to call InvokeSync inside them
+func (m *MTProto) AddUpdateHandler(handler UpdateHandler) {
+	m.handlersMutex.Lock()
+	defer m.handlersMutex.Unlock()
+	m.updateHandlers = append(m.updateHandlers, handler)
+}
+
+// isUpdates reports if data is one of the Updates constructors
+func isUpdates(data interface{}) bool {
+	switch data.(type) {
+	case TL_updates, TL_updatesCombined, TL_updateShort, TL_updateShortMessage,
+		TL_updateShortChatMessage, TL_updateShortSentMessage, TL_updatesTooLong:
+		return true
+	}
+
+	return false
+}
+
+func (m *MTProto) dispatchUpdate(data interface{}) {
+	if !isUpdates(data) {
+		return
+	}
+	m.queueUpdate(data)
+}
+
+// queueUpdate passes data to updates routine. The queue isn't limited, so read routine
+// never waits for handlers and keeps reading responses to requests made by them.
+func (m *MTProto) queueUpdate(data interface{}) {
+	m.updatesMutex.Lock()
+	m.updates = append(m.updates, data)
+	m.updatesMutex.Unlock()
+
+	m.signalUpdates()
+}
+
+// signalUpdates wakes up updates routine
+func (m *MTProto) signalUpdates() {
+	select {
+	case m.updatesSignal <- struct{}{}:
+	default:
+	}
+}
+
+// nextUpdate removes and returns the first queued update
+func (m *MTProto) nextUpdate() (interface{}, bool) {
+	m.updatesMutex.Lock()
+	defer m.updatesMutex.Unlock()
+
+	if len(m.updates) == 0 {
+		return nil, false
+	}
+	x := m.updates[0]
+	m.updates[0] = nil
+	m.updates = m.updates[1:]
+
+	return x, true
+}
+
+// startUpdates starts updates routine unless it's running. The routine lives until Disconnect,
+// reconnects don't wait for it as handlers may wait for responses which come after reconnect.
+func (m *MTProto) startUpdates() {
+	m.updatesMutex.Lock()
+	defer m.updatesMutex.Unlock()
+
+	if m.updatesStop != nil {
+		return
+	}
+	m.updatesStop = make(chan struct{})
+	go m.updatesRoutine(m.updatesStop)
+
+	// updates could be queued while there was no routine
+	m.signalUpdates()
+}
+
+// stopUpdates stops updates routine without waiting for it, the caller may be a handler
+func (m *MTProto) stopUpdates() {
+	m.updatesMutex.Lock()
+	defer m.updatesMutex.Unlock()
+
+	if m.updatesStop != nil {
+		close(m.updatesStop)
+		m.updatesStop = nil
+	}
+}
+
+func (m *MTProto) updatesRoutine(stop chan struct{}) {
+	// routine started after Disconnect and Connect waits until the previous one returns
+	m.updatesLock.Lock()
+	defer m.updatesLock.Unlock()
+
+	for {
+		var timeout <-chan time.Time
+		if m.updatesManager != nil {
+			timeout = m.updatesManager.timeout
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-timeout:
+			m.updatesManager.gapTimeout()
+		case <-m.updatesSignal:
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				x, ok := m.nextUpdate()
+				if !ok {
+					break
+				}
+				m.handleUpdate(x)
+			}
+		}
+	}
+}
+
+func (m *MTProto) handleUpdate(x interface{}) {
+	switch x := x.(type) {
+	case updatesSync:
+		x.result <- m.updatesManager.sync()
+	case TL:
+		// entity storage may be slow, so it's used here rather than in read routine
+		m.entities.remember(x)
+		if m.updatesManager != nil {
+			m.updatesManager.handle(x)
+		} else {
+			m.deliverUpdate(x)
+		}
+	}
+}
+
+func (m *MTProto) deliverUpdate(update TL) {
+	m.handlersMutex.RLock()
+	handlers := m.updateHandlers
+	m.handlersMutex.RUnlock()
+	for _, handler := range handlers {
+		handler(update)
+	}
+}
+
 func (m *MTProto) UpdatesGetState() (*TL, error) {
 	return m.InvokeSync(TL_updates_getState{})
 }
@@ -13,7 +164,7 @@ func (m *MTProto) UpdatesGetDifference(pts, ptsTotalLimit, date, qts int32) (*TL
 	})
 }
 
-func (m *MTProto) UpdatesGetChannelDifference(force bool, channel, filter TL, pts, limit int32) (*TL, error) {
+func (m *MTProto) UpdatesGetChannelDifference(force bool, channel InputChannel, filter ChannelMessagesFilter, pts, limit int32) (*TL, error) {
 	return m.InvokeSync(TL_updates_getChannelDifference{
 		Force:   force,
 		Channel: channel,
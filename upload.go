@@ -0,0 +1,152 @@
+package mtproto
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+const (
+	uploadPartSize    = 512 * 1024
+	uploadMaxParts    = 3000
+	uploadBigFileSize = 10 * 1024 * 1024
+	uploadWorkers     = 4
+	uploadRetries     = 3
+)
+
+type uploadPart struct {
+	index int32
+	data  []byte
+}
+
+// UploadFile uploads size bytes read from r by parts and returns TL_inputFile,
+// or TL_inputFileBig for files bigger than 10MB, to be used in messages.sendMedia.
+func (m *MTProto) UploadFile(ctx context.Context, r io.Reader, size int64, name string) (InputFile, error) {
+	if size <= 0 {
+		return nil, errors.New("MTProto::UploadFile file is empty")
+	}
+	parts := int32((size + uploadPartSize - 1) / uploadPartSize)
+	if parts > uploadMaxParts {
+		return nil, fmt.Errorf("MTProto::UploadFile file is too big: %d bytes", size)
+	}
+	big := size > uploadBigFileSize
+	fileID := GenerateMessageId()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errMutex sync.Mutex
+		firstErr error
+	)
+	fail := func(err error) {
+		errMutex.Lock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		errMutex.Unlock()
+		cancel()
+	}
+
+	queue := make(chan uploadPart)
+	for i := 0; i < uploadWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for part := range queue {
+				if err := m.uploadPart(ctx, fileID, part, parts, big); err != nil {
+					fail(err)
+					return
+				}
+			}
+		}()
+	}
+
+	// parts are read sequentially, checksum is counted on the way
+	checksum := md5.New()
+	remain := size
+read:
+	for index := int32(0); index < parts; index++ {
+		data := make([]byte, uploadPartSize)
+		if remain < uploadPartSize {
+			data = data[:remain]
+		}
+		if _, err := io.ReadFull(r, data); err != nil {
+			fail(err)
+			break
+		}
+		remain -= int64(len(data))
+		if !big {
+			checksum.Write(data)
+		}
+
+		select {
+		case queue <- uploadPart{index: index, data: data}:
+		case <-ctx.Done():
+			break read
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var file InputFile
+	if big {
+		file = TL_inputFileBig{
+			Id:    fileID,
+			Parts: parts,
+			Name:  name,
+		}
+	} else {
+		file = TL_inputFile{
+			Id:           fileID,
+			Parts:        parts,
+			Name:         name,
+			Md5_checksum: hex.EncodeToString(checksum.Sum(nil)),
+		}
+	}
+
+	return file, nil
+}
+
+// uploadPart saves one part of the file, failed attempts are repeated
+func (m *MTProto) uploadPart(ctx context.Context, fileID int64, part uploadPart, parts int32, big bool) error {
+	var msg TL
+	if big {
+		msg = TL_upload_saveBigFilePart{
+			File_id:          fileID,
+			File_part:        part.index,
+			File_total_parts: parts,
+			Bytes:            part.data,
+		}
+	} else {
+		msg = TL_upload_saveFilePart{
+			File_id:   fileID,
+			File_part: part.index,
+			Bytes:     part.data,
+		}
+	}
+
+	return retry(ctx, uploadRetries, func() error {
+		tl, err := m.InvokeContext(ctx, msg)
+		if err != nil {
+			return err
+		}
+		ok, err := ToBool(*tl)
+		if err == nil && !ok {
+			err = fmt.Errorf("MTProto::UploadFile part %d isn't saved", part.index)
+		}
+		return err
+	})
+}
@@ -2,7 +2,7 @@ package mtproto
 
 import "fmt"
 
-func (m *MTProto) UsersGetFullUsers(id TL) (*TL_userFull, error) {
+func (m *MTProto) UsersGetFullUsers(id InputUser) (*TL_userFull, error) {
 	var user TL_userFull
 	tl, err := m.InvokeSync(TL_users_getFullUser{
 		Id: id,